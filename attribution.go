@@ -0,0 +1,115 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// UTM is a normalized set of campaign parameters and ad click IDs, as found in the query string
+// of a landing page URL
+type UTM struct {
+	Source   string // utm_source, lowercased
+	Medium   string // utm_medium, lowercased
+	Campaign string // utm_campaign
+	Term     string // utm_term
+	Content  string // utm_content
+	GCLID    string // Google Ads click ID
+	FBCLID   string // Facebook click ID
+	MSCLKID  string // Microsoft Ads click ID
+	DCLID    string // Google Display click ID
+}
+
+// ParseUTM reads the UTM parameters and click IDs of a query string. Values are trimmed, and
+// source and medium are lowercased so "Google" and "google" report as the same source.
+func ParseUTM(query url.Values) UTM {
+	get := func(key string) string { return strings.TrimSpace(query.Get(key)) }
+	return UTM{
+		Source:   strings.ToLower(get("utm_source")),
+		Medium:   strings.ToLower(get("utm_medium")),
+		Campaign: get("utm_campaign"),
+		Term:     get("utm_term"),
+		Content:  get("utm_content"),
+		GCLID:    get("gclid"),
+		FBCLID:   get("fbclid"),
+		MSCLKID:  get("msclkid"),
+		DCLID:    get("dclid"),
+	}
+}
+
+// IsZero reports whether no parameter is set
+func (u UTM) IsZero() bool {
+	return u == UTM{}
+}
+
+// Query returns the parameters as a query string, e.g. to tag a link
+func (u UTM) Query() url.Values {
+	query := url.Values{}
+	for key, value := range map[string]string{
+		"utm_source":   u.Source,
+		"utm_medium":   u.Medium,
+		"utm_campaign": u.Campaign,
+		"utm_term":     u.Term,
+		"utm_content":  u.Content,
+		"gclid":        u.GCLID,
+		"fbclid":       u.FBCLID,
+		"msclkid":      u.MSCLKID,
+		"dclid":        u.DCLID,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	return query
+}
+
+// AttributionSource converts the parameters to a contact attribution source
+func (u UTM) AttributionSource() *AttributionSource {
+	return &AttributionSource{
+		Source:     u.Source,
+		Medium:     u.Medium,
+		Campaign:   u.Campaign,
+		UTMKeyword: u.Term,
+		UTMContent: u.Content,
+		GCLId:      u.GCLID,
+		FBCLId:     u.FBCLID,
+		MSCLKId:    u.MSCLKID,
+		DCLID:      u.DCLID,
+	}
+}
+
+// UTM returns the campaign parameters and click IDs of an attribution source. It returns a zero
+// UTM for a nil source.
+func (a *AttributionSource) UTM() UTM {
+	if a == nil {
+		return UTM{}
+	}
+	return UTM{
+		Source:   a.Source,
+		Medium:   a.Medium,
+		Campaign: a.Campaign,
+		Term:     a.UTMKeyword,
+		Content:  a.UTMContent,
+		GCLID:    a.GCLId,
+		FBCLID:   a.FBCLId,
+		MSCLKID:  a.MSCLKId,
+		DCLID:    a.DCLID,
+	}
+}
+
+// AttributionFromURL builds an attribution source from a landing page URL, reading its UTM
+// parameters and click IDs. The URL is kept without its fragment.
+func AttributionFromURL(landingURL string) (*AttributionSource, error) {
+	u, err := url.Parse(strings.TrimSpace(landingURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid landing page URL: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid landing page URL: %q is not absolute", landingURL)
+	}
+
+	source := ParseUTM(u.Query()).AttributionSource()
+	u.Fragment = ""
+	source.URL = u.String()
+	return source, nil
+}
@@ -0,0 +1,52 @@
+package gohighlevel
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestAttributionFromURL(t *testing.T) {
+	source, err := AttributionFromURL("https://example.com/offer?utm_source=Google&utm_medium=CPC&utm_campaign=Spring+Sale&utm_term=crm&utm_content=ad-1&gclid=abc#pricing")
+	if err != nil {
+		t.Fatalf("AttributionFromURL failed: %v", err)
+	}
+
+	want := &AttributionSource{
+		URL:        "https://example.com/offer?utm_source=Google&utm_medium=CPC&utm_campaign=Spring+Sale&utm_term=crm&utm_content=ad-1&gclid=abc",
+		Source:     "google",
+		Medium:     "cpc",
+		Campaign:   "Spring Sale",
+		UTMKeyword: "crm",
+		UTMContent: "ad-1",
+		GCLId:      "abc",
+	}
+	if !reflect.DeepEqual(source, want) {
+		t.Errorf("AttributionFromURL = %+v, want %+v", source, want)
+	}
+
+	for _, invalid := range []string{"", "/offer?utm_source=google", "://bad"} {
+		if _, err := AttributionFromURL(invalid); err == nil {
+			t.Errorf("AttributionFromURL(%q) succeeded", invalid)
+		}
+	}
+}
+
+func TestUTM_RoundTrip(t *testing.T) {
+	utm := UTM{Source: "newsletter", Medium: "email", Campaign: "launch", Term: "t", Content: "c", FBCLID: "fb", MSCLKID: "ms", DCLID: "dc"}
+
+	if got := utm.AttributionSource().UTM(); got != utm {
+		t.Errorf("AttributionSource().UTM() = %+v, want %+v", got, utm)
+	}
+	if got := ParseUTM(utm.Query()); got != utm {
+		t.Errorf("ParseUTM(Query()) = %+v, want %+v", got, utm)
+	}
+
+	var nilSource *AttributionSource
+	if !nilSource.UTM().IsZero() {
+		t.Error("UTM of a nil source is not zero")
+	}
+	if len(ParseUTM(url.Values{"other": {"x"}}).Query()) != 0 {
+		t.Error("unrelated parameters were parsed")
+	}
+}
@@ -0,0 +1,102 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TokenError is returned when the OAuth token endpoint rejects a request
+type TokenError struct {
+	StatusCode  int
+	Code        string // OAuth error code, e.g. "invalid_grant"
+	Description string
+	Body        string
+}
+
+func (e *TokenError) Error() string {
+	return fmt.Sprintf("token request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Permanent reports whether retrying the same request cannot succeed, e.g. because the refresh
+// token was revoked when the app was uninstalled, or the client credentials are wrong
+func (e *TokenError) Permanent() bool {
+	switch e.Code {
+	case "invalid_grant", "invalid_client", "unauthorized_client", "unsupported_grant_type":
+		return true
+	}
+	return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// newTokenError builds a TokenError from an error response of the token endpoint
+func newTokenError(statusCode int, body []byte) *TokenError {
+	tokenErr := &TokenError{StatusCode: statusCode, Body: string(body)}
+	var oauthErr struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if json.Unmarshal(body, &oauthErr) == nil {
+		tokenErr.Code = oauthErr.Error
+		tokenErr.Description = oauthErr.ErrorDescription
+	}
+	return tokenErr
+}
+
+// isPermanentAuthError reports whether err means the client cannot get a working token without
+// the user authorizing the app again
+func isPermanentAuthError(err error) bool {
+	var tokenErr *TokenError
+	return errors.As(err, &tokenErr) && tokenErr.Permanent()
+}
+
+// TokenInfo describes the tokens of a client whose authorization failed
+type TokenInfo struct {
+	LocationID   string
+	RefreshToken string    // The refresh token that was rejected, to find the installation in your storage
+	Expiry       time.Time // Expiry of the access token, zero if unknown
+	Metadata     Metadata  // Metadata of the client whose request failed
+}
+
+// AuthFailureCallback is called when a client's authorization fails permanently because the
+// token refresh was rejected, e.g. with invalid_grant after the app was uninstalled. Apps
+// typically mark the installation as disconnected and ask the user to authorize again.
+type AuthFailureCallback func(err error, info TokenInfo)
+
+// ErrAuthorizationFailed is returned, wrapping the original error, for requests made after the
+// client's authorization failed permanently. Setting new tokens clears the failure.
+var ErrAuthorizationFailed = errors.New("authorization failed permanently")
+
+// markAuthFailed records a permanent authorization failure caused by rejectedRefreshToken and
+// calls the OnAuthFailure callback, once per failure. Nothing is recorded when the tokens were
+// replaced in the meantime.
+func (c *Client) markAuthFailed(rejectedRefreshToken string, err error) {
+	c.tokens.mu.Lock()
+	if c.tokens.refreshToken != rejectedRefreshToken {
+		c.tokens.mu.Unlock()
+		return
+	}
+	first := c.tokens.authFailure == nil
+	if first {
+		c.tokens.authFailure = err
+	}
+	info := TokenInfo{
+		LocationID:   c.locationID,
+		RefreshToken: rejectedRefreshToken,
+		Expiry:       c.tokens.expiry,
+		Metadata:     c.Metadata(),
+	}
+	c.tokens.mu.Unlock()
+
+	if first && c.onAuthFailure != nil {
+		c.onAuthFailure(err, info)
+	}
+}
+
+// authFailure returns the recorded permanent authorization failure, if any
+func (c *Client) authFailure() error {
+	c.tokens.mu.RLock()
+	defer c.tokens.mu.RUnlock()
+	return c.tokens.authFailure
+}
@@ -0,0 +1,195 @@
+package gohighlevel
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOnAuthFailure_InvalidGrant(t *testing.T) {
+	var refreshes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			atomic.AddInt32(&refreshes, 1)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Invalid refresh token"}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var calls int
+	var gotErr error
+	var gotInfo TokenInfo
+	client, _ := NewClient(Config{
+		ClientID:         "id",
+		ClientSecret:     "secret",
+		AccessToken:      "expired",
+		RefreshToken:     "revoked",
+		LocationID:       "loc",
+		Environment:      MockEnvironment(server.URL),
+		AutoRefreshOn401: true,
+		Metadata:         Metadata{"tenant": "acme"},
+		OnAuthFailure: func(err error, info TokenInfo) {
+			calls++
+			gotErr, gotInfo = err, info
+		},
+	})
+
+	if _, err := client.Contacts.Get("c1"); err == nil {
+		t.Fatal("Get succeeded with a revoked token")
+	}
+	var tokenErr *TokenError
+	if !errors.As(gotErr, &tokenErr) || tokenErr.Code != "invalid_grant" || !tokenErr.Permanent() {
+		t.Errorf("callback error = %v", gotErr)
+	}
+	if gotInfo.LocationID != "loc" || gotInfo.RefreshToken != "revoked" || gotInfo.Metadata["tenant"] != "acme" {
+		t.Errorf("callback info = %+v", gotInfo)
+	}
+
+	// Later requests fail fast instead of refreshing again
+	_, err := client.Contacts.Get("c1")
+	if !errors.Is(err, ErrAuthorizationFailed) {
+		t.Errorf("second Get error = %v, want ErrAuthorizationFailed", err)
+	}
+	if calls != 1 || atomic.LoadInt32(&refreshes) != 1 {
+		t.Errorf("callback called %d times, %d refreshes; want 1 and 1", calls, refreshes)
+	}
+
+	// New tokens clear the failure
+	client.SetTokens("new-access", "new-refresh", 3600)
+	_, _ = client.Contacts.Get("c1")
+	if atomic.LoadInt32(&refreshes) != 2 {
+		t.Errorf("refresh not attempted after new tokens were set")
+	}
+}
+
+func TestOnAuthFailure_TransientRefreshError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	called := false
+	client, _ := NewClient(Config{
+		ClientID:         "id",
+		ClientSecret:     "secret",
+		AccessToken:      "expired",
+		RefreshToken:     "valid",
+		Environment:      MockEnvironment(server.URL),
+		AutoRefreshOn401: true,
+		OnAuthFailure:    func(err error, info TokenInfo) { called = true },
+	})
+
+	_, _ = client.Contacts.Get("c1")
+	if called {
+		t.Error("OnAuthFailure called for a transient refresh error")
+	}
+	if _, err := client.Contacts.Get("c1"); errors.Is(err, ErrAuthorizationFailed) {
+		t.Error("client gave up after a transient refresh error")
+	}
+}
+
+func TestAutoRefresh_ConcurrentRequestsRefreshOnce(t *testing.T) {
+	const requests = 8
+	var refreshes int32
+	var stale sync.WaitGroup
+	stale.Add(requests)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			atomic.AddInt32(&refreshes, 1)
+			_ = r.ParseForm()
+			if r.PostForm.Get("refresh_token") != "single-use" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"fresh","refresh_token":"rotated","expires_in":3600}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			// Reject only once every request has been sent with the expired token
+			stale.Done()
+			stale.Wait()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	var failures int32
+	client, _ := NewClient(Config{
+		ClientID:         "id",
+		ClientSecret:     "secret",
+		AccessToken:      "expired",
+		RefreshToken:     "single-use",
+		Environment:      MockEnvironment(server.URL),
+		AutoRefreshOn401: true,
+		OnAuthFailure:    func(err error, info TokenInfo) { atomic.AddInt32(&failures, 1) },
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Contacts.Get("c1")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Get failed: %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("%d refreshes, want 1", n)
+	}
+	if atomic.LoadInt32(&failures) != 0 || client.GetRefreshToken() != "rotated" {
+		t.Errorf("auth failure reported %d times, refresh token %q", failures, client.GetRefreshToken())
+	}
+}
+
+func TestOnAuthFailure_NoRefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			t.Error("refresh attempted without a refresh token")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	called := false
+	client, _ := NewClient(Config{
+		ClientID:         "id",
+		ClientSecret:     "secret",
+		AccessToken:      "expired",
+		Environment:      MockEnvironment(server.URL),
+		AutoRefreshOn401: true,
+		OnAuthFailure:    func(err error, info TokenInfo) { called = true },
+	})
+
+	_, err := client.Contacts.Get("c1")
+	if err == nil || errors.Is(err, ErrAuthorizationFailed) {
+		t.Errorf("Get error = %v, want the 401", err)
+	}
+	if called {
+		t.Error("OnAuthFailure called for a 401 without a refresh token")
+	}
+	if _, err := client.Contacts.Get("c1"); errors.Is(err, ErrAuthorizationFailed) {
+		t.Error("client gave up after a 401 without a refresh token")
+	}
+}
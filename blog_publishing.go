@@ -0,0 +1,96 @@
+package gohighlevel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidBlogTransition is returned when a blog post cannot move from its current status to
+// the requested one
+var ErrInvalidBlogTransition = errors.New("invalid blog post status transition")
+
+// blogTransitions lists the statuses each blog post status can move to
+var blogTransitions = map[string][]string{
+	BlogPostStatusDraft:     {BlogPostStatusScheduled, BlogPostStatusPublished, BlogPostStatusArchived},
+	BlogPostStatusScheduled: {BlogPostStatusScheduled, BlogPostStatusDraft, BlogPostStatusPublished, BlogPostStatusArchived},
+	BlogPostStatusPublished: {BlogPostStatusDraft, BlogPostStatusArchived},
+	BlogPostStatusArchived:  {BlogPostStatusDraft},
+}
+
+// SchedulePost schedules a draft or scheduled blog post to go live at the given time
+// Required scopes: blogs/posts.readonly, blogs/post-update.write
+func (s *BlogsService) SchedulePost(locationID, blogID, postID string, at time.Time) (*BlogPost, error) {
+	if !at.After(time.Now()) {
+		return nil, fmt.Errorf("scheduled time must be in the future")
+	}
+	return s.transitionPost(locationID, blogID, postID, BlogPostStatusScheduled, at)
+}
+
+// PublishPost publishes a draft or scheduled blog post immediately
+// Required scopes: blogs/posts.readonly, blogs/post-update.write
+func (s *BlogsService) PublishPost(locationID, blogID, postID string) (*BlogPost, error) {
+	return s.transitionPost(locationID, blogID, postID, BlogPostStatusPublished, time.Now())
+}
+
+// UnpublishPost moves a scheduled, published or archived blog post back to draft
+// Required scopes: blogs/posts.readonly, blogs/post-update.write
+func (s *BlogsService) UnpublishPost(locationID, blogID, postID string) (*BlogPost, error) {
+	return s.transitionPost(locationID, blogID, postID, BlogPostStatusDraft, time.Time{})
+}
+
+// transitionPost moves a blog post to status, checking the transition against its current
+// status. A non-zero publishAt replaces the post's publish time.
+func (s *BlogsService) transitionPost(locationID, blogID, postID, status string, publishAt time.Time) (*BlogPost, error) {
+	post, err := s.GetPost(locationID, blogID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !blogTransitionAllowed(post.Status, status) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidBlogTransition, post.Status, status)
+	}
+
+	req := blogPostRequestFrom(post)
+	req.LocationID = s.client.resolveLocationID(locationID)
+	req.BlogID = blogID
+	req.Status = status
+	if !publishAt.IsZero() {
+		req.PublishedAt = publishAt.UTC().Format(time.RFC3339)
+	}
+	return s.UpdatePost(postID, req)
+}
+
+// blogTransitionAllowed reports whether a post may move from one status to another. Posts with
+// a status the SDK does not know about may move anywhere.
+func blogTransitionAllowed(from, to string) bool {
+	next, ok := blogTransitions[from]
+	if !ok {
+		return true
+	}
+	for _, status := range next {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// blogPostRequestFrom returns a request that rewrites a blog post with its current values
+func blogPostRequestFrom(post *BlogPost) *BlogPostRequest {
+	return &BlogPostRequest{
+		LocationID:    post.LocationID,
+		BlogID:        post.BlogID,
+		Title:         post.Title,
+		Description:   post.Description,
+		RawHTML:       post.RawHTML,
+		ImageURL:      post.ImageURL,
+		ImageAltText:  post.ImageAltText,
+		Status:        post.Status,
+		Categories:    post.Categories,
+		Tags:          post.Tags,
+		Author:        post.Author,
+		URLSlug:       post.URLSlug,
+		CanonicalLink: post.CanonicalLink,
+		PublishedAt:   post.PublishedAt.iso(),
+	}
+}
@@ -0,0 +1,73 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newBlogPublishingClient serves post-1 of blog-1 with the given status and records its update
+func newBlogPublishingClient(t *testing.T, status string, updated *BlogPostRequest) *Client {
+	return newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /blogs/posts/all": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, BlogPostsResponse{Posts: []BlogPost{{
+				ID:         "post-1",
+				LocationID: "loc-1",
+				BlogID:     "blog-1",
+				Title:      "Launch",
+				Status:     status,
+				Categories: []string{"cat-1"},
+				URLSlug:    "launch",
+			}}})
+		},
+		"PUT /blogs/posts/post-1": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(updated)
+			writeJSON(w, updateBlogPostResponse{UpdatedBlogPost: &BlogPost{ID: "post-1", Status: updated.Status}})
+		},
+	})
+}
+
+func TestBlogs_SchedulePost(t *testing.T) {
+	var updated BlogPostRequest
+	client := newBlogPublishingClient(t, BlogPostStatusDraft, &updated)
+	at := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	post, err := client.Blogs.SchedulePost("loc-1", "blog-1", "post-1", at)
+	if err != nil {
+		t.Fatalf("SchedulePost failed: %v", err)
+	}
+	if post.Status != BlogPostStatusScheduled {
+		t.Errorf("Expected scheduled post, got %s", post.Status)
+	}
+	if updated.PublishedAt != at.UTC().Format(time.RFC3339) {
+		t.Errorf("Expected publishedAt %s, got %s", at.UTC().Format(time.RFC3339), updated.PublishedAt)
+	}
+	if updated.Title != "Launch" || updated.URLSlug != "launch" || len(updated.Categories) != 1 {
+		t.Errorf("Expected existing fields to be kept, got %+v", updated)
+	}
+
+	if _, err := client.Blogs.SchedulePost("loc-1", "blog-1", "post-1", time.Now().Add(-time.Hour)); err == nil {
+		t.Error("Expected error for a time in the past")
+	}
+}
+
+func TestBlogs_InvalidTransition(t *testing.T) {
+	var updated BlogPostRequest
+	client := newBlogPublishingClient(t, BlogPostStatusPublished, &updated)
+	_, err := client.Blogs.SchedulePost("loc-1", "blog-1", "post-1", time.Now().Add(time.Hour))
+	if !errors.Is(err, ErrInvalidBlogTransition) {
+		t.Fatalf("Expected ErrInvalidBlogTransition, got %v", err)
+	}
+	if updated.Status != "" {
+		t.Error("Expected no update for an invalid transition")
+	}
+
+	post, err := client.Blogs.UnpublishPost("loc-1", "blog-1", "post-1")
+	if err != nil {
+		t.Fatalf("UnpublishPost failed: %v", err)
+	}
+	if post.Status != BlogPostStatusDraft {
+		t.Errorf("Expected draft post, got %s", post.Status)
+	}
+}
@@ -0,0 +1,313 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// BlogsService handles operations related to blogs and blog posts
+type BlogsService struct {
+	client *Client
+}
+
+// BlogPost represents a post of a GoHighLevel blog
+type BlogPost struct {
+	ID            string   `json:"_id,omitempty"`
+	LocationID    string   `json:"locationId,omitempty"`
+	BlogID        string   `json:"blogId,omitempty"`
+	Title         string   `json:"title,omitempty"`
+	Description   string   `json:"description,omitempty"` // Used as the SEO meta description
+	RawHTML       string   `json:"rawHTML,omitempty"`
+	ImageURL      string   `json:"imageUrl,omitempty"`
+	ImageAltText  string   `json:"imageAltText,omitempty"`
+	Status        string   `json:"status,omitempty"` // "DRAFT", "PUBLISHED", "SCHEDULED" or "ARCHIVED"
+	Categories    []string `json:"categories,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	Author        string   `json:"author,omitempty"`
+	URLSlug       string   `json:"urlSlug,omitempty"`
+	CanonicalLink string   `json:"canonicalLink,omitempty"`
+	Archived      bool     `json:"archived,omitempty"`
+	PublishedAt   Time     `json:"publishedAt,omitempty"`
+	UpdatedAt     Time     `json:"updatedAt,omitempty"`
+}
+
+// BlogPostRequest represents a request to create or update a blog post
+type BlogPostRequest struct {
+	LocationID    string   `json:"locationId"`
+	BlogID        string   `json:"blogId"`
+	Title         string   `json:"title"`
+	Description   string   `json:"description"`
+	RawHTML       string   `json:"rawHTML"`
+	ImageURL      string   `json:"imageUrl"`
+	ImageAltText  string   `json:"imageAltText"`
+	Status        string   `json:"status"`     // "DRAFT", "PUBLISHED", "SCHEDULED" or "ARCHIVED"
+	Categories    []string `json:"categories"` // Category IDs
+	Tags          []string `json:"tags,omitempty"`
+	Author        string   `json:"author"` // Author ID
+	URLSlug       string   `json:"urlSlug"`
+	CanonicalLink string   `json:"canonicalLink,omitempty"`
+	PublishedAt   string   `json:"publishedAt"` // ISO 8601 timestamp
+}
+
+// ListBlogPostsOptions represents query options for listing blog posts
+type ListBlogPostsOptions struct {
+	LocationID string
+	BlogID     string
+	SearchTerm string
+	Status     string
+	Limit      int
+	Offset     int
+}
+
+// BlogPostsResponse represents a list of blog posts API response
+type BlogPostsResponse struct {
+	Posts []BlogPost `json:"blogs,omitempty"`
+}
+
+// createBlogPostResponse represents the response to creating a blog post
+type createBlogPostResponse struct {
+	Data *BlogPost `json:"data,omitempty"`
+}
+
+// updateBlogPostResponse represents the response to updating a blog post
+type updateBlogPostResponse struct {
+	UpdatedBlogPost *BlogPost `json:"updatedBlogPost,omitempty"`
+}
+
+// ListPosts retrieves the posts of a blog
+// Required scope: blogs/posts.readonly
+func (s *BlogsService) ListPosts(opts *ListBlogPostsOptions) ([]BlogPost, error) {
+	if opts == nil {
+		opts = &ListBlogPostsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if opts.BlogID == "" {
+		return nil, fmt.Errorf("blogId is required")
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	query := url.Values{}
+	query.Set("locationId", opts.LocationID)
+	query.Set("blogId", opts.BlogID)
+	query.Set("limit", fmt.Sprintf("%d", limit))
+	query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	if opts.SearchTerm != "" {
+		query.Set("searchTerm", opts.SearchTerm)
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+
+	var result BlogPostsResponse
+	err := s.client.doRequest("GET", "/blogs/posts/all?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Posts, nil
+}
+
+// GetPost retrieves a blog post by ID.
+// The API has no single-post endpoint, so this pages through ListPosts until the post is found.
+// Required scope: blogs/posts.readonly
+func (s *BlogsService) GetPost(locationID, blogID, postID string) (*BlogPost, error) {
+	if postID == "" {
+		return nil, fmt.Errorf("postId is required")
+	}
+
+	const pageSize = 50
+	for offset := 0; ; offset += pageSize {
+		posts, err := s.ListPosts(&ListBlogPostsOptions{
+			LocationID: locationID,
+			BlogID:     blogID,
+			Limit:      pageSize,
+			Offset:     offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range posts {
+			if posts[i].ID == postID {
+				return &posts[i], nil
+			}
+		}
+
+		if len(posts) < pageSize {
+			return nil, fmt.Errorf("blog post %s not found", postID)
+		}
+	}
+}
+
+// CreatePost creates a new blog post
+// Required scope: blogs/post.write
+func (s *BlogsService) CreatePost(req *BlogPostRequest) (*BlogPost, error) {
+	req, err := validateBlogPostRequest(s.client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result createBlogPostResponse
+	err = s.client.doRequest("POST", "/blogs/posts", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// UpdatePost updates an existing blog post
+// Required scope: blogs/post-update.write
+func (s *BlogsService) UpdatePost(postID string, req *BlogPostRequest) (*BlogPost, error) {
+	if postID == "" {
+		return nil, fmt.Errorf("postId is required")
+	}
+	req, err := validateBlogPostRequest(s.client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result updateBlogPostResponse
+	err = s.client.doRequest("PUT", fmt.Sprintf("/blogs/posts/%s", postID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.UpdatedBlogPost, nil
+}
+
+// validateBlogPostRequest checks the fields required to create or update a blog post and returns
+// a copy of req with the default location filled in
+func validateBlogPostRequest(c *Client, req *BlogPostRequest) (*BlogPostRequest, error) {
+	req = shallowCopy(req)
+	req.LocationID = c.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.BlogID == "" {
+		return nil, fmt.Errorf("blogId is required")
+	}
+	if req.Title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	if req.Status == "" {
+		return nil, fmt.Errorf("status is required")
+	}
+	return req, nil
+}
+
+// BlogAuthor represents an author that blog posts can be attributed to
+type BlogAuthor struct {
+	ID            string `json:"_id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	LocationID    string `json:"locationId,omitempty"`
+	CanonicalLink string `json:"canonicalLink,omitempty"`
+	UpdatedAt     Time   `json:"updatedAt,omitempty"`
+}
+
+// BlogCategory represents a category that blog posts can be filed under
+type BlogCategory struct {
+	ID            string `json:"_id,omitempty"`
+	Label         string `json:"label,omitempty"`
+	LocationID    string `json:"locationId,omitempty"`
+	URLSlug       string `json:"urlSlug,omitempty"`
+	CanonicalLink string `json:"canonicalLink,omitempty"`
+	UpdatedAt     Time   `json:"updatedAt,omitempty"`
+}
+
+// BlogAuthorsResponse represents a list of blog authors API response
+type BlogAuthorsResponse struct {
+	Authors []BlogAuthor `json:"authors,omitempty"`
+}
+
+// BlogCategoriesResponse represents a list of blog categories API response
+type BlogCategoriesResponse struct {
+	Categories []BlogCategory `json:"categories,omitempty"`
+}
+
+// slugExistsResponse represents the response of the URL slug check
+type slugExistsResponse struct {
+	Exists bool `json:"exists"`
+}
+
+// ListAuthors retrieves the blog authors of a location
+// Required scope: blogs/author.readonly
+func (s *BlogsService) ListAuthors(locationID string, limit, offset int) ([]BlogAuthor, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result BlogAuthorsResponse
+	err := s.client.doRequest("GET", "/blogs/authors?"+blogPageQuery(locationID, limit, offset).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Authors, nil
+}
+
+// ListCategories retrieves the blog categories of a location
+// Required scope: blogs/category.readonly
+func (s *BlogsService) ListCategories(locationID string, limit, offset int) ([]BlogCategory, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result BlogCategoriesResponse
+	err := s.client.doRequest("GET", "/blogs/categories?"+blogPageQuery(locationID, limit, offset).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Categories, nil
+}
+
+// IsSlugAvailable reports whether a URL slug is free to use for a blog post.
+// Pass the ID of the post being edited as postID so its own slug is not reported as taken,
+// or "" for a new post.
+// Required scope: blogs/check-slug.readonly
+func (s *BlogsService) IsSlugAvailable(locationID, urlSlug, postID string) (bool, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return false, fmt.Errorf("locationId is required")
+	}
+	if urlSlug == "" {
+		return false, fmt.Errorf("urlSlug is required")
+	}
+
+	query := locationQuery(locationID)
+	query.Set("urlSlug", urlSlug)
+	if postID != "" {
+		query.Set("postId", postID)
+	}
+
+	var result slugExistsResponse
+	err := s.client.doRequest("GET", "/blogs/posts/url-slug-exists?"+query.Encode(), nil, &result)
+	if err != nil {
+		return false, err
+	}
+
+	return !result.Exists, nil
+}
+
+// blogPageQuery builds the locationId/limit/offset query used by the blog listing endpoints
+func blogPageQuery(locationID string, limit, offset int) url.Values {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	query := locationQuery(locationID)
+	query.Set("limit", fmt.Sprintf("%d", limit))
+	query.Set("offset", fmt.Sprintf("%d", offset))
+	return query
+}
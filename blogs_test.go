@@ -0,0 +1,187 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestBlogsService_Posts(t *testing.T) {
+	var created, updated BlogPostRequest
+	var offsets []string
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /blogs/posts/all": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("locationId") != "loc-1" || q.Get("blogId") != "blog-1" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			if q.Get("searchTerm") != "" {
+				if q.Get("searchTerm") != "launch" || q.Get("status") != "PUBLISHED" || q.Get("limit") != "10" || q.Get("offset") != "0" {
+					t.Errorf("unexpected query %s", r.URL.RawQuery)
+				}
+				writeJSON(w, BlogPostsResponse{Posts: []BlogPost{{ID: "post-1", Title: "Launch"}}})
+				return
+			}
+
+			// 60 posts, served in pages
+			offsets = append(offsets, q.Get("offset"))
+			limit, _ := strconv.Atoi(q.Get("limit"))
+			offset, _ := strconv.Atoi(q.Get("offset"))
+			var posts []BlogPost
+			for i := offset; i < min(offset+limit, 60); i++ {
+				posts = append(posts, BlogPost{ID: fmt.Sprintf("post-%d", i)})
+			}
+			writeJSON(w, BlogPostsResponse{Posts: posts})
+		},
+		"POST /blogs/posts": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"data":{"_id":"post-2","title":"New"}}`))
+		},
+		"PUT /blogs/posts/post-2": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"updatedBlogPost":{"_id":"post-2","title":"Renamed"}}`))
+		},
+	})
+
+	posts, err := client.Blogs.ListPosts(&ListBlogPostsOptions{LocationID: "loc-1", BlogID: "blog-1", SearchTerm: "launch", Status: "PUBLISHED"})
+	if err != nil {
+		t.Fatalf("ListPosts failed: %v", err)
+	}
+	if len(posts) != 1 || posts[0].ID != "post-1" {
+		t.Errorf("Unexpected posts: %+v", posts)
+	}
+
+	post, err := client.Blogs.GetPost("loc-1", "blog-1", "post-55")
+	if err != nil {
+		t.Fatalf("GetPost failed: %v", err)
+	}
+	if post.ID != "post-55" || len(offsets) != 2 || offsets[1] != "50" {
+		t.Errorf("Unexpected post %+v after fetching offsets %v", post, offsets)
+	}
+	if _, err := client.Blogs.GetPost("loc-1", "blog-1", "post-99"); err == nil {
+		t.Error("Expected error for a post that does not exist")
+	}
+
+	post, err = client.Blogs.CreatePost(&BlogPostRequest{LocationID: "loc-1", BlogID: "blog-1", Title: "New", Status: "DRAFT"})
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+	if post.ID != "post-2" || created.LocationID != "loc-1" || created.Status != "DRAFT" {
+		t.Errorf("Unexpected create: %+v, %+v", post, created)
+	}
+
+	post, err = client.Blogs.UpdatePost("post-2", &BlogPostRequest{LocationID: "loc-1", BlogID: "blog-1", Title: "Renamed", Status: "DRAFT"})
+	if err != nil {
+		t.Fatalf("UpdatePost failed: %v", err)
+	}
+	if post.Title != "Renamed" || updated.LocationID != "loc-1" || updated.Title != "Renamed" {
+		t.Errorf("Unexpected update: %+v, %+v", post, updated)
+	}
+}
+
+func TestBlogsService_PostsValidation(t *testing.T) {
+	client := newTestClient(t, Config{}, nil)
+
+	invalid := map[string]*BlogPostRequest{
+		"blogId": {LocationID: "loc-1", Title: "New", Status: "DRAFT"},
+		"title":  {LocationID: "loc-1", BlogID: "blog-1", Status: "DRAFT"},
+		"status": {LocationID: "loc-1", BlogID: "blog-1", Title: "New"},
+	}
+	for field, req := range invalid {
+		if _, err := client.Blogs.CreatePost(req); err == nil {
+			t.Errorf("Expected error for missing %s", field)
+		}
+	}
+	if _, err := client.Blogs.UpdatePost("", &BlogPostRequest{LocationID: "loc-1", BlogID: "blog-1", Title: "New", Status: "DRAFT"}); err == nil {
+		t.Error("Expected error for missing postId")
+	}
+	if _, err := client.Blogs.ListPosts(nil); err == nil {
+		t.Error("Expected error for missing blogId")
+	}
+	if _, err := client.Blogs.GetPost("loc-1", "blog-1", ""); err == nil {
+		t.Error("Expected error for missing postId")
+	}
+
+	if _, err := client.Blogs.ListPosts(&ListBlogPostsOptions{BlogID: "blog-1"}); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
+
+func TestBlogsService_AuthorsAndCategories(t *testing.T) {
+	checkQuery := func(r *http.Request, limit, offset string) {
+		if q := r.URL.Query(); q.Get("locationId") != "loc-1" || q.Get("limit") != limit || q.Get("offset") != offset {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /blogs/authors": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r, "10", "0")
+			_, _ = w.Write([]byte(`{"authors":[{"_id":"author-1","name":"Jane Doe"}]}`))
+		},
+		"GET /blogs/categories": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r, "25", "50")
+			_, _ = w.Write([]byte(`{"categories":[{"_id":"cat-1","label":"News","urlSlug":"news"},{"_id":"cat-2","label":"Guides"}]}`))
+		},
+	})
+
+	authors, err := client.Blogs.ListAuthors("loc-1", 0, 0)
+	if err != nil {
+		t.Fatalf("ListAuthors failed: %v", err)
+	}
+	if len(authors) != 1 || authors[0].Name != "Jane Doe" {
+		t.Errorf("Unexpected authors: %+v", authors)
+	}
+
+	categories, err := client.Blogs.ListCategories("loc-1", 25, 50)
+	if err != nil {
+		t.Fatalf("ListCategories failed: %v", err)
+	}
+	if len(categories) != 2 || categories[0].URLSlug != "news" {
+		t.Errorf("Unexpected categories: %+v", categories)
+	}
+
+	if _, err := client.Blogs.ListAuthors("", 0, 0); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+	if _, err := client.Blogs.ListCategories("", 0, 0); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
+
+func TestBlogsService_IsSlugAvailable(t *testing.T) {
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /blogs/posts/url-slug-exists": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("locationId") != "loc-1" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			// "launch" belongs to post-1, so it is only free when editing that post
+			writeJSON(w, slugExistsResponse{Exists: q.Get("urlSlug") == "launch" && q.Get("postId") != "post-1"})
+		},
+	})
+
+	tests := []struct {
+		slug   string
+		postID string
+		want   bool
+	}{
+		{"launch", "", false},
+		{"launch", "post-1", true},
+		{"roadmap", "", true},
+	}
+	for _, tt := range tests {
+		got, err := client.Blogs.IsSlugAvailable("loc-1", tt.slug, tt.postID)
+		if err != nil {
+			t.Fatalf("IsSlugAvailable(%q, %q) failed: %v", tt.slug, tt.postID, err)
+		}
+		if got != tt.want {
+			t.Errorf("IsSlugAvailable(%q, %q) = %v, want %v", tt.slug, tt.postID, got, tt.want)
+		}
+	}
+
+	if _, err := client.Blogs.IsSlugAvailable("loc-1", "", ""); err == nil {
+		t.Error("Expected error for missing urlSlug")
+	}
+}
@@ -0,0 +1,138 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Outcomes of a broadcast recipient
+const (
+	BroadcastSent        = "sent"
+	BroadcastSkippedDND  = "skipped_dnd"
+	BroadcastAlreadySent = "already_sent"
+	BroadcastFailed      = "failed"
+)
+
+// BroadcastOptions configures Messages.Broadcast
+type BroadcastOptions struct {
+	// Bulk sets concurrency and retries. Its RateLimiter paces every send of the broadcast, e.g.
+	// to stay within the location's API limit alongside other traffic.
+	Bulk *BulkOptions
+	// NumberLimiters paces sends per sending number (the message's FromNumber, or "" for the
+	// location default), e.g. to the carrier's per-number throughput. Share one set between
+	// broadcasts that send from the same numbers.
+	NumberLimiters *RateLimiterSet
+	// CheckDND skips contacts with do not disturb enabled for the message's channel. It adds to,
+	// and never turns off, a CheckDND set on the message itself.
+	CheckDND bool
+	// AlreadySent reports contacts sent to by an earlier run of the same broadcast, which are
+	// skipped. Persist the results passed to OnResult to resume an interrupted broadcast.
+	AlreadySent func(contactID string) bool
+	// OnResult is called as each recipient finishes. It may be called from several goroutines
+	// at once.
+	OnResult func(result BroadcastResult)
+}
+
+// BroadcastResult is the outcome of sending a broadcast to one contact
+type BroadcastResult struct {
+	ContactID      string
+	Status         string // See Broadcast* constants
+	MessageID      string
+	ConversationID string
+	Attempts       int
+	Duration       time.Duration
+	Err            error // Set when Status is BroadcastFailed
+}
+
+// BroadcastResults are the outcomes of a broadcast, in recipient order
+type BroadcastResults []BroadcastResult
+
+// Count returns the number of recipients with the given status
+func (r BroadcastResults) Count(status string) int {
+	n := 0
+	for _, result := range r {
+		if result.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Broadcast sends msg to each of contactIDs with bounded concurrency and throughput, and reports
+// the outcome for every recipient. msg.ContactID is ignored. Recipients that were not reached
+// because ctx was cancelled are reported as failed with ctx.Err(), so the broadcast can be
+// resumed with AlreadySent.
+// Required scopes: conversations/message.write, contacts.readonly (with CheckDND)
+func (s *MessagesService) Broadcast(ctx context.Context, msg *SendMessageRequest, contactIDs []string, opts *BroadcastOptions) (BroadcastResults, error) {
+	if msg == nil || msg.Type == "" {
+		return nil, fmt.Errorf("message type is required")
+	}
+	o := BroadcastOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	results := make(BroadcastResults, len(contactIDs))
+	var pending []int // Indexes of the recipients still to send to
+	for i, contactID := range contactIDs {
+		results[i] = BroadcastResult{ContactID: contactID}
+		if o.AlreadySent != nil && o.AlreadySent(contactID) {
+			results[i].Status = BroadcastAlreadySent
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	bulk := BulkOptions{}
+	if o.Bulk != nil {
+		bulk = *o.Bulk
+	}
+	onBulkResult := bulk.OnResult
+	bulk.OnResult = func(r BulkResult) {
+		result := &results[pending[r.Index]]
+		result.Attempts, result.Duration = r.Attempts, r.Duration
+		if r.Err != nil {
+			result.Status, result.Err = BroadcastFailed, r.Err
+		}
+		if onBulkResult != nil {
+			onBulkResult(r)
+		}
+		if o.OnResult != nil {
+			o.OnResult(*result)
+		}
+	}
+
+	bulkResults := RunBulk(ctx, len(pending), &bulk, func(ctx context.Context, i int) error {
+		result := &results[pending[i]]
+		if o.NumberLimiters != nil {
+			if err := o.NumberLimiters.For(msg.FromNumber).Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		req := *msg
+		req.ContactID = result.ContactID
+		req.CheckDND = msg.CheckDND || o.CheckDND
+		resp, err := s.Send(&req)
+		if errors.Is(err, ErrDNDActive) {
+			result.Status = BroadcastSkippedDND
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		result.Status = BroadcastSent
+		result.MessageID, result.ConversationID = resp.MessageID, resp.ConversationID
+		return nil
+	})
+
+	// Recipients never started because ctx was cancelled are not passed to OnResult
+	for i, r := range bulkResults {
+		if result := &results[pending[i]]; r.Err != nil && result.Status == "" {
+			result.Status, result.Err = BroadcastFailed, r.Err
+		}
+	}
+	return results, nil
+}
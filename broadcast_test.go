@@ -0,0 +1,91 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMessages_Broadcast(t *testing.T) {
+	var mu sync.Mutex
+	var sentTo []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/contacts/c2":
+			_, _ = w.Write([]byte(`{"contact":{"id":"c2","dnd":true}}`))
+		case "/contacts/c1", "/contacts/c4":
+			_, _ = w.Write([]byte(`{"contact":{"id":"` + r.URL.Path[len("/contacts/"):] + `"}}`))
+		case "/conversations/messages":
+			var req SendMessageRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			mu.Lock()
+			sentTo = append(sentTo, req.ContactID)
+			mu.Unlock()
+			if req.ContactID == "c4" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message":"invalid phone"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"conversationId":"conv-` + req.ContactID + `","messageId":"msg-` + req.ContactID + `"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	var reported []BroadcastResult
+	results, err := client.Messages.Broadcast(context.Background(),
+		&SendMessageRequest{Type: MessageTypeSMS, Message: "Sale starts now", FromNumber: "+15550001111"},
+		[]string{"c1", "c2", "c3", "c4"},
+		&BroadcastOptions{
+			Bulk:           &BulkOptions{Concurrency: 2},
+			NumberLimiters: NewRateLimiterSet(func() RateLimiter { return NewRateLimiter(10, time.Second) }),
+			CheckDND:       true,
+			AlreadySent:    func(contactID string) bool { return contactID == "c3" },
+			OnResult: func(result BroadcastResult) {
+				mu.Lock()
+				reported = append(reported, result)
+				mu.Unlock()
+			},
+		})
+	if err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+
+	want := []string{BroadcastSent, BroadcastSkippedDND, BroadcastAlreadySent, BroadcastFailed}
+	for i, status := range want {
+		if results[i].Status != status {
+			t.Errorf("recipient %s: status = %s, want %s (err %v)", results[i].ContactID, results[i].Status, status, results[i].Err)
+		}
+	}
+	if results[0].MessageID != "msg-c1" || results[3].Err == nil {
+		t.Errorf("unexpected results %+v", results)
+	}
+	if len(sentTo) != 2 {
+		t.Errorf("expected sends to c1 and c4 only, got %v", sentTo)
+	}
+	if len(reported) != 3 || results.Count(BroadcastSent) != 1 {
+		t.Errorf("expected 3 reported results, got %+v", reported)
+	}
+}
+
+func TestMessages_Broadcast_Cancelled(t *testing.T) {
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: "http://127.0.0.1:0"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := client.Messages.Broadcast(ctx, &SendMessageRequest{Type: MessageTypeSMS}, []string{"c1", "c2"}, nil)
+	if err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+	for _, result := range results {
+		if result.Status != BroadcastFailed || result.Err != context.Canceled {
+			t.Errorf("expected cancelled recipient, got %+v", result)
+		}
+	}
+}
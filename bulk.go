@@ -0,0 +1,160 @@
+package gohighlevel
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BulkOptions configures a bulk operation run by RunBulk
+type BulkOptions struct {
+	// Concurrency is the number of items processed at the same time (default 5)
+	Concurrency int
+	// MaxAttempts is the number of times an item is attempted before it is reported as
+	// failed (default 1). Requests are already retried by the client's RetryPolicy; this
+	// retries the whole item, e.g. after a validation race or a non-idempotent POST failure.
+	MaxAttempts int
+	// Backoff is the delay before an item is attempted again (default 1s), with jitter
+	Backoff time.Duration
+	// RateLimiter paces the start of each attempt, in addition to the client's own limiter
+	RateLimiter RateLimiter
+	// OnResult is called as each item finishes, e.g. to report progress. It may be called
+	// from several goroutines at once.
+	OnResult func(result BulkResult)
+}
+
+// BulkResult is the outcome of one item of a bulk operation
+type BulkResult struct {
+	Index    int           // Position of the item in the input
+	Attempts int           // Number of attempts made
+	Duration time.Duration // Total time spent on the item, including retries
+	Err      error         // Error of the last attempt, nil on success
+}
+
+// BulkResults are the outcomes of a bulk operation, in input order
+type BulkResults []BulkResult
+
+// Failed returns the results of the items that failed
+func (r BulkResults) Failed() BulkResults {
+	var failed BulkResults
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Succeeded returns the number of items that succeeded
+func (r BulkResults) Succeeded() int {
+	return len(r) - len(r.Failed())
+}
+
+// RunBulk calls fn for each index in [0, n) with bounded concurrency and returns one result per
+// item. It stops starting new items when ctx is cancelled; items that were never started report
+// ctx.Err().
+//
+//	results := ghl.RunBulk(ctx, len(reqs), &ghl.BulkOptions{Concurrency: 10}, func(ctx context.Context, i int) error {
+//		_, err := client.Contacts.Upsert(reqs[i])
+//		return err
+//	})
+func RunBulk(ctx context.Context, n int, opts *BulkOptions, fn func(ctx context.Context, index int) error) BulkResults {
+	o := BulkOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 5
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 1
+	}
+	if o.Backoff <= 0 {
+		o.Backoff = time.Second
+	}
+	backoff := RetryPolicy{MinBackoff: o.Backoff, MaxBackoff: o.Backoff << 4}.withDefaults()
+
+	results := make(BulkResults, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < o.Concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = runBulkItem(ctx, i, &o, backoff, fn)
+				if o.OnResult != nil {
+					o.OnResult(results[i])
+				}
+			}
+		}()
+	}
+
+	next := 0
+	for ; next < n && ctx.Err() == nil; next++ {
+		select {
+		case indexes <- next:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := next; i < n; i++ {
+		results[i] = BulkResult{Index: i, Err: ctx.Err()}
+	}
+	return results
+}
+
+// runBulkItem attempts a single item until it succeeds or runs out of attempts
+func runBulkItem(ctx context.Context, index int, o *BulkOptions, backoff RetryPolicy, fn func(context.Context, int) error) BulkResult {
+	start := time.Now()
+	result := BulkResult{Index: index}
+
+	for attempt := 0; attempt < o.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff.backoff(attempt-1, nil))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				result.Duration = time.Since(start)
+				return result
+			case <-timer.C:
+			}
+		}
+		if o.RateLimiter != nil {
+			if err := o.RateLimiter.Wait(ctx); err != nil {
+				result.Err = err
+				break
+			}
+		}
+
+		result.Attempts++
+		result.Err = fn(ctx, index)
+		if result.Err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+
+	result.Duration = time.Since(start)
+	return result
+}
+
+// BulkUpsert upserts many contacts concurrently and reports the outcome of each one.
+// The upserted contacts are returned in input order; entries for failed items are nil.
+// Required scope: contacts.write
+func (s *ContactsService) BulkUpsert(ctx context.Context, reqs []*UpsertContactRequest, opts *BulkOptions) ([]*Contact, BulkResults) {
+	contacts := make([]*Contact, len(reqs))
+	results := RunBulk(ctx, len(reqs), opts, func(ctx context.Context, i int) error {
+		contact, err := s.Upsert(reqs[i])
+		if err != nil {
+			return err
+		}
+		contacts[i] = contact
+		return nil
+	})
+	return contacts, results
+}
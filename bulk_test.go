@@ -0,0 +1,85 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBulk(t *testing.T) {
+	var running, maxRunning int32
+	var reported int32
+
+	results := RunBulk(context.Background(), 50, &BulkOptions{
+		Concurrency: 4,
+		OnResult:    func(BulkResult) { atomic.AddInt32(&reported, 1) },
+	}, func(ctx context.Context, i int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		if i%10 == 0 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if len(results) != 50 {
+		t.Fatalf("got %d results, want 50", len(results))
+	}
+	for i, result := range results {
+		if result.Index != i || result.Attempts != 1 {
+			t.Errorf("result %d = %+v", i, result)
+		}
+	}
+	if failed := results.Failed(); len(failed) != 5 || failed[1].Index != 10 {
+		t.Errorf("Failed() = %+v, want items 0,10,20,30,40", failed)
+	}
+	if results.Succeeded() != 45 {
+		t.Errorf("Succeeded() = %d, want 45", results.Succeeded())
+	}
+	if maxRunning > 4 {
+		t.Errorf("ran %d items concurrently, limit is 4", maxRunning)
+	}
+	if reported != 50 {
+		t.Errorf("OnResult called %d times, want 50", reported)
+	}
+}
+
+func TestRunBulk_Retries(t *testing.T) {
+	var calls int32
+	results := RunBulk(context.Background(), 1, &BulkOptions{MaxAttempts: 3, Backoff: time.Millisecond}, func(ctx context.Context, i int) error {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	if results[0].Err != nil || results[0].Attempts != 3 {
+		t.Errorf("result = %+v, want success after 3 attempts", results[0])
+	}
+}
+
+func TestRunBulk_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	results := RunBulk(ctx, 100, &BulkOptions{Concurrency: 1}, func(ctx context.Context, i int) error {
+		if i == 4 {
+			cancel()
+		}
+		return nil
+	})
+
+	if !errors.Is(results[99].Err, context.Canceled) || results[99].Attempts != 0 {
+		t.Errorf("unstarted item = %+v, want context.Canceled", results[99])
+	}
+	if results[0].Err != nil {
+		t.Errorf("first item = %+v, want success", results[0])
+	}
+}
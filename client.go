@@ -3,11 +3,15 @@ package gohighlevel
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 )
@@ -15,7 +19,7 @@ import (
 const (
 	// DefaultBaseURL is the default base URL for the GoHighLevel API
 	DefaultBaseURL = "https://services.leadconnectorhq.com"
-	// OAuthTokenURL is the OAuth token endpoint
+	// OAuthTokenURL is the OAuth token endpoint of the production environment
 	OAuthTokenURL = "https://services.leadconnectorhq.com/oauth/token"
 	// DefaultTimeout is the default HTTP client timeout
 	DefaultTimeout = 30 * time.Second
@@ -37,6 +41,9 @@ type TokenResponse struct {
 	AgencyID          string `json:"agencyId,omitempty"`          // Only present in some responses
 	PlanID            string `json:"planId,omitempty"`            // Only present in some responses
 	ApprovalRequestID string `json:"approvalRequestId,omitempty"` // Only present in some responses
+
+	// Metadata of the client whose request triggered an automatic refresh (not part of the response)
+	Metadata Metadata `json:"-"`
 }
 
 // TokenRefreshCallback is called whenever tokens are automatically refreshed due to 401 errors.
@@ -44,33 +51,99 @@ type TokenResponse struct {
 // The callback receives the complete token response with all metadata.
 type TokenRefreshCallback func(tokenResponse TokenResponse)
 
+// tokenState holds the OAuth tokens of a client
+type tokenState struct {
+	mu           sync.RWMutex
+	refreshMu    sync.Mutex // Held while refreshing, so a single-use refresh token is sent only once
+	accessToken  string
+	refreshToken string
+	expiry       time.Time
+	authFailure  error          // Set when authorization failed permanently, cleared by new tokens
+	reported     *TokenResponse // What the token endpoint reported with the current tokens, if they came from it
+}
+
 // Client is the main GoHighLevel API client
 type Client struct {
 	// BaseURL is the base URL for API requests
 	BaseURL string
 
+	// baseURLOverrides maps API path prefixes to the base URL used for them
+	baseURLOverrides map[string]string
+
 	// HTTPClient is the underlying HTTP client used for requests
 	HTTPClient *http.Client
 
-	// OAuth credentials
+	// OAuth credentials and token endpoint
+	tokenURL     string
 	clientID     string
 	clientSecret string
 
-	// Access token management
-	accessToken  string
-	refreshToken string
-	tokenExpiry  time.Time
-	tokenMutex   sync.RWMutex
+	// Access token management, shared with clients derived from this one
+	tokens *tokenState
 
 	// LocationID is the default location ID for API requests
 	locationID string
 
 	// Token refresh configuration
 	onTokenRefresh   TokenRefreshCallback
+	onAuthFailure    AuthFailureCallback
 	autoRefreshOn401 bool
 
+	// Client-side request validation
+	validateRequests bool
+
+	// Retries and rate limiting
+	retryPolicy  *RetryPolicy
+	rateLimiter  RateLimiter
+	rateLimiters *RateLimiterSet
+	retryBudget  *RetryBudget
+	concurrency  *AdaptiveConcurrency
+	hedging      *HedgePolicy
+	rateLimits   *rateLimitState // Shared with clients derived from this one
+
+	// Read-through cache of ContactsService.Get, nil when disabled; shared with derived clients
+	contactCache *contactCache
+
+	// Pipelines by location for name-based resolution; shared with derived clients
+	pipelines *pipelineCache
+
+	// Largest response body accepted, 0 for no limit
+	maxResponseSize int64
+
+	// Request metadata and hooks
+	metadata  Metadata
+	onRequest RequestHook
+	onTiming  TimingHook
+	metrics   MetricsHook
+
 	// Resources
-	Contacts *ContactsService
+	Contacts        *ContactsService
+	Links           *LinksService
+	Emails          *EmailsService
+	Orders          *OrdersService
+	Subscriptions   *SubscriptionsService
+	CustomProviders *CustomProvidersService
+	Invoices        *InvoicesService
+	Products        *ProductsService
+	CustomObjects   *CustomObjectsService
+	Blogs           *BlogsService
+	SocialPlanner   *SocialPlannerService
+	Courses         *CoursesService
+	PhoneNumbers    *PhoneNumbersService
+	Reviews         *ReviewsService
+	Marketplace     *MarketplaceService
+	Messages        *MessagesService
+	Locations       *LocationsService
+	SaaS            *SaaSService
+	Opportunities   *OpportunitiesService
+	Forms           *FormsService
+	Surveys         *SurveysService
+	Media           *MediaService
+	Transactions    *TransactionsService
+	Coupons         *CouponsService
+	Relations       *RelationsService
+	Documents       *DocumentsService
+	Conversations   *ConversationsService
 }
 
 // Config holds configuration for the GoHighLevel client
@@ -81,9 +154,28 @@ type Config struct {
 	RefreshToken     string
 	LocationID       string
 	BaseURL          string
+	Environment      *Environment      // Sets BaseURL and the OAuth token URL together (default: production); BaseURL takes precedence
+	BaseURLOverrides map[string]string // Base URLs for API path prefixes, e.g. {"/contacts": "http://localhost:8080"}
 	HTTPClient       *http.Client
+	Timeout          time.Duration        // Request timeout of the default HTTP client (default: DefaultTimeout)
+	Transport        *TransportOptions    // Connection pool and HTTP/2 settings of the default HTTP client
 	OnTokenRefresh   TokenRefreshCallback // Called when tokens are automatically refreshed on 401
+	OnAuthFailure    AuthFailureCallback  // Called when token refresh fails permanently, e.g. with invalid_grant
 	AutoRefreshOn401 bool                 // Enable automatic token refresh on 401 errors (default: false)
+	ValidateRequests bool                 // Validate contact create/upsert requests client-side before sending (default: false)
+	RetryPolicy      *RetryPolicy         // Retry failed requests (default: nil, no retries)
+	RateLimiter      RateLimiter          // Pace outgoing requests (default: nil, no limit)
+	RateLimiters     *RateLimiterSet      // Pace requests per location, also in ForLocation clients; ignored when RateLimiter is set
+	RetryBudget      *RetryBudget         // Limit retries across all requests of the client (default: nil, no limit)
+	Concurrency      *AdaptiveConcurrency // Adaptively limit requests in flight (default: nil, no limit)
+	Hedging          *HedgePolicy         // Hedge slow GET requests (default: nil, no hedging)
+	Middleware       []Middleware         // Wrap the HTTP transport, outermost first
+	MaxResponseSize  int64                // Reject response bodies larger than this many bytes (default: 0, no limit)
+	OnRequest        RequestHook          // Called after every request attempt, e.g. for logging
+	OnTiming         TimingHook           // Called with the DNS/TLS/TTFB timing breakdown of every request
+	Metrics          MetricsHook          // Receives request, retry, token refresh and rate limit metrics
+	Metadata         Metadata             // Attached to every request, see Client.WithMetadata
+	ContactCache     *ContactCacheOptions // Cache contacts fetched with Contacts.Get (default: nil, no cache)
 }
 
 // NewClient creates a new GoHighLevel API client.
@@ -91,41 +183,210 @@ type Config struct {
 // If you only need to make API calls with an existing access token, you can omit them.
 func NewClient(config Config) (*Client, error) {
 
+	env := config.Environment
+	if env == nil {
+		env = ProductionEnvironment()
+	}
+
 	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = env.BaseURL
+	}
 	if baseURL == "" {
 		baseURL = DefaultBaseURL
 	}
 
 	httpClient := config.HTTPClient
 	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: DefaultTimeout,
-			Transport: &http.Transport{
-				MaxIdleConns:        100,
-				MaxIdleConnsPerHost: 10,
-				IdleConnTimeout:     90 * time.Second,
-			},
-		}
+		httpClient = newDefaultHTTPClient(config.Timeout, config.Transport)
+	} else if config.Transport != nil {
+		return nil, fmt.Errorf("transport options cannot be combined with a custom HTTPClient; configure its transport directly")
 	}
+	httpClient = withMiddleware(httpClient, config.Middleware)
 
 	c := &Client{
 		BaseURL:          baseURL,
+		baseURLOverrides: copyStringMap(config.BaseURLOverrides),
+		tokenURL:         env.tokenURL(),
 		HTTPClient:       httpClient,
 		clientID:         config.ClientID,
 		clientSecret:     config.ClientSecret,
-		accessToken:      config.AccessToken,
-		refreshToken:     config.RefreshToken,
+		tokens:           &tokenState{accessToken: config.AccessToken, refreshToken: config.RefreshToken},
 		locationID:       config.LocationID,
 		onTokenRefresh:   config.OnTokenRefresh,
+		onAuthFailure:    config.OnAuthFailure,
 		autoRefreshOn401: config.AutoRefreshOn401,
+		validateRequests: config.ValidateRequests,
+		retryPolicy:      config.RetryPolicy,
+		rateLimiter:      config.RateLimiter,
+		rateLimiters:     config.RateLimiters,
+		retryBudget:      config.RetryBudget,
+		concurrency:      config.Concurrency,
+		hedging:          config.Hedging,
+		rateLimits:       &rateLimitState{},
+		contactCache:     newContactCache(config.ContactCache),
+		pipelines:        newPipelineCache(),
+		maxResponseSize:  config.MaxResponseSize,
+		metadata:         config.Metadata.merge(nil),
+		onRequest:        config.OnRequest,
+		onTiming:         config.OnTiming,
+		metrics:          config.Metrics,
 	}
 
-	// Initialize services
-	c.Contacts = &ContactsService{client: c}
+	if c.rateLimiter != nil {
+		// An explicit RateLimiter takes precedence, including for clients from ForLocation
+		c.rateLimiters = nil
+	} else if c.rateLimiters != nil {
+		c.rateLimiter = c.rateLimiters.For(c.locationID)
+	}
+
+	c.initServices()
 
 	return c, nil
 }
 
+// initServices points every resource service at c
+func (c *Client) initServices() {
+	c.Contacts = &ContactsService{client: c}
+	c.Links = &LinksService{client: c}
+	c.Emails = &EmailsService{client: c}
+	c.Orders = &OrdersService{client: c}
+	c.Subscriptions = &SubscriptionsService{client: c}
+	c.CustomProviders = &CustomProvidersService{client: c}
+	c.Invoices = &InvoicesService{client: c}
+	c.Products = &ProductsService{client: c}
+	c.CustomObjects = &CustomObjectsService{client: c}
+	c.Blogs = &BlogsService{client: c}
+	c.SocialPlanner = &SocialPlannerService{client: c}
+	c.Courses = &CoursesService{client: c}
+	c.PhoneNumbers = &PhoneNumbersService{client: c}
+	c.Reviews = &ReviewsService{client: c}
+	c.Marketplace = &MarketplaceService{client: c}
+	c.Messages = &MessagesService{client: c}
+	c.Locations = &LocationsService{client: c}
+	c.SaaS = &SaaSService{client: c}
+	c.Opportunities = &OpportunitiesService{client: c}
+	c.Forms = &FormsService{client: c}
+	c.Surveys = &SurveysService{client: c}
+	c.Media = &MediaService{client: c}
+	c.Transactions = &TransactionsService{client: c}
+	c.Coupons = &CouponsService{client: c}
+	c.Relations = &RelationsService{client: c}
+	c.Documents = &DocumentsService{client: c}
+	c.Conversations = &ConversationsService{client: c}
+}
+
+// TransportOptions tunes the transport of the default HTTP client, so high-throughput services
+// don't have to replace the whole client. Zero values keep the defaults.
+type TransportOptions struct {
+	MaxIdleConns          int                                   // Idle connections kept across all hosts (default 100)
+	MaxIdleConnsPerHost   int                                   // Idle connections kept per host (default 10)
+	MaxConnsPerHost       int                                   // Limit on connections per host, including active ones (default: no limit)
+	IdleConnTimeout       time.Duration                         // How long idle connections are kept (default 90s)
+	TLSHandshakeTimeout   time.Duration                         // Limit on the TLS handshake (default: no limit)
+	ResponseHeaderTimeout time.Duration                         // Limit on waiting for response headers (default: no limit)
+	ForceAttemptHTTP2     bool                                  // Use HTTP/2 when available
+	Proxy                 func(*http.Request) (*url.URL, error) // Proxy selection, e.g. http.ProxyFromEnvironment (default: no proxy)
+}
+
+// derive returns a copy of c modified by modify, with its own services. The copy shares tokens,
+// transport and rate limit state with c.
+func (c *Client) derive(modify func(d *Client)) *Client {
+	derived := *c
+	modify(&derived)
+	derived.initServices()
+	return &derived
+}
+
+// WithBaseURL returns a client that sends every request to baseURL, ignoring base URL
+// overrides. It shares tokens and configuration with c, so it can be used for a single call:
+//
+//	contact, err := client.WithBaseURL("http://localhost:8080").Contacts.Get(contactID)
+func (c *Client) WithBaseURL(baseURL string) *Client {
+	return c.derive(func(d *Client) {
+		d.BaseURL = baseURL
+		d.baseURLOverrides = nil
+	})
+}
+
+// ForLocation returns a client for another location with its own tokens. It shares the HTTP
+// client and its connection pool, hooks, metrics and throttling with c, so an app serving
+// hundreds of locations can create a client per location from one configured client without
+// duplicating connection pools. With Config.RateLimiters set (and no Config.RateLimiter) the new
+// client is paced by the limiter of its location, otherwise by c's limiter. Contacts cached by the new client are kept
+// apart from those of other locations.
+func (c *Client) ForLocation(locationID, accessToken, refreshToken string) *Client {
+	return c.derive(func(d *Client) {
+		d.locationID = locationID
+		d.tokens = &tokenState{accessToken: accessToken, refreshToken: refreshToken}
+		if c.rateLimiters != nil {
+			d.rateLimiter = c.rateLimiters.For(locationID)
+		}
+		if c.contactCache != nil {
+			d.contactCache = c.contactCache.forLocation(locationID)
+		}
+	})
+}
+
+// baseURLFor returns the base URL for an API path: the override with the longest prefix
+// matching the path, or BaseURL. Prefixes match whole path segments, so "/contacts" matches
+// "/contacts/123" but not "/contactsfoo".
+func (c *Client) baseURLFor(path string) string {
+	baseURL, matched := c.BaseURL, 0
+	for prefix, override := range c.baseURLOverrides {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if len(prefix) <= matched || !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		if rest := path[len(prefix):]; rest != "" && rest[0] != '/' && rest[0] != '?' {
+			continue
+		}
+		baseURL, matched = override, len(prefix)
+	}
+	return baseURL
+}
+
+// copyStringMap returns a copy of m, or nil if m is empty
+func copyStringMap(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	copied := make(map[string]string, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied
+}
+
+// newDefaultHTTPClient returns the HTTP client used when none is configured
+func newDefaultHTTPClient(timeout time.Duration, opts *TransportOptions) *http.Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	transport := &http.Transport{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	if opts != nil {
+		if opts.MaxIdleConns > 0 {
+			transport.MaxIdleConns = opts.MaxIdleConns
+		}
+		if opts.MaxIdleConnsPerHost > 0 {
+			transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+		}
+		if opts.IdleConnTimeout > 0 {
+			transport.IdleConnTimeout = opts.IdleConnTimeout
+		}
+		transport.MaxConnsPerHost = opts.MaxConnsPerHost
+		transport.TLSHandshakeTimeout = opts.TLSHandshakeTimeout
+		transport.ResponseHeaderTimeout = opts.ResponseHeaderTimeout
+		transport.ForceAttemptHTTP2 = opts.ForceAttemptHTTP2
+		transport.Proxy = opts.Proxy
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
 // AuthorizeWithCode exchanges an authorization code for an access token.
 // Requires ClientID and ClientSecret to be set in the client config.
 func (c *Client) AuthorizeWithCode(code, redirectURI string) error {
@@ -161,34 +422,38 @@ func (c *Client) AuthorizeWithRefreshToken(refreshToken string) error {
 
 // SetAccessToken manually sets the access token
 func (c *Client) SetAccessToken(token string) {
-	c.tokenMutex.Lock()
-	defer c.tokenMutex.Unlock()
-	c.accessToken = token
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	c.tokens.accessToken = token
+	c.tokens.authFailure = nil
+	c.tokens.reported = nil
 }
 
 // SetTokens manually sets both access and refresh tokens
 func (c *Client) SetTokens(accessToken, refreshToken string, expiresIn int) {
-	c.tokenMutex.Lock()
-	defer c.tokenMutex.Unlock()
-	c.accessToken = accessToken
-	c.refreshToken = refreshToken
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	c.tokens.accessToken = accessToken
+	c.tokens.refreshToken = refreshToken
+	c.tokens.authFailure = nil
+	c.tokens.reported = nil
 	if expiresIn > 0 {
-		c.tokenExpiry = time.Now().Add(time.Duration(expiresIn) * time.Second)
+		c.tokens.expiry = time.Now().Add(time.Duration(expiresIn) * time.Second)
 	}
 }
 
 // GetAccessToken returns the current access token
 func (c *Client) GetAccessToken() string {
-	c.tokenMutex.RLock()
-	defer c.tokenMutex.RUnlock()
-	return c.accessToken
+	c.tokens.mu.RLock()
+	defer c.tokens.mu.RUnlock()
+	return c.tokens.accessToken
 }
 
 // GetRefreshToken returns the current refresh token
 func (c *Client) GetRefreshToken() string {
-	c.tokenMutex.RLock()
-	defer c.tokenMutex.RUnlock()
-	return c.refreshToken
+	c.tokens.mu.RLock()
+	defer c.tokens.mu.RUnlock()
+	return c.tokens.refreshToken
 }
 
 // SetLocationID sets the default location ID for API requests
@@ -201,6 +466,24 @@ func (c *Client) GetLocationID() string {
 	return c.locationID
 }
 
+// resolveLocationID returns locationID, or the client's default location ID when it is empty.
+// Services call it before validating a location ID, so an ID passed explicitly on a request
+// always overrides the default.
+func (c *Client) resolveLocationID(locationID string) string {
+	if locationID != "" {
+		return locationID
+	}
+	return c.locationID
+}
+
+// shallowCopy returns a copy of *v. Services fill in defaults such as the location ID on a
+// copy, so a request or options value the caller reuses, e.g. across ForLocation clients, is
+// never changed by a call.
+func shallowCopy[T any](v *T) *T {
+	copied := *v
+	return &copied
+}
+
 // refreshTokenInternal is an internal method that refreshes the token and calls the callback
 // This is used for automatic token refresh on 401 errors
 func (c *Client) refreshTokenInternal(refreshToken string) error {
@@ -214,7 +497,7 @@ func (c *Client) refreshTokenInternal(refreshToken string) error {
 	data.Set("grant_type", "refresh_token")
 	data.Set("refresh_token", refreshToken)
 
-	req, err := http.NewRequest("POST", OAuthTokenURL, bytes.NewBufferString(data.Encode()))
+	req, err := http.NewRequestWithContext(c.requestContext(context.Background()), "POST", c.tokenURL, bytes.NewBufferString(data.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create token request: %w", err)
 	}
@@ -235,7 +518,7 @@ func (c *Client) refreshTokenInternal(refreshToken string) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+		return newTokenError(resp.StatusCode, body)
 	}
 
 	var tokenResp TokenResponse
@@ -243,15 +526,18 @@ func (c *Client) refreshTokenInternal(refreshToken string) error {
 	if err := json.Unmarshal(body, &tokenResp); err != nil {
 		return fmt.Errorf("failed to parse token response: %w", err)
 	}
+	tokenResp.Metadata = c.Metadata()
 
 	// Update tokens
-	c.tokenMutex.Lock()
-	c.accessToken = tokenResp.AccessToken
-	c.refreshToken = tokenResp.RefreshToken
+	c.tokens.mu.Lock()
+	c.tokens.accessToken = tokenResp.AccessToken
+	c.tokens.refreshToken = tokenResp.RefreshToken
+	c.tokens.authFailure = nil
+	c.tokens.reported = &tokenResp
 	if tokenResp.ExpiresIn > 0 {
-		c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+		c.tokens.expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
 	}
-	c.tokenMutex.Unlock()
+	c.tokens.mu.Unlock()
 
 	// Call the callback if set (this is automatic refresh, so always call it)
 	if c.onTokenRefresh != nil {
@@ -263,7 +549,7 @@ func (c *Client) refreshTokenInternal(refreshToken string) error {
 
 // fetchToken fetches an access token from the OAuth endpoint
 func (c *Client) fetchToken(data url.Values) error {
-	req, err := http.NewRequest("POST", OAuthTokenURL, bytes.NewBufferString(data.Encode()))
+	req, err := http.NewRequestWithContext(c.requestContext(context.Background()), "POST", c.tokenURL, bytes.NewBufferString(data.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create token request: %w", err)
 	}
@@ -284,7 +570,7 @@ func (c *Client) fetchToken(data url.Values) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+		return newTokenError(resp.StatusCode, body)
 	}
 
 	var tokenResp TokenResponse
@@ -293,41 +579,87 @@ func (c *Client) fetchToken(data url.Values) error {
 		return fmt.Errorf("failed to parse token response: %w", err)
 	}
 
-	c.tokenMutex.Lock()
-	c.accessToken = tokenResp.AccessToken
-	c.refreshToken = tokenResp.RefreshToken
+	c.tokens.mu.Lock()
+	c.tokens.accessToken = tokenResp.AccessToken
+	c.tokens.refreshToken = tokenResp.RefreshToken
+	c.tokens.authFailure = nil
+	c.tokens.reported = &tokenResp
 	if tokenResp.ExpiresIn > 0 {
-		c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+		c.tokens.expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
 	}
-	c.tokenMutex.Unlock()
+	c.tokens.mu.Unlock()
 
 	return nil
 }
 
+// refreshAfter401 refreshes the tokens after a request made with usedRefreshToken got a 401.
+// Refreshes are serialized: when another request already replaced usedRefreshToken, the new
+// tokens are used as they are, since sending the old single-use refresh token again would fail
+// with invalid_grant and lock out a working installation.
+func (c *Client) refreshAfter401(usedRefreshToken string) error {
+	c.tokens.refreshMu.Lock()
+	defer c.tokens.refreshMu.Unlock()
+
+	c.tokens.mu.RLock()
+	currentRefreshToken := c.tokens.refreshToken
+	authErr := c.tokens.authFailure
+	c.tokens.mu.RUnlock()
+
+	if currentRefreshToken != usedRefreshToken {
+		return nil
+	}
+	if authErr != nil {
+		return fmt.Errorf("%w: %w", ErrAuthorizationFailed, authErr)
+	}
+
+	err := c.refreshTokenInternal(currentRefreshToken)
+	if c.metrics != nil {
+		c.metrics.ObserveTokenRefresh(err)
+	}
+	if err != nil && isPermanentAuthError(err) {
+		c.markAuthFailed(currentRefreshToken, err)
+	}
+	return err
+}
+
 // doRequest performs an HTTP request with the access token
 func (c *Client) doRequest(method, path string, body interface{}, result interface{}) error {
+	return c.doRequestContext(context.Background(), method, path, body, result)
+}
+
+// doRequestContext performs an HTTP request with the access token, aborting it and its retries
+// when ctx is cancelled
+func (c *Client) doRequestContext(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	if err := c.revokedError(); err != nil {
+		return err
+	}
+
+	// Remember which refresh token was current, to tell after a 401 whether another request
+	// has refreshed the tokens in the meantime
+	c.tokens.mu.RLock()
+	usedRefreshToken := c.tokens.refreshToken
+	c.tokens.mu.RUnlock()
+
 	// First attempt
-	statusCode, respBody, err := c.executeRequest(method, path, body)
+	statusCode, respBody, err := c.sendRequest(ctx, method, path, body, result)
 
 	// Check if we got a 401 and should auto-refresh
 	if statusCode == http.StatusUnauthorized && c.autoRefreshOn401 {
-		// Check if we have the necessary credentials to refresh
-		c.tokenMutex.RLock()
-		hasRefreshToken := c.refreshToken != ""
-		hasCredentials := c.clientID != "" && c.clientSecret != ""
-		currentRefreshToken := c.refreshToken
-		c.tokenMutex.RUnlock()
+		// A refresh token that was already rejected will not work this time either
+		if authErr := c.authFailure(); authErr != nil {
+			return fmt.Errorf("API request failed with status %d: %w: %w", statusCode, ErrAuthorizationFailed, authErr)
+		}
 
-		if hasRefreshToken && hasCredentials {
-			// Attempt to refresh the token
-			refreshErr := c.refreshTokenInternal(currentRefreshToken)
-			if refreshErr != nil {
+		// Without a refresh token or client credentials the 401 is returned as is
+		hasCredentials := c.clientID != "" && c.clientSecret != ""
+		if usedRefreshToken != "" && hasCredentials {
+			if refreshErr := c.refreshAfter401(usedRefreshToken); refreshErr != nil {
 				// Refresh failed, return original error
 				return fmt.Errorf("API request failed with status %d: %s (token refresh failed: %w)", statusCode, string(respBody), refreshErr)
 			}
 
 			// Retry the request with new token
-			statusCode, respBody, err = c.executeRequest(method, path, body)
+			statusCode, respBody, err = c.sendRequest(ctx, method, path, body, result)
 		}
 	}
 
@@ -337,62 +669,287 @@ func (c *Client) doRequest(method, path string, body interface{}, result interfa
 	}
 
 	if statusCode < 200 || statusCode >= 300 {
-		return fmt.Errorf("API request failed with status %d: %s", statusCode, string(respBody))
-	}
-
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to parse response: %w", err)
-		}
+		return &statusError{StatusCode: statusCode, Body: string(respBody)}
 	}
 
 	return nil
 }
 
-// executeRequest performs the actual HTTP request and returns status code, body, and error
-func (c *Client) executeRequest(method, path string, body interface{}) (int, []byte, error) {
-	c.tokenMutex.RLock()
-	token := c.accessToken
-	c.tokenMutex.RUnlock()
+// statusError is returned by doRequest for a response with an unsuccessful status
+type statusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// hasStatus reports whether err is the error for a response with the given status
+func hasStatus(err error, statusCode int) bool {
+	var statusErr *statusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == statusCode
+}
+
+// executeRequest performs the actual HTTP request and returns status code, headers, body, and error.
+// A successful response is decoded straight into result as it streams in, so the returned body is
+// only populated for error responses, or when result is nil.
+func (c *Client) executeRequest(ctx context.Context, method, path string, body, result interface{}) (statusCode int, header http.Header, respBody []byte, err error) {
+	c.tokens.mu.RLock()
+	token := c.tokens.accessToken
+	c.tokens.mu.RUnlock()
 
 	if token == "" {
-		return 0, nil, fmt.Errorf("no access token available, please authorize first")
+		return 0, nil, nil, fmt.Errorf("no access token available, please authorize first")
 	}
 
 	var bodyReader io.Reader
-	if body != nil {
+	contentType := "application/json"
+	if form, ok := body.(*multipartBody); ok {
+		if bodyReader, err = form.reader(); err != nil {
+			return 0, nil, nil, err
+		}
+		contentType = form.contentType
+		if form.ctx != nil {
+			// Cancelling the upload's context aborts the request, but keeps the client's metadata
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			defer cancel()
+			defer context.AfterFunc(form.ctx, cancel)()
+		}
+		if form.onProgress != nil {
+			bodyReader = NewProgressReader(ctx, bodyReader, form.length(), form.onProgress)
+		}
+	} else if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
-			return 0, nil, fmt.Errorf("failed to marshal request body: %w", err)
+			return 0, nil, nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		bodyReader = bytes.NewBuffer(jsonData)
 	}
 
-	fullURL := c.BaseURL + path
-	req, err := http.NewRequest(method, fullURL, bodyReader)
+	if c.onTiming != nil {
+		var timer *requestTimer
+		timer, ctx = newRequestTimer(ctx, method, path)
+		defer func() { c.onTiming(timer.finish(err, c.Metadata())) }()
+	}
+
+	fullURL := c.baseURLFor(path) + path
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, bodyReader)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if form, ok := body.(*multipartBody); ok {
+		// Streamed bodies are not a type net/http can size by itself
+		req.ContentLength = form.length()
 	}
 
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Version", "2021-07-28")
 	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return 0, nil, fmt.Errorf("request failed: %w", err)
+		return 0, nil, nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	// A streamed result takes ownership of the body, so it is neither read nor closed here
+	if stream, ok := result.(*streamResult); ok && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		stream.body = resp.Body
+		stream.contentType = resp.Header.Get("Content-Type")
+		stream.contentLength = resp.ContentLength
+		return resp.StatusCode, resp.Header, nil, nil
 	}
+
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
-	respBody, err := io.ReadAll(resp.Body)
+	respReader := io.Reader(resp.Body)
+	if c.maxResponseSize > 0 {
+		respReader = &limitedReader{r: resp.Body, remaining: c.maxResponseSize}
+	}
+
+	if result != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := json.NewDecoder(respReader).Decode(result); err != nil && err != io.EOF {
+			if errors.Is(err, ErrResponseTooLarge) {
+				return resp.StatusCode, resp.Header, nil, err
+			}
+			return resp.StatusCode, resp.Header, nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		// Drain what is left (usually a trailing newline) so the connection can be reused
+		_, _ = io.Copy(io.Discard, io.LimitReader(respReader, 4096))
+		return resp.StatusCode, resp.Header, nil, nil
+	}
+
+	respBody, err = io.ReadAll(respReader)
+	if err != nil {
+		return resp.StatusCode, resp.Header, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return resp.StatusCode, resp.Header, respBody, nil
+}
+
+// requestContext returns ctx carrying the client's metadata, for requests sent by the client
+func (c *Client) requestContext(ctx context.Context) context.Context {
+	return ContextWithMetadata(ctx, c.metadata)
+}
+
+// ErrResponseTooLarge is returned when a response body exceeds Config.MaxResponseSize
+var ErrResponseTooLarge = errors.New("response body exceeds the maximum response size")
+
+// limitedReader reads at most remaining bytes and fails with ErrResponseTooLarge if the
+// underlying reader has more
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for more data to tell a body of exactly the limit apart from a larger one
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// multipartBody is a request body encoded as multipart/form-data. It is either kept in memory, or
+// streams a seekable file between an encoded prefix and suffix; both can be replayed after a
+// token refresh.
+type multipartBody struct {
+	contentType string
+	data        []byte // The whole body, unless file is set
+
+	// Streamed file: fileSize bytes from fileStart, sent between prefix and suffix
+	file      io.ReadSeeker
+	fileStart int64
+	fileSize  int64
+	prefix    []byte
+	suffix    []byte
+
+	// Optional context and progress callback of an upload, applied to every attempt
+	ctx        context.Context
+	onProgress ProgressFunc
+}
+
+// streamResult is a request result that receives the raw response body instead of having it
+// decoded as JSON, for endpoints that return files. The caller must close body.
+type streamResult struct {
+	body          io.ReadCloser
+	contentType   string
+	contentLength int64
+}
+
+// newMultipartBody encodes a file upload plus optional form fields as multipart/form-data
+func newMultipartBody(fieldName, fileName string, file io.Reader, fields map[string]string) (*multipartBody, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, fmt.Errorf("failed to write form field %s: %w", key, err)
+		}
+	}
+
+	part, err := writer.CreateFormFile(fieldName, fileName)
 	if err != nil {
-		return resp.StatusCode, nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode multipart body: %w", err)
 	}
 
-	return resp.StatusCode, respBody, nil
+	return &multipartBody{contentType: writer.FormDataContentType(), data: buf.Bytes()}, nil
+}
+
+// newStreamingMultipartBody encodes a file upload plus optional form fields as multipart/form-data
+// without reading the file into memory. The file is read from its current offset to its end each
+// time the request is sent.
+func newStreamingMultipartBody(fieldName, fileName string, file io.ReadSeeker, fields map[string]string) (*multipartBody, error) {
+	start, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	end, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, fmt.Errorf("failed to write form field %s: %w", key, err)
+		}
+	}
+	if _, err := writer.CreateFormFile(fieldName, fileName); err != nil {
+		return nil, fmt.Errorf("failed to create form file: %w", err)
+	}
+	prefix := bytes.Clone(buf.Bytes())
+
+	buf.Reset()
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode multipart body: %w", err)
+	}
+
+	return &multipartBody{
+		contentType: writer.FormDataContentType(),
+		file:        file,
+		fileStart:   start,
+		fileSize:    end - start,
+		prefix:      prefix,
+		suffix:      bytes.Clone(buf.Bytes()),
+	}, nil
+}
+
+// length returns the size of the encoded body
+func (b *multipartBody) length() int64 {
+	if b.file == nil {
+		return int64(len(b.data))
+	}
+	return int64(len(b.prefix)) + b.fileSize + int64(len(b.suffix))
+}
+
+// reader returns a reader of the whole body from the start
+func (b *multipartBody) reader() (io.Reader, error) {
+	if b.file == nil {
+		return bytes.NewReader(b.data), nil
+	}
+	if _, err := b.file.Seek(b.fileStart, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind upload: %w", err)
+	}
+	return io.MultiReader(bytes.NewReader(b.prefix), io.LimitReader(b.file, b.fileSize), bytes.NewReader(b.suffix)), nil
+}
+
+// locationQuery returns the locationId query parameter used by most location-scoped endpoints
+func locationQuery(locationID string) url.Values {
+	query := url.Values{}
+	query.Set("locationId", locationID)
+	return query
+}
+
+// altLocationQuery returns the altId/altType query parameters identifying a location,
+// as used by the payments, invoices and store endpoints
+func altLocationQuery(locationID string) url.Values {
+	query := url.Values{}
+	query.Set("altId", locationID)
+	query.Set("altType", "location")
+	return query
 }
@@ -0,0 +1,226 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_ResolveLocationID(t *testing.T) {
+	client, _ := NewClient(Config{LocationID: "default-loc"})
+
+	if got := client.resolveLocationID(""); got != "default-loc" {
+		t.Errorf("resolveLocationID(\"\") = %q, want default-loc", got)
+	}
+	if got := client.resolveLocationID("other-loc"); got != "other-loc" {
+		t.Errorf("resolveLocationID(\"other-loc\") = %q, want other-loc", got)
+	}
+}
+
+func TestClient_DefaultLocationInjection(t *testing.T) {
+	var gotLocation string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LocationID string `json:"locationId"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotLocation = body.LocationID
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "default-loc"})
+
+	if _, err := client.Contacts.Create(&CreateContactRequest{FirstName: "Ada"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if gotLocation != "default-loc" {
+		t.Errorf("locationId = %q, want default-loc", gotLocation)
+	}
+
+	if _, err := client.Contacts.Create(&CreateContactRequest{LocationID: "other-loc", FirstName: "Ada"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if gotLocation != "other-loc" {
+		t.Errorf("locationId = %q, want other-loc override", gotLocation)
+	}
+
+	client.SetLocationID("")
+	if _, err := client.Contacts.Create(&CreateContactRequest{FirstName: "Ada"}); err == nil {
+		t.Error("expected error without a location ID")
+	}
+}
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	payload := `{"contact":{"id":"c1","firstName":"` + strings.Repeat("a", 100) + `"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/contacts/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Contact not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, MaxResponseSize: int64(len(payload))})
+	contact, err := client.Contacts.Get("c1")
+	if err != nil {
+		t.Fatalf("Get at exactly the limit failed: %v", err)
+	}
+	if contact.ID != "c1" {
+		t.Errorf("contact ID = %q, want c1", contact.ID)
+	}
+
+	_, err = client.Contacts.Get("missing")
+	if err == nil || !strings.Contains(err.Error(), "Contact not found") {
+		t.Errorf("error response = %v, want the API message", err)
+	}
+
+	client, _ = NewClient(Config{AccessToken: "token", BaseURL: server.URL, MaxResponseSize: 50})
+	if _, err := client.Contacts.Get("c1"); !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Get over the limit = %v, want ErrResponseTooLarge", err)
+	}
+}
+
+func TestClient_BaseURLFor(t *testing.T) {
+	client, _ := NewClient(Config{
+		BaseURL: "https://api",
+		BaseURLOverrides: map[string]string{
+			"/contacts":          "https://contacts-proxy",
+			"/contacts/business": "https://business-mock",
+			"/invoices/":         "https://invoices-mock",
+		},
+	})
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/contacts/", "https://contacts-proxy"},
+		{"/contacts/abc", "https://contacts-proxy"},
+		{"/contacts?locationId=x", "https://contacts-proxy"},
+		{"/contacts/business/123", "https://business-mock"},
+		{"/contactsfoo", "https://api"},
+		{"/invoices/123", "https://invoices-mock"},
+		{"/products/", "https://api"},
+	}
+	for _, tt := range tests {
+		if got := client.baseURLFor(tt.path); got != tt.want {
+			t.Errorf("baseURLFor(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	perCall := client.WithBaseURL("https://staging")
+	if got := perCall.baseURLFor("/contacts/abc"); got != "https://staging" {
+		t.Errorf("per-call baseURLFor = %q, want https://staging", got)
+	}
+	if got := client.baseURLFor("/products/"); got != "https://api" {
+		t.Errorf("parent client changed by WithBaseURL: %q", got)
+	}
+}
+
+func TestClient_ForLocation(t *testing.T) {
+	var mu sync.Mutex
+	tokensSeen := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokensSeen[r.URL.Path] = r.Header.Get("Authorization")
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	limiters := NewRateLimiterSet(func() RateLimiter { return NewRateLimiter(100, 10*time.Second) })
+	base, _ := NewClient(Config{BaseURL: server.URL, RateLimiters: limiters, ContactCache: &ContactCacheOptions{}})
+
+	a := base.ForLocation("loc-a", "token-a", "refresh-a")
+	b := base.ForLocation("loc-b", "token-b", "refresh-b")
+
+	if a.HTTPClient != base.HTTPClient || b.HTTPClient != base.HTTPClient {
+		t.Error("location clients do not share the HTTP client")
+	}
+	if a.rateLimiter != limiters.For("loc-a") || a.rateLimiter == b.rateLimiter {
+		t.Error("location clients are not paced by their location's limiter")
+	}
+	if a.GetLocationID() != "loc-a" || a.GetRefreshToken() != "refresh-a" || base.GetAccessToken() != "" {
+		t.Error("tokens or location leaked between clients")
+	}
+
+	if _, err := a.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if tokensSeen["/contacts/c1"] != "Bearer token-a" {
+		t.Errorf("request sent with %q", tokensSeen["/contacts/c1"])
+	}
+	// b must not be served a contact cached by a
+	delete(tokensSeen, "/contacts/c1")
+	if _, err := b.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if tokensSeen["/contacts/c1"] != "Bearer token-b" {
+		t.Errorf("location b was served from location a's cache")
+	}
+
+	a.SetAccessToken("rotated")
+	if b.GetAccessToken() != "token-b" {
+		t.Error("token change leaked to another location")
+	}
+}
+
+func TestClient_ForLocation_ExplicitRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(10, time.Second)
+	limiters := NewRateLimiterSet(func() RateLimiter { return NewRateLimiter(100, 10*time.Second) })
+	base, _ := NewClient(Config{RateLimiter: limiter, RateLimiters: limiters})
+
+	if a := base.ForLocation("loc-a", "token-a", "refresh-a"); a.rateLimiter != limiter {
+		t.Error("the explicit rate limiter was replaced by the location's limiter")
+	}
+}
+
+func TestClient_ForLocation_ReusedRequest(t *testing.T) {
+	var mu sync.Mutex
+	var locations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LocationID string `json:"locationId"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		locations = append(locations, body.LocationID)
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"},"contacts":[]}`))
+	}))
+	defer server.Close()
+
+	base, _ := NewClient(Config{BaseURL: server.URL})
+	a := base.ForLocation("loc-a", "token-a", "refresh-a")
+	b := base.ForLocation("loc-b", "token-b", "refresh-b")
+
+	create := &CreateContactRequest{FirstName: "John"}
+	search := &SearchContactsRequest{Sort: []ContactSort{{Field: ContactSortDateAdded}}}
+	for _, client := range []*Client{a, b} {
+		if _, err := client.Contacts.Create(create); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if _, err := client.Contacts.Search(search); err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+	}
+
+	want := []string{"loc-a", "loc-a", "loc-b", "loc-b"}
+	if strings.Join(locations, ",") != strings.Join(want, ",") {
+		t.Errorf("requests sent for locations %v, want %v", locations, want)
+	}
+	if create.LocationID != "" || search.LocationID != "" || search.PageLimit != 0 {
+		t.Error("the caller's request was modified")
+	}
+	if search.Sort[0].Direction != "" {
+		t.Error("the caller's sort was modified")
+	}
+}
@@ -0,0 +1,235 @@
+package gohighlevel
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Defaults of ContactCacheOptions
+const (
+	DefaultContactCacheTTL        = time.Minute
+	DefaultContactCacheMaxEntries = 1000
+)
+
+// CacheBackend stores cached API responses. Values are JSON-encoded, so a backend can be an
+// external store such as Redis as well as in-process memory. Implementations must be safe for
+// concurrent use.
+type CacheBackend interface {
+	// Get returns the value stored under key, or false if it is missing or expired
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Delete(key string)
+}
+
+// ContactCacheOptions configures the read-through cache in front of ContactsService.Get.
+// Contacts changed or deleted through the client are evicted; changes made elsewhere are seen
+// once the TTL expires, or earlier if ContactsService.InvalidateCache is called, e.g. from a
+// ContactUpdate webhook handler.
+type ContactCacheOptions struct {
+	TTL        time.Duration // How long a contact is served from the cache (default: DefaultContactCacheTTL)
+	MaxEntries int           // Size of the default in-memory backend (default: DefaultContactCacheMaxEntries)
+	Backend    CacheBackend  // Where contacts are stored (default: a MemoryCache of MaxEntries)
+}
+
+// contactCache is the contact cache of a client, shared with clients derived from it
+type contactCache struct {
+	backend   CacheBackend
+	ttl       time.Duration
+	namespace string        // Prefix of the keys, keeping the contacts of location clients apart
+	fetches   *cacheFetches // Shared by all namespaces, as it is keyed by full key
+}
+
+// cacheFetches tracks the fetches in flight for each key, so that a fetch that started before
+// an invalidation does not put the contact it read back into the cache
+type cacheFetches struct {
+	mu   sync.Mutex
+	keys map[string]*keyFetches
+}
+
+type keyFetches struct {
+	inFlight   int
+	generation uint64 // Incremented by every invalidation of the key
+}
+
+// newContactCache returns the cache described by opts, or nil if opts is nil
+func newContactCache(opts *ContactCacheOptions) *contactCache {
+	if opts == nil {
+		return nil
+	}
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = DefaultContactCacheTTL
+	}
+	backend := opts.Backend
+	if backend == nil {
+		backend = NewMemoryCache(opts.MaxEntries)
+	}
+	return &contactCache{backend: backend, ttl: ttl, fetches: &cacheFetches{keys: make(map[string]*keyFetches)}}
+}
+
+// forLocation returns a cache sharing c's backend, with keys of its own for a location
+func (c *contactCache) forLocation(locationID string) *contactCache {
+	return &contactCache{backend: c.backend, ttl: c.ttl, namespace: "locations/" + locationID + "/", fetches: c.fetches}
+}
+
+func (c *contactCache) key(contactID string) string {
+	return c.namespace + "contacts/" + contactID
+}
+
+// get returns a copy of a cached contact
+func (c *contactCache) get(contactID string) (*Contact, bool) {
+	data, ok := c.backend.Get(c.key(contactID))
+	if !ok {
+		return nil, false
+	}
+	var contact Contact
+	if err := json.Unmarshal(data, &contact); err != nil {
+		c.backend.Delete(c.key(contactID))
+		return nil, false
+	}
+	return &contact, true
+}
+
+// beginFetch registers a fetch of a contact from the API and returns the generation to pass to
+// endFetch
+func (c *contactCache) beginFetch(contactID string) uint64 {
+	c.fetches.mu.Lock()
+	defer c.fetches.mu.Unlock()
+	key := c.key(contactID)
+	state, ok := c.fetches.keys[key]
+	if !ok {
+		state = &keyFetches{}
+		c.fetches.keys[key] = state
+	}
+	state.inFlight++
+	return state.generation
+}
+
+// endFetch ends a fetch started with beginFetch, caching contact unless it is nil or the contact
+// was invalidated while it was fetched
+func (c *contactCache) endFetch(contactID string, generation uint64, contact *Contact) {
+	c.fetches.mu.Lock()
+	defer c.fetches.mu.Unlock()
+	key := c.key(contactID)
+	state := c.fetches.keys[key]
+	if contact != nil && contact.ID == contactID && state.generation == generation {
+		if data, err := json.Marshal(contact); err == nil {
+			c.backend.Set(key, data, c.ttl)
+		}
+	}
+	if state.inFlight--; state.inFlight == 0 {
+		delete(c.fetches.keys, key)
+	}
+}
+
+func (c *contactCache) invalidate(contactIDs ...string) {
+	c.fetches.mu.Lock()
+	for _, id := range contactIDs {
+		if state, ok := c.fetches.keys[c.key(id)]; ok {
+			state.generation++
+		}
+	}
+	c.fetches.mu.Unlock()
+
+	for _, id := range contactIDs {
+		c.backend.Delete(c.key(id))
+	}
+}
+
+// InvalidateCache evicts contacts from the client's contact cache, so the next Get fetches
+// them from the API. It does nothing if the cache is disabled.
+func (s *ContactsService) InvalidateCache(contactIDs ...string) {
+	if s.client.contactCache != nil {
+		s.client.contactCache.invalidate(contactIDs...)
+	}
+}
+
+// MemoryCache is an in-process CacheBackend that evicts the least recently used entry once
+// full
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // Front is most recently used
+	now        func() time.Time
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries entries
+// (DefaultContactCacheMaxEntries if maxEntries <= 0)
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultContactCacheMaxEntries
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get implements CacheBackend
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*memoryCacheEntry)
+	if !m.now().Before(entry.expiresAt) {
+		m.remove(elem)
+		return nil, false
+	}
+	m.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set implements CacheBackend
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expiresAt := m.now().Add(ttl)
+	if elem, ok := m.entries[key]; ok {
+		entry := elem.Value.(*memoryCacheEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete implements CacheBackend
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet evicted
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+func (m *MemoryCache) remove(elem *list.Element) {
+	m.order.Remove(elem)
+	delete(m.entries, elem.Value.(*memoryCacheEntry).key)
+}
@@ -0,0 +1,148 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestContactCache_ReadThrough(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			atomic.AddInt32(&gets, 1)
+		}
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1","firstName":"Ada","tags":["vip"]}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, ContactCache: &ContactCacheOptions{}})
+
+	first, err := client.Contacts.Get("c1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	first.Tags[0] = "changed"
+
+	second, err := client.Contacts.Get("c1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := atomic.LoadInt32(&gets); n != 1 {
+		t.Errorf("API called %d times, want 1", n)
+	}
+	if second.FirstName != "Ada" || second.Tags[0] != "vip" {
+		t.Errorf("cached contact = %+v, want an unmodified copy", second)
+	}
+
+	// Writes through the client evict the contact
+	if err := client.Contacts.AddTags("c1", []string{"new"}); err != nil {
+		t.Fatalf("AddTags failed: %v", err)
+	}
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := atomic.LoadInt32(&gets); n != 2 {
+		t.Errorf("API called %d times after AddTags, want 2", n)
+	}
+
+	client.Contacts.InvalidateCache("c1")
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := atomic.LoadInt32(&gets); n != 3 {
+		t.Errorf("API called %d times after InvalidateCache, want 3", n)
+	}
+}
+
+func TestContactCache_InvalidateDuringGet(t *testing.T) {
+	var gets int32
+	fetching, release := make(chan struct{}), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&gets, 1) == 1 {
+			// Hold the first response until the contact has been invalidated
+			close(fetching)
+			<-release
+			_, _ = w.Write([]byte(`{"contact":{"id":"c1","firstName":"Old"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1","firstName":"New"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, ContactCache: &ContactCacheOptions{}})
+
+	done := make(chan *Contact)
+	go func() {
+		contact, _ := client.Contacts.Get("c1")
+		done <- contact
+	}()
+	<-fetching
+	client.Contacts.InvalidateCache("c1")
+	close(release)
+	if stale := <-done; stale == nil || stale.FirstName != "Old" {
+		t.Fatalf("first Get = %+v", stale)
+	}
+
+	contact, err := client.Contacts.Get("c1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if contact.FirstName != "New" || atomic.LoadInt32(&gets) != 2 {
+		t.Errorf("Get after invalidation = %+v after %d API calls, want the fresh contact", contact, gets)
+	}
+	if n := len(client.contactCache.fetches.keys); n != 0 {
+		t.Errorf("%d keys still tracked after the fetches ended", n)
+	}
+}
+
+func TestContactCache_Disabled(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&gets, 1)
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	_, _ = client.Contacts.Get("c1")
+	_, _ = client.Contacts.Get("c1")
+	client.Contacts.InvalidateCache("c1")
+
+	if n := atomic.LoadInt32(&gets); n != 2 {
+		t.Errorf("API called %d times, want 2", n)
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+	cache.Get("a") // a is now more recently used than b
+	cache.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("least recently used entry b was not evicted")
+	}
+	if v, ok := cache.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("Get(a) = %q, %v", v, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expired entry returned")
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Len = %d, want 1", cache.Len())
+	}
+
+	cache.Delete("c")
+	if _, ok := cache.Get("c"); ok {
+		t.Error("deleted entry returned")
+	}
+}
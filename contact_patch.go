@@ -0,0 +1,181 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ContactPatch builds a contact update that contains exactly the fields the caller sets or clears.
+// Unlike UpdateContactRequest, where empty values are omitted and so cannot be cleared, a field
+// cleared on a patch is sent as null, and fields that are not mentioned are never sent.
+//
+//	patch := NewContactPatch().SetEmail("ada@example.com").ClearPhone()
+//	contact, err := client.Contacts.Patch(contactID, patch)
+type ContactPatch struct {
+	fields       map[string]interface{}
+	customFields []CustomField
+}
+
+// NewContactPatch returns an empty contact patch
+func NewContactPatch() *ContactPatch {
+	return &ContactPatch{fields: make(map[string]interface{})}
+}
+
+func (p *ContactPatch) set(key string, value interface{}) *ContactPatch {
+	p.fields[key] = value
+	return p
+}
+
+// SetFirstName sets the first name
+func (p *ContactPatch) SetFirstName(v string) *ContactPatch { return p.set("firstName", v) }
+
+// ClearFirstName clears the first name
+func (p *ContactPatch) ClearFirstName() *ContactPatch { return p.set("firstName", nil) }
+
+// SetLastName sets the last name
+func (p *ContactPatch) SetLastName(v string) *ContactPatch { return p.set("lastName", v) }
+
+// ClearLastName clears the last name
+func (p *ContactPatch) ClearLastName() *ContactPatch { return p.set("lastName", nil) }
+
+// SetName sets the full name
+func (p *ContactPatch) SetName(v string) *ContactPatch { return p.set("name", v) }
+
+// SetEmail sets the email address
+func (p *ContactPatch) SetEmail(v string) *ContactPatch { return p.set("email", v) }
+
+// ClearEmail clears the email address
+func (p *ContactPatch) ClearEmail() *ContactPatch { return p.set("email", nil) }
+
+// SetPhone sets the phone number
+func (p *ContactPatch) SetPhone(v string) *ContactPatch { return p.set("phone", v) }
+
+// ClearPhone clears the phone number
+func (p *ContactPatch) ClearPhone() *ContactPatch { return p.set("phone", nil) }
+
+// SetAddress1 sets the street address
+func (p *ContactPatch) SetAddress1(v string) *ContactPatch { return p.set("address1", v) }
+
+// ClearAddress1 clears the street address
+func (p *ContactPatch) ClearAddress1() *ContactPatch { return p.set("address1", nil) }
+
+// SetCity sets the city
+func (p *ContactPatch) SetCity(v string) *ContactPatch { return p.set("city", v) }
+
+// SetState sets the state
+func (p *ContactPatch) SetState(v string) *ContactPatch { return p.set("state", v) }
+
+// SetPostalCode sets the postal code
+func (p *ContactPatch) SetPostalCode(v string) *ContactPatch { return p.set("postalCode", v) }
+
+// SetCountry sets the country code
+func (p *ContactPatch) SetCountry(v string) *ContactPatch { return p.set("country", v) }
+
+// SetCompanyName sets the company name
+func (p *ContactPatch) SetCompanyName(v string) *ContactPatch { return p.set("companyName", v) }
+
+// ClearCompanyName clears the company name
+func (p *ContactPatch) ClearCompanyName() *ContactPatch { return p.set("companyName", nil) }
+
+// SetWebsite sets the website
+func (p *ContactPatch) SetWebsite(v string) *ContactPatch { return p.set("website", v) }
+
+// ClearWebsite clears the website
+func (p *ContactPatch) ClearWebsite() *ContactPatch { return p.set("website", nil) }
+
+// SetSource sets the contact source
+func (p *ContactPatch) SetSource(v string) *ContactPatch { return p.set("source", v) }
+
+// SetTimezone sets the timezone
+func (p *ContactPatch) SetTimezone(v string) *ContactPatch { return p.set("timezone", v) }
+
+// SetDateOfBirth sets the date of birth
+func (p *ContactPatch) SetDateOfBirth(v Date) *ContactPatch { return p.set("dateOfBirth", v) }
+
+// ClearDateOfBirth clears the date of birth
+func (p *ContactPatch) ClearDateOfBirth() *ContactPatch { return p.set("dateOfBirth", nil) }
+
+// SetDND sets the global do-not-disturb flag
+func (p *ContactPatch) SetDND(v bool) *ContactPatch { return p.set("dnd", v) }
+
+// SetDNDSettings sets the per-channel do-not-disturb settings
+func (p *ContactPatch) SetDNDSettings(v *DNDSettings) *ContactPatch { return p.set("dndSettings", v) }
+
+// SetAssignedTo assigns the contact to a user
+func (p *ContactPatch) SetAssignedTo(userID string) *ContactPatch { return p.set("assignedTo", userID) }
+
+// ClearAssignedTo unassigns the contact
+func (p *ContactPatch) ClearAssignedTo() *ContactPatch { return p.set("assignedTo", nil) }
+
+// SetTags replaces all tags of the contact. Use ContactsService.AddTags/RemoveTags to change
+// individual tags without a read-modify-write.
+func (p *ContactPatch) SetTags(tags []string) *ContactPatch {
+	if tags == nil {
+		tags = []string{}
+	}
+	return p.set("tags", tags)
+}
+
+// SetCustomField sets a custom field value by field ID
+func (p *ContactPatch) SetCustomField(id string, value interface{}) *ContactPatch {
+	p.customFields = append(p.customFields, CustomField{ID: id, Value: value})
+	return p
+}
+
+// ClearCustomField clears a custom field by field ID
+func (p *ContactPatch) ClearCustomField(id string) *ContactPatch {
+	p.customFields = append(p.customFields, CustomField{ID: id, Value: ""})
+	return p
+}
+
+// Fields returns the names of the fields the patch changes, sorted
+func (p *ContactPatch) Fields() []string {
+	names := make([]string, 0, len(p.fields)+1)
+	for name := range p.fields {
+		names = append(names, name)
+	}
+	if len(p.customFields) > 0 {
+		names = append(names, "customField")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsEmpty reports whether the patch changes nothing
+func (p *ContactPatch) IsEmpty() bool {
+	return len(p.fields) == 0 && len(p.customFields) == 0
+}
+
+// MarshalJSON implements json.Marshaler
+func (p *ContactPatch) MarshalJSON() ([]byte, error) {
+	body := make(map[string]interface{}, len(p.fields)+1)
+	for name, value := range p.fields {
+		body[name] = value
+	}
+	if len(p.customFields) > 0 {
+		body["customField"] = p.customFields
+	}
+	return json.Marshal(body)
+}
+
+// Patch updates only the fields set or cleared on the patch
+// Required scope: contacts.write
+func (s *ContactsService) Patch(contactID string, patch *ContactPatch) (*Contact, error) {
+	if contactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+	if patch == nil || patch.IsEmpty() {
+		return nil, fmt.Errorf("patch has no changes")
+	}
+
+	defer s.InvalidateCache(contactID)
+
+	var result ContactResponse
+	err := s.client.doRequest("PUT", fmt.Sprintf("/contacts/%s", contactID), patch, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Contact, nil
+}
@@ -0,0 +1,67 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestContactPatch_MarshalJSON(t *testing.T) {
+	patch := NewContactPatch().
+		SetEmail("ada@example.com").
+		ClearPhone().
+		SetCustomField("field-1", "green")
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(data, &body); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if len(body) != 3 {
+		t.Errorf("Expected exactly 3 fields, got %s", data)
+	}
+	if string(body["email"]) != `"ada@example.com"` {
+		t.Errorf("Unexpected email: %s", body["email"])
+	}
+	if string(body["phone"]) != "null" {
+		t.Errorf("Expected phone to be cleared with null, got %s", body["phone"])
+	}
+	if string(body["customField"]) != `[{"id":"field-1","field_value":"green"}]` {
+		t.Errorf("Unexpected customField: %s", body["customField"])
+	}
+
+	if got := patch.Fields(); !reflect.DeepEqual(got, []string{"customField", "email", "phone"}) {
+		t.Errorf("Unexpected fields %v", got)
+	}
+}
+
+func TestContactPatch_CustomFieldKeyMatchesUpdate(t *testing.T) {
+	fields := []CustomField{{ID: "field-1", Value: "green"}}
+	for _, req := range []interface{}{&UpdateContactRequest{CustomFields: fields}, &CreateContactRequest{CustomFields: fields}} {
+		var want, got map[string]json.RawMessage
+		data, _ := json.Marshal(req)
+		_ = json.Unmarshal(data, &want)
+		data, _ = json.Marshal(NewContactPatch().SetCustomField("field-1", "green"))
+		_ = json.Unmarshal(data, &got)
+
+		if len(got) != 1 || string(got["customField"]) != string(want["customField"]) {
+			t.Errorf("%T sends %s, patch sends %s", req, want["customField"], data)
+		}
+	}
+}
+
+func TestContactPatch_Empty(t *testing.T) {
+	if !NewContactPatch().IsEmpty() {
+		t.Error("Expected new patch to be empty")
+	}
+
+	client, _ := NewClient(Config{AccessToken: "token"})
+	if _, err := client.Contacts.Patch("contact-id", NewContactPatch()); err == nil {
+		t.Error("Expected error for empty patch")
+	}
+}
@@ -0,0 +1,333 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Operators of ContactFilter
+const (
+	FilterEq          = "eq"
+	FilterNotEq       = "not_eq"
+	FilterContains    = "contains"
+	FilterNotContains = "not_contains"
+	FilterExists      = "exists"
+	FilterNotExists   = "not_exists"
+	FilterRange       = "range"
+)
+
+// ContactFilter is a condition of a contact search. Set Field, Operator and Value for a single
+// condition, or Group ("AND" or "OR") and Filters to combine conditions.
+type ContactFilter struct {
+	Field    string          `json:"field,omitempty"`
+	Operator string          `json:"operator,omitempty"`
+	Value    interface{}     `json:"value,omitempty"`
+	Group    string          `json:"group,omitempty"`
+	Filters  []ContactFilter `json:"filters,omitempty"`
+}
+
+// SortDirection is the order of a sort
+type SortDirection string
+
+// Sort directions
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+// Contact fields the search endpoint can sort by
+const (
+	ContactSortDateAdded    = "dateAdded"
+	ContactSortDateUpdated  = "dateUpdated"
+	ContactSortFirstName    = "firstNameLowerCase"
+	ContactSortLastName     = "lastNameLowerCase"
+	ContactSortBusinessName = "businessName"
+	ContactSortEmail        = "email"
+)
+
+// ContactSort orders contact search results by a field
+type ContactSort struct {
+	Field     string        `json:"field"`     // One of the ContactSort field constants
+	Direction SortDirection `json:"direction"` // Default SortAsc
+}
+
+// validate checks the sort against the fields and directions the API accepts
+func (s *ContactSort) validate() error {
+	switch s.Field {
+	case ContactSortDateAdded, ContactSortDateUpdated, ContactSortFirstName, ContactSortLastName, ContactSortBusinessName, ContactSortEmail:
+	default:
+		return fmt.Errorf("contacts cannot be sorted by %q", s.Field)
+	}
+	switch s.Direction {
+	case "":
+		s.Direction = SortAsc
+	case SortAsc, SortDesc:
+	default:
+		return fmt.Errorf("sort direction must be asc or desc")
+	}
+	return nil
+}
+
+// ContactQuery selects contacts by free text, filters and date windows; all conditions must
+// match. Windows include their After time and exclude their Before time, so consecutive windows
+// neither overlap nor leave gaps. Zero times leave that end of a window open.
+type ContactQuery struct {
+	Query         string
+	Filters       []ContactFilter
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+}
+
+// filters returns the query's filters with its date windows added as range filters
+func (q *ContactQuery) filters() []ContactFilter {
+	filters := q.Filters
+	if !q.CreatedAfter.IsZero() || !q.CreatedBefore.IsZero() {
+		filters = append(filters[:len(filters):len(filters)], DateRangeFilter("dateAdded", q.CreatedAfter, q.CreatedBefore))
+	}
+	if !q.UpdatedAfter.IsZero() || !q.UpdatedBefore.IsZero() {
+		filters = append(filters[:len(filters):len(filters)], DateRangeFilter("dateUpdated", q.UpdatedAfter, q.UpdatedBefore))
+	}
+	return filters
+}
+
+// DateRangeFilter returns a filter matching contacts whose date field, e.g. "dateAdded" or
+// "dateUpdated", is at or after from and before to. A zero time leaves that end open.
+func DateRangeFilter(field string, from, to time.Time) ContactFilter {
+	value := map[string]string{}
+	if !from.IsZero() {
+		value["gte"] = from.UTC().Format(time.RFC3339)
+	}
+	if !to.IsZero() {
+		value["lt"] = to.UTC().Format(time.RFC3339)
+	}
+	return ContactFilter{Field: field, Operator: FilterRange, Value: value}
+}
+
+// SearchContactsRequest represents a request to search the contacts of a location
+type SearchContactsRequest struct {
+	LocationID string          `json:"locationId"`
+	Query      string          `json:"query,omitempty"`
+	Filters    []ContactFilter `json:"filters,omitempty"`
+	Sort       []ContactSort   `json:"sort,omitempty"`
+	Page       int             `json:"page,omitempty"`
+	PageLimit  int             `json:"pageLimit"`
+	PageToken  string          `json:"-"` // Resumes a search from Page.NextPageToken, in place of Page
+}
+
+// applyPageDefaults sets the default page size and the page encoded in r.PageToken
+func (r *SearchContactsRequest) applyPageDefaults() error {
+	if r.PageLimit <= 0 {
+		r.PageLimit = 20
+	}
+	if r.PageToken != "" {
+		offset, err := parseOffsetPageToken(r.PageToken)
+		if err != nil {
+			return err
+		}
+		r.Page, r.PageToken = offset/r.PageLimit+1, ""
+	}
+	return nil
+}
+
+// Search retrieves the contacts of a location matching a query and filters, one page at a time
+// Required scope: contacts.readonly
+func (s *ContactsService) Search(req *SearchContactsRequest) (*ContactsResponse, error) {
+	return s.search(context.Background(), req)
+}
+
+// search searches contacts like Search, aborting the request when ctx is cancelled
+func (s *ContactsService) search(ctx context.Context, req *SearchContactsRequest) (*ContactsResponse, error) {
+	if req == nil {
+		req = &SearchContactsRequest{}
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if err := req.applyPageDefaults(); err != nil {
+		return nil, err
+	}
+	req.Sort = slices.Clone(req.Sort)
+	for i := range req.Sort {
+		if err := req.Sort[i].validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var result ContactsResponse
+	err := s.client.doRequestContext(ctx, "POST", "/contacts/search", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Count returns the number of contacts of a location matching a query, fetching a single-contact
+// page instead of full result pages. A nil query counts every contact.
+// Required scope: contacts.readonly
+func (s *ContactsService) Count(locationID string, query *ContactQuery) (int, error) {
+	if query == nil {
+		query = &ContactQuery{}
+	}
+
+	result, err := s.Search(&SearchContactsRequest{
+		LocationID: locationID,
+		Query:      query.Query,
+		Filters:    query.filters(),
+		Page:       1,
+		PageLimit:  1,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Total, nil
+}
+
+// SearchPage retrieves a page of search results like Search, with HasMore and NextPage for
+// manual pagination
+// Required scope: contacts.readonly
+func (s *ContactsService) SearchPage(ctx context.Context, req *SearchContactsRequest) (*Page[Contact], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &SearchContactsRequest{}
+	}
+	current := *req
+	if err := current.applyPageDefaults(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.search(ctx, &current)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[Contact]{Items: result.Contacts, Total: result.Total}
+	pageNumber := current.Page
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	next := pageNumber * current.PageLimit
+	page.HasMore = len(result.Contacts) == current.PageLimit && next < result.Total
+	if page.HasMore {
+		page.NextPageToken = offsetPageToken(next)
+		nextReq := current
+		nextReq.PageToken = page.NextPageToken
+		page.next = func(ctx context.Context) (*Page[Contact], error) {
+			return s.SearchPage(ctx, &nextReq)
+		}
+	}
+	return page, nil
+}
+
+// searchPageSize is the page size used when collecting every contact matching a query
+const searchPageSize = 100
+
+// searchAll retrieves every contact matching a query
+func (s *ContactsService) searchAll(ctx context.Context, locationID string, query *ContactQuery) ([]Contact, int, error) {
+	if query == nil {
+		query = &ContactQuery{}
+	}
+
+	var contacts []Contact
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		result, err := s.search(ctx, &SearchContactsRequest{
+			LocationID: locationID,
+			Query:      query.Query,
+			Filters:    query.filters(),
+			Page:       page,
+			PageLimit:  searchPageSize,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		contacts = append(contacts, result.Contacts...)
+		if len(result.Contacts) < searchPageSize || len(contacts) >= result.Total {
+			return contacts, result.Total, nil
+		}
+	}
+}
+
+// ErrDeleteCountMismatch is returned by DeleteByQuery when the number of matching contacts is
+// not what the caller confirmed, or differs from the total reported by the search
+var ErrDeleteCountMismatch = errors.New("matching contact count does not match")
+
+// DeleteByQueryOptions configures DeleteByQuery
+type DeleteByQueryOptions struct {
+	// DryRun reports the matching contacts without deleting them
+	DryRun bool
+	// ExpectedCount, when positive, aborts the deletion unless exactly this many contacts match.
+	// Run with DryRun first to get the count to confirm.
+	ExpectedCount int
+	// MaxDeletes, when positive, aborts the deletion if more contacts match
+	MaxDeletes int
+	// Bulk sets the concurrency and pacing of the deletes (default: RunBulk defaults)
+	Bulk *BulkOptions
+}
+
+// DeleteByQueryResult reports the outcome of DeleteByQuery
+type DeleteByQueryResult struct {
+	DryRun     bool
+	Matched    []Contact   // Contacts matching the query when it ran
+	Results    BulkResults // Outcome of each delete, in the order of Matched; empty on a dry run
+	DeletedIDs []string
+}
+
+// DeleteByQuery deletes every contact of a location matching a query, e.g. for GDPR cleanups or
+// purging test data. All matching contacts are collected before anything is deleted, and the
+// count is checked against the search total and opts.ExpectedCount first, so a query that
+// matches more than intended fails with ErrDeleteCountMismatch instead of deleting.
+// Required scopes: contacts.readonly, contacts.write
+func (s *ContactsService) DeleteByQuery(ctx context.Context, locationID string, query *ContactQuery, opts *DeleteByQueryOptions) (*DeleteByQueryResult, error) {
+	if opts == nil {
+		opts = &DeleteByQueryOptions{}
+	}
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if query == nil || (query.Query == "" && len(query.filters()) == 0) {
+		return nil, fmt.Errorf("a query or at least one filter is required")
+	}
+
+	matched, total, err := s.searchAll(ctx, locationID, query)
+	if err != nil {
+		return nil, err
+	}
+	result := &DeleteByQueryResult{DryRun: opts.DryRun, Matched: matched}
+
+	if len(matched) != total {
+		return result, fmt.Errorf("%w: search reported %d contacts but returned %d", ErrDeleteCountMismatch, total, len(matched))
+	}
+	if opts.ExpectedCount > 0 && len(matched) != opts.ExpectedCount {
+		return result, fmt.Errorf("%w: expected %d contacts, found %d", ErrDeleteCountMismatch, opts.ExpectedCount, len(matched))
+	}
+	if opts.MaxDeletes > 0 && len(matched) > opts.MaxDeletes {
+		return result, fmt.Errorf("%d contacts match, more than the maximum of %d", len(matched), opts.MaxDeletes)
+	}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	result.Results = RunBulk(ctx, len(matched), opts.Bulk, func(ctx context.Context, i int) error {
+		return s.delete(ctx, matched[i].ID)
+	})
+	for i, r := range result.Results {
+		if r.Err == nil {
+			result.DeletedIDs = append(result.DeletedIDs, matched[i].ID)
+		}
+	}
+	return result, nil
+}
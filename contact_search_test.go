@@ -0,0 +1,234 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newSearchServer serves n matching contacts through /contacts/search and records deletes
+func newSearchServer(t *testing.T, n int) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/contacts/search":
+			var req SearchContactsRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if len(req.Filters) == 0 || req.Filters[0].Field != "tags" {
+				t.Errorf("filters = %+v", req.Filters)
+			}
+			var resp ContactsResponse
+			resp.Total = n
+			for i := (req.Page - 1) * req.PageLimit; i < n && i < req.Page*req.PageLimit; i++ {
+				resp.Contacts = append(resp.Contacts, Contact{ID: fmt.Sprintf("c%03d", i)})
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		case r.Method == "DELETE":
+			mu.Lock()
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/contacts/"))
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Strings(deleted)
+		return deleted
+	}
+}
+
+var testQuery = &ContactQuery{Filters: []ContactFilter{{Field: "tags", Operator: FilterContains, Value: "test-data"}}}
+
+func TestContactsService_DeleteByQuery(t *testing.T) {
+	server, deleted := newSearchServer(t, 150)
+	defer server.Close()
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	ctx := context.Background()
+
+	dry, err := client.Contacts.DeleteByQuery(ctx, "loc", testQuery, &DeleteByQueryOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	if len(dry.Matched) != 150 || len(deleted()) != 0 {
+		t.Fatalf("dry run matched %d and deleted %d, want 150 and 0", len(dry.Matched), len(deleted()))
+	}
+
+	result, err := client.Contacts.DeleteByQuery(ctx, "loc", testQuery, &DeleteByQueryOptions{ExpectedCount: 150})
+	if err != nil {
+		t.Fatalf("DeleteByQuery failed: %v", err)
+	}
+	if len(result.DeletedIDs) != 150 || len(deleted()) != 150 || deleted()[0] != "c000" {
+		t.Errorf("deleted %d contacts (%d reported)", len(deleted()), len(result.DeletedIDs))
+	}
+}
+
+func TestContactsService_DeleteByQuery_Safeguards(t *testing.T) {
+	server, deleted := newSearchServer(t, 10)
+	defer server.Close()
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	ctx := context.Background()
+
+	_, err := client.Contacts.DeleteByQuery(ctx, "loc", testQuery, &DeleteByQueryOptions{ExpectedCount: 9})
+	if !errors.Is(err, ErrDeleteCountMismatch) {
+		t.Errorf("ExpectedCount mismatch error = %v", err)
+	}
+	if _, err := client.Contacts.DeleteByQuery(ctx, "loc", testQuery, &DeleteByQueryOptions{MaxDeletes: 5}); err == nil {
+		t.Error("MaxDeletes was not enforced")
+	}
+	if _, err := client.Contacts.DeleteByQuery(ctx, "loc", &ContactQuery{}, nil); err == nil {
+		t.Error("empty query was accepted")
+	}
+	if n := len(deleted()); n != 0 {
+		t.Errorf("%d contacts deleted despite safeguards", n)
+	}
+}
+
+func TestContactsService_DeleteByQuery_CancelDuringBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			_, _ = w.Write([]byte(`{"contacts":[{"id":"c1"}],"total":1}`))
+			return
+		}
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, RetryPolicy: DefaultRetryPolicy()})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	result, err := client.Contacts.DeleteByQuery(ctx, "loc", testQuery, nil)
+	if err != nil {
+		t.Fatalf("DeleteByQuery failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("DeleteByQuery returned after %v, want the retry wait to end with ctx", elapsed)
+	}
+	if len(result.Results) != 1 || !errors.Is(result.Results[0].Err, context.DeadlineExceeded) {
+		t.Errorf("results = %+v, want the deadline error", result.Results)
+	}
+}
+
+func TestContactsService_Count(t *testing.T) {
+	var pageLimit int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchContactsRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		pageLimit = req.PageLimit
+		_, _ = w.Write([]byte(`{"contacts":[{"id":"c1"}],"total":4213}`))
+	}))
+	defer server.Close()
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+
+	count, err := client.Contacts.Count("loc", testQuery)
+	if err != nil {
+		t.Fatalf("Count failed: %v", err)
+	}
+	if count != 4213 {
+		t.Errorf("Count = %d, want 4213", count)
+	}
+	if pageLimit != 1 {
+		t.Errorf("pageLimit = %d, want 1", pageLimit)
+	}
+	if _, err := client.Contacts.Count("", nil); err == nil {
+		t.Error("missing locationId was accepted")
+	}
+}
+
+func TestContactQuery_DateRange(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	base := []ContactFilter{{Field: "tags", Operator: FilterContains, Value: "x"}}
+	q := &ContactQuery{Filters: base, CreatedAfter: from, CreatedBefore: to, UpdatedAfter: from}
+
+	filters := q.filters()
+	if len(filters) != 3 || len(q.Filters) != 1 {
+		t.Fatalf("filters = %+v, query filters = %+v", filters, q.Filters)
+	}
+	created := filters[1]
+	if created.Field != "dateAdded" || created.Operator != FilterRange ||
+		!reflect.DeepEqual(created.Value, map[string]string{"gte": "2024-03-01T00:00:00Z", "lt": "2024-04-01T00:00:00Z"}) {
+		t.Errorf("created filter = %+v", created)
+	}
+	if updated := filters[2]; updated.Field != "dateUpdated" || !reflect.DeepEqual(updated.Value, map[string]string{"gte": "2024-03-01T00:00:00Z"}) {
+		t.Errorf("updated filter = %+v", updated)
+	}
+}
+
+func TestContactsService_ListByDateRange(t *testing.T) {
+	var got SearchContactsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/contacts/search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"contacts":[{"id":"c1"},{"id":"c2"}],"total":12}`))
+	}))
+	defer server.Close()
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+
+	opts := &GetContactsOptions{
+		LocationID:   "loc",
+		Limit:        10,
+		Skip:         10,
+		UpdatedAfter: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+	result, err := client.Contacts.List(opts)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if result.Count != 2 || result.Total != 12 {
+		t.Errorf("Count = %d, Total = %d", result.Count, result.Total)
+	}
+	if got.Page != 2 || got.PageLimit != 10 || len(got.Filters) != 1 || got.Filters[0].Field != "dateUpdated" {
+		t.Errorf("search request = %+v", got)
+	}
+
+	opts.Skip = 5
+	if _, err := client.Contacts.List(opts); err == nil {
+		t.Error("unaligned skip was accepted")
+	}
+}
+
+func TestContactsService_SearchSort(t *testing.T) {
+	var got SearchContactsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"contacts":[],"total":0}`))
+	}))
+	defer server.Close()
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+
+	_, err := client.Contacts.List(&GetContactsOptions{
+		LocationID: "loc",
+		Sort:       []ContactSort{{Field: ContactSortDateUpdated, Direction: SortDesc}, {Field: ContactSortLastName}},
+	})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	want := []ContactSort{{Field: "dateUpdated", Direction: "desc"}, {Field: "lastNameLowerCase", Direction: "asc"}}
+	if !reflect.DeepEqual(got.Sort, want) {
+		t.Errorf("sort = %+v, want %+v", got.Sort, want)
+	}
+
+	for _, sort := range []ContactSort{{Field: "phone"}, {Field: ContactSortEmail, Direction: "up"}} {
+		_, err := client.Contacts.Search(&SearchContactsRequest{LocationID: "loc", Sort: []ContactSort{sort}})
+		if err == nil {
+			t.Errorf("sort %+v was accepted", sort)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package gohighlevel
+
+import "fmt"
+
+// ContactSession represents a tracked web session (page visit with its attribution data)
+// recorded for a contact by the GoHighLevel tracking script, forms or funnels
+type ContactSession struct {
+	URL              string `json:"url,omitempty"`
+	PageURL          string `json:"pageUrl,omitempty"`
+	Referrer         string `json:"referrer,omitempty"`
+	SessionSource    string `json:"sessionSource,omitempty"`    // e.g. "Direct traffic", "Paid Search", "Social media"
+	UTMSessionSource string `json:"utmSessionSource,omitempty"` // Source classification derived from the UTM parameters
+	Medium           string `json:"medium,omitempty"`           // e.g. "form", "survey", "calendar", "chat_widget"
+	MediumID         string `json:"mediumId,omitempty"`
+	UTMSource        string `json:"utmSource,omitempty"`
+	UTMMedium        string `json:"utmMedium,omitempty"`
+	UTMCampaign      string `json:"utmCampaign,omitempty"`
+	UTMContent       string `json:"utmContent,omitempty"`
+	UTMTerm          string `json:"utmTerm,omitempty"`
+	FBCLID           string `json:"fbclid,omitempty"`
+	GCLID            string `json:"gclid,omitempty"`
+	UserAgent        string `json:"userAgent,omitempty"`
+	IP               string `json:"ip,omitempty"`
+	IsFirst          bool   `json:"isFirst,omitempty"`
+	IsLast           bool   `json:"isLast,omitempty"`
+	DateAdded        Time   `json:"dateAdded,omitempty"`
+}
+
+// contactSessionsResponse represents the attribution part of a single contact API response
+type contactSessionsResponse struct {
+	Contact struct {
+		Attributions []ContactSession `json:"attributions,omitempty"`
+	} `json:"contact"`
+}
+
+// GetSessions retrieves the tracked sessions (page visits and their attribution) of a contact.
+// The API exposes these as part of the contact record, so this is the contact's attribution
+// history rather than a full clickstream; the first and last sessions are flagged with
+// IsFirst and IsLast.
+// Required scope: contacts.readonly
+func (s *ContactsService) GetSessions(contactID string) ([]ContactSession, error) {
+	if contactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+
+	var result contactSessionsResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/contacts/%s", contactID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Contact.Attributions, nil
+}
@@ -0,0 +1,44 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestContactsService_GetSessions(t *testing.T) {
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /contacts/contact-1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"contact":{"id":"contact-1","attributions":[
+				{"url":"https://example.com/landing","utmSource":"google","gclid":"abc","isFirst":true,"dateAdded":"2025-01-01T10:00:00.000Z"},
+				{"pageUrl":"https://example.com/checkout","medium":"form","mediumId":"form-1","isLast":true}
+			]}}`))
+		},
+		"GET /contacts/contact-2": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"contact":{"id":"contact-2"}}`))
+		},
+	})
+
+	sessions, err := client.Contacts.GetSessions("contact-1")
+	if err != nil {
+		t.Fatalf("GetSessions failed: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("Expected 2 sessions, got %d", len(sessions))
+	}
+	first, last := sessions[0], sessions[1]
+	if !first.IsFirst || first.UTMSource != "google" || first.GCLID != "abc" || first.DateAdded.IsZero() {
+		t.Errorf("Unexpected first session: %+v", first)
+	}
+	if !last.IsLast || last.Medium != "form" || last.MediumID != "form-1" {
+		t.Errorf("Unexpected last session: %+v", last)
+	}
+
+	sessions, err = client.Contacts.GetSessions("contact-2")
+	if err != nil || len(sessions) != 0 {
+		t.Errorf("GetSessions = %+v, %v", sessions, err)
+	}
+
+	if _, err := client.Contacts.GetSessions(""); err == nil {
+		t.Error("Expected error for missing contactId")
+	}
+}
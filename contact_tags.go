@@ -0,0 +1,86 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagChanges reports the tags added to and removed from a contact
+type TagChanges struct {
+	Added   []string
+	Removed []string
+}
+
+// EnsureTags makes sure a contact has every tag in want, adding only the ones it is missing.
+// Tags are compared case-insensitively, as the API stores them in lowercase. Calling it again
+// with the same tags makes no changes.
+// Required scopes: contacts.readonly, contacts.write
+func (s *ContactsService) EnsureTags(contactID string, want []string) (*TagChanges, error) {
+	return s.reconcileTags(contactID, want, false)
+}
+
+// SetTags makes a contact's tags exactly match want, adding missing tags and removing all
+// others. An empty want removes every tag.
+// Required scopes: contacts.readonly, contacts.write
+func (s *ContactsService) SetTags(contactID string, want []string) (*TagChanges, error) {
+	return s.reconcileTags(contactID, want, true)
+}
+
+// reconcileTags reads a contact's current tags and applies only the add and remove operations
+// needed to reach want
+func (s *ContactsService) reconcileTags(contactID string, want []string, removeOthers bool) (*TagChanges, error) {
+	if contactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+
+	// Read from the API, not the cache, so a stale cached tag list can't skip a change
+	contact, err := s.fetch(contactID)
+	if err != nil {
+		return nil, err
+	}
+	if contact == nil {
+		return nil, fmt.Errorf("contact %s not found", contactID)
+	}
+
+	current := make(map[string]bool, len(contact.Tags))
+	for _, tag := range contact.Tags {
+		current[normalizeTag(tag)] = true
+	}
+	wanted := make(map[string]bool, len(want))
+	changes := &TagChanges{}
+	for _, tag := range want {
+		tag = normalizeTag(tag)
+		if tag == "" || wanted[tag] {
+			continue
+		}
+		wanted[tag] = true
+		if !current[tag] {
+			changes.Added = append(changes.Added, tag)
+		}
+	}
+	if removeOthers {
+		for _, tag := range contact.Tags {
+			if normalized := normalizeTag(tag); current[normalized] && !wanted[normalized] {
+				changes.Removed = append(changes.Removed, tag)
+				delete(current, normalized)
+			}
+		}
+	}
+
+	if len(changes.Added) > 0 {
+		if err := s.AddTags(contactID, changes.Added); err != nil {
+			return nil, err
+		}
+	}
+	if len(changes.Removed) > 0 {
+		if err := s.RemoveTags(contactID, changes.Removed); err != nil {
+			return &TagChanges{Added: changes.Added}, err
+		}
+	}
+	return changes, nil
+}
+
+// normalizeTag returns the form the API stores a tag in
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
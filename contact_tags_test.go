@@ -0,0 +1,84 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// newTagsClient serves contact c1 with the given tags and records tag add and remove requests
+func newTagsClient(t *testing.T, tags []string) (*Client, *[]string, *[]string) {
+	var added, removed []string
+	record := func(into *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Tags []string `json:"tags"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			*into = append(*into, body.Tags...)
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /contacts/c1": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, ContactResponse{Contact: &Contact{ID: "c1", Tags: tags}})
+		},
+		"POST /contacts/c1/tags":   record(&added),
+		"DELETE /contacts/c1/tags": record(&removed),
+	})
+	return client, &added, &removed
+}
+
+func TestContactsService_EnsureTags(t *testing.T) {
+	client, added, removed := newTagsClient(t, []string{"vip", "lead"})
+
+	changes, err := client.Contacts.EnsureTags("c1", []string{"VIP", "customer", "customer"})
+	if err != nil {
+		t.Fatalf("EnsureTags failed: %v", err)
+	}
+	if !reflect.DeepEqual(changes.Added, []string{"customer"}) || len(changes.Removed) != 0 {
+		t.Errorf("changes = %+v", changes)
+	}
+	if !reflect.DeepEqual(*added, []string{"customer"}) || len(*removed) != 0 {
+		t.Errorf("added %v, removed %v", *added, *removed)
+	}
+}
+
+func TestContactsService_EnsureTags_NoChanges(t *testing.T) {
+	client, added, removed := newTagsClient(t, []string{"vip"})
+
+	if _, err := client.Contacts.EnsureTags("c1", []string{"vip"}); err != nil {
+		t.Fatalf("EnsureTags failed: %v", err)
+	}
+	if len(*added) != 0 || len(*removed) != 0 {
+		t.Errorf("requests made for a no-op: added %v, removed %v", *added, *removed)
+	}
+}
+
+func TestContactsService_SetTags(t *testing.T) {
+	client, added, removed := newTagsClient(t, []string{"vip", "lead", "Old"})
+
+	changes, err := client.Contacts.SetTags("c1", []string{"vip", "customer"})
+	if err != nil {
+		t.Fatalf("SetTags failed: %v", err)
+	}
+	if !reflect.DeepEqual(changes, &TagChanges{Added: []string{"customer"}, Removed: []string{"lead", "Old"}}) {
+		t.Errorf("changes = %+v", changes)
+	}
+	if !reflect.DeepEqual(*added, []string{"customer"}) || !reflect.DeepEqual(*removed, []string{"lead", "Old"}) {
+		t.Errorf("added %v, removed %v", *added, *removed)
+	}
+}
+
+func TestContactsService_EnsureTags_MissingContact(t *testing.T) {
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /contacts/c1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	if _, err := client.Contacts.EnsureTags("c1", []string{"vip"}); err == nil {
+		t.Fatal("expected error for a response without a contact")
+	}
+}
@@ -1,6 +1,8 @@
 package gohighlevel
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"time"
@@ -31,9 +33,9 @@ type Contact struct {
 	CompanyName          string             `json:"companyName,omitempty"`
 	Website              string             `json:"website,omitempty"`
 	Tags                 []string           `json:"tags,omitempty"`
-	DateOfBirth          string             `json:"dateOfBirth,omitempty"`
-	DateAdded            time.Time          `json:"dateAdded,omitempty"`
-	DateUpdated          time.Time          `json:"dateUpdated,omitempty"`
+	DateOfBirth          Date               `json:"dateOfBirth,omitempty"`
+	DateAdded            Time               `json:"dateAdded,omitempty"`
+	DateUpdated          Time               `json:"dateUpdated,omitempty"`
 	CustomFields         []CustomField      `json:"customField,omitempty"`
 	BusinessID           string             `json:"businessId,omitempty"`
 	AttributionSource    *AttributionSource `json:"attributionSource,omitempty"`
@@ -49,6 +51,9 @@ type Contact struct {
 	ConversationProvider string             `json:"conversationProvider,omitempty"`
 	ConversationAgencyID string             `json:"conversationAgencyId,omitempty"`
 	Followers            []string           `json:"followers,omitempty"`
+	// Extra holds fields returned by the API that this struct does not declare yet,
+	// so they survive a decode/encode round-trip
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // CustomField represents a custom field on a contact
@@ -65,6 +70,9 @@ type AttributionSource struct {
 	Medium            string `json:"medium,omitempty"`
 	MediumID          string `json:"mediumId,omitempty"`
 	Source            string `json:"source,omitempty"`
+	URL               string `json:"url,omitempty"` // Landing page URL
+	UTMContent        string `json:"utmContent,omitempty"`
+	UTMKeyword        string `json:"utmKeyword,omitempty"` // utm_term
 	Referrer          string `json:"referrer,omitempty"`
 	AdGroup           string `json:"adGroup,omitempty"`
 	AdGroupID         string `json:"adGroupId,omitempty"`
@@ -166,6 +174,21 @@ type GetContactsOptions struct {
 	Skip         int
 	StartAfter   string
 	StartAfterID string
+	// Date windows, see ContactQuery, and sort order. Listings with a window or sort order
+	// are served by the search endpoint, which pages by Skip in multiples of Limit instead of
+	// by StartAfter cursors.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	Sort          []ContactSort
+	// PageToken resumes a listing from Page.NextPageToken, in place of StartAfter/Skip
+	PageToken string
+}
+
+// needsSearch reports whether the options can only be served by the search endpoint
+func (o *GetContactsOptions) needsSearch() bool {
+	return len(o.Sort) > 0 || !o.CreatedAfter.IsZero() || !o.CreatedBefore.IsZero() || !o.UpdatedAfter.IsZero() || !o.UpdatedBefore.IsZero()
 }
 
 // ContactResponse represents a single contact API response
@@ -183,9 +206,16 @@ type ContactsResponse struct {
 // Create creates a new contact
 // Required scope: contacts.write
 func (s *ContactsService) Create(req *CreateContactRequest) (*Contact, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
 	if req.LocationID == "" {
 		return nil, fmt.Errorf("locationId is required")
 	}
+	if s.client.validateRequests {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	var result ContactResponse
 	err := s.client.doRequest("POST", "/contacts/", req, &result)
@@ -196,13 +226,32 @@ func (s *ContactsService) Create(req *CreateContactRequest) (*Contact, error) {
 	return result.Contact, nil
 }
 
-// Get retrieves a contact by ID
+// Get retrieves a contact by ID.
+// With Config.ContactCache set, contacts are served from the cache until they expire.
 // Required scope: contacts.readonly
 func (s *ContactsService) Get(contactID string) (*Contact, error) {
 	if contactID == "" {
 		return nil, fmt.Errorf("contactId is required")
 	}
+	cache := s.client.contactCache
+	if cache == nil {
+		return s.fetch(contactID)
+	}
+	if contact, ok := cache.get(contactID); ok {
+		return contact, nil
+	}
+
+	generation := cache.beginFetch(contactID)
+	contact, err := s.fetch(contactID)
+	cache.endFetch(contactID, generation, contact)
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
 
+// fetch retrieves a contact from the API, bypassing the contact cache
+func (s *ContactsService) fetch(contactID string) (*Contact, error) {
 	var result ContactResponse
 	err := s.client.doRequest("GET", fmt.Sprintf("/contacts/%s", contactID), nil, &result)
 	if err != nil {
@@ -219,6 +268,8 @@ func (s *ContactsService) Update(contactID string, req *UpdateContactRequest) (*
 		return nil, fmt.Errorf("contactId is required")
 	}
 
+	defer s.InvalidateCache(contactID)
+
 	var result ContactResponse
 	err := s.client.doRequest("PUT", fmt.Sprintf("/contacts/%s", contactID), req, &result)
 	if err != nil {
@@ -231,25 +282,41 @@ func (s *ContactsService) Update(contactID string, req *UpdateContactRequest) (*
 // Delete deletes a contact
 // Required scope: contacts.write
 func (s *ContactsService) Delete(contactID string) error {
+	return s.delete(context.Background(), contactID)
+}
+
+// delete deletes a contact, aborting the request when ctx is cancelled
+func (s *ContactsService) delete(ctx context.Context, contactID string) error {
 	if contactID == "" {
 		return fmt.Errorf("contactId is required")
 	}
 
-	return s.client.doRequest("DELETE", fmt.Sprintf("/contacts/%s", contactID), nil, nil)
+	defer s.InvalidateCache(contactID)
+	return s.client.doRequestContext(ctx, "DELETE", fmt.Sprintf("/contacts/%s", contactID), nil, nil)
 }
 
 // Upsert creates or updates a contact based on duplicate detection settings
 // Required scope: contacts.write
 func (s *ContactsService) Upsert(req *UpsertContactRequest) (*Contact, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
 	if req.LocationID == "" {
 		return nil, fmt.Errorf("locationId is required")
 	}
+	if s.client.validateRequests {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	var result ContactResponse
 	err := s.client.doRequest("POST", "/contacts/upsert", req, &result)
 	if err != nil {
 		return nil, err
 	}
+	if result.Contact != nil {
+		s.InvalidateCache(result.Contact.ID)
+	}
 
 	return result.Contact, nil
 }
@@ -261,10 +328,20 @@ func (s *ContactsService) List(opts *GetContactsOptions) (*ContactsResponse, err
 	if opts == nil {
 		opts = &GetContactsOptions{}
 	}
+	if opts.PageToken != "" {
+		resumed := *opts
+		if err := resumed.applyPageToken(); err != nil {
+			return nil, err
+		}
+		opts = &resumed
+	}
+	if opts.needsSearch() {
+		return s.listBySearch(opts)
+	}
 
 	query := url.Values{}
-	if opts.LocationID != "" {
-		query.Set("locationId", opts.LocationID)
+	if locationID := s.client.resolveLocationID(opts.LocationID); locationID != "" {
+		query.Set("locationId", locationID)
 	}
 	if opts.Query != "" {
 		query.Set("query", opts.Query)
@@ -296,6 +373,90 @@ func (s *ContactsService) List(opts *GetContactsOptions) (*ContactsResponse, err
 	return &result, nil
 }
 
+// ListPage retrieves a page of contacts like List, with HasMore and NextPage for manual
+// pagination
+// Required scope: contacts.readonly
+func (s *ContactsService) ListPage(ctx context.Context, opts *GetContactsOptions) (*Page[Contact], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &GetContactsOptions{}
+	}
+	current := *opts
+	if err := current.applyPageToken(); err != nil {
+		return nil, err
+	}
+	current.PageToken = ""
+	limit := current.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	result, err := s.List(&current)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[Contact]{Items: result.Contacts, Total: result.Total}
+	if current.needsSearch() {
+		next := current.Skip + limit
+		page.HasMore = len(result.Contacts) == limit && (result.Total == 0 || next < result.Total)
+		if page.HasMore {
+			page.NextPageToken = offsetPageToken(next)
+		}
+	} else {
+		page.HasMore = len(result.Contacts) == limit
+		if page.HasMore {
+			page.NextPageToken = cursorPageToken(result.Contacts[len(result.Contacts)-1])
+		}
+	}
+	if page.HasMore {
+		nextOpts := current
+		nextOpts.PageToken = page.NextPageToken
+		page.next = func(ctx context.Context) (*Page[Contact], error) {
+			return s.ListPage(ctx, &nextOpts)
+		}
+	}
+	return page, nil
+}
+
+// listBySearch serves a List call with date windows or a sort order through the search
+// endpoint, since the list endpoint supports neither
+func (s *ContactsService) listBySearch(opts *GetContactsOptions) (*ContactsResponse, error) {
+	if opts.StartAfter != "" || opts.StartAfterID != "" {
+		return nil, fmt.Errorf("startAfter cursors cannot be combined with date ranges or sorting; page with skip instead")
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if opts.Skip%limit != 0 {
+		return nil, fmt.Errorf("skip must be a multiple of limit when filtering by date range or sorting")
+	}
+
+	query := &ContactQuery{
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
+		UpdatedAfter:  opts.UpdatedAfter,
+		UpdatedBefore: opts.UpdatedBefore,
+	}
+	result, err := s.Search(&SearchContactsRequest{
+		LocationID: opts.LocationID,
+		Query:      opts.Query,
+		Filters:    query.filters(),
+		Sort:       opts.Sort,
+		Page:       opts.Skip/limit + 1,
+		PageLimit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Count = len(result.Contacts)
+	return result, nil
+}
+
 // GetByBusinessID retrieves contacts by business ID
 // Required scope: contacts.readonly
 func (s *ContactsService) GetByBusinessID(businessID string) (*ContactsResponse, error) {
@@ -322,6 +483,7 @@ func (s *ContactsService) AddTags(contactID string, tags []string) error {
 		return fmt.Errorf("at least one tag is required")
 	}
 
+	defer s.InvalidateCache(contactID)
 	req := map[string][]string{"tags": tags}
 	return s.client.doRequest("POST", fmt.Sprintf("/contacts/%s/tags", contactID), req, nil)
 }
@@ -336,6 +498,7 @@ func (s *ContactsService) RemoveTags(contactID string, tags []string) error {
 		return fmt.Errorf("at least one tag is required")
 	}
 
+	defer s.InvalidateCache(contactID)
 	req := map[string][]string{"tags": tags}
 	return s.client.doRequest("DELETE", fmt.Sprintf("/contacts/%s/tags", contactID), req, nil)
 }
@@ -0,0 +1,58 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConversations_UnreadCounts(t *testing.T) {
+	totals := map[string]string{"": "12", "unassigned": "5", "user-1": "4", "user-2": "3"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations/search" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("locationId") != "loc-1" || query.Get("status") != "unread" || query.Get("limit") != "1" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"conversations":[{"id":"conv-1","unreadCount":2,"lastMessageDate":1760000000000}],"total":` + totals[query.Get("assignedTo")] + `}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	counts, err := client.Conversations.UnreadCounts(context.Background(), "loc-1", "user-1", "user-2")
+	if err != nil {
+		t.Fatalf("UnreadCounts failed: %v", err)
+	}
+	if counts.Total != 12 || counts.Unassigned != 5 {
+		t.Errorf("Unexpected totals: %+v", counts)
+	}
+	if counts.ByAssignee["user-1"] != 4 || counts.ByAssignee["user-2"] != 3 {
+		t.Errorf("Unexpected per-assignee counts: %+v", counts.ByAssignee)
+	}
+}
+
+func TestConversations_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAfterDate") != "1760000000000" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"conversations":[{"id":"conv-1","assignedTo":"user-1","unreadCount":2,"lastMessageDate":1760000000000}],"total":1}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	result, err := client.Conversations.Search(&SearchConversationsOptions{LocationID: "loc-1", StartAfterDate: 1760000000000})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(result.Conversations) != 1 || result.Conversations[0].LastMessageDate.UnixMilli() != 1760000000000 {
+		t.Errorf("Unexpected conversations: %+v", result.Conversations)
+	}
+
+	if _, err := client.Conversations.Search(nil); err == nil {
+		t.Error("Expected error for missing location")
+	}
+}
@@ -0,0 +1,151 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CouponsService handles operations related to payment coupons
+type CouponsService struct {
+	client *Client
+}
+
+// Coupon represents a discount coupon
+type Coupon struct {
+	ID                    string   `json:"_id,omitempty"`
+	AltID                 string   `json:"altId,omitempty"`
+	AltType               string   `json:"altType,omitempty"`
+	Name                  string   `json:"name,omitempty"`
+	Code                  string   `json:"code,omitempty"`
+	DiscountType          string   `json:"discountType,omitempty"` // "percentage" or "amount"
+	DiscountValue         float64  `json:"discountValue,omitempty"`
+	Status                string   `json:"status,omitempty"` // "scheduled", "active" or "expired"
+	StartDate             Time     `json:"startDate,omitempty"`
+	EndDate               Time     `json:"endDate,omitempty"` // Empty if the coupon does not expire
+	UsageCount            int      `json:"usageCount,omitempty"`
+	UsageLimit            int      `json:"usageLimit,omitempty"` // 0 for unlimited
+	LimitPerCustomer      int      `json:"limitPerCustomer,omitempty"`
+	ProductIDs            []string `json:"productIds,omitempty"` // Empty if the coupon applies to every product
+	ApplyToFuturePayments bool     `json:"applyToFuturePayments,omitempty"`
+	CreatedAt             Time     `json:"createdAt,omitempty"`
+	UpdatedAt             Time     `json:"updatedAt,omitempty"`
+}
+
+// ListCouponsOptions represents query options for listing coupons
+type ListCouponsOptions struct {
+	LocationID string
+	Status     string
+	Search     string // Matches coupon name or code
+	Limit      int
+	Offset     int
+}
+
+// CouponsResponse represents a list of coupons API response
+type CouponsResponse struct {
+	Data       []Coupon `json:"data,omitempty"`
+	TotalCount int      `json:"totalCount,omitempty"`
+}
+
+// List retrieves the coupons of a location
+// Required scope: payments/coupons.readonly
+func (s *CouponsService) List(opts *ListCouponsOptions) (*CouponsResponse, error) {
+	if opts == nil {
+		opts = &ListCouponsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := altLocationQuery(opts.LocationID)
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+
+	var result CouponsResponse
+	err := s.client.doRequest("GET", "/payments/coupon/list?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Reasons a coupon is not applicable (CouponValidation.Reason)
+const (
+	CouponReasonNotFound           = "not_found"
+	CouponReasonNotStarted         = "not_started"
+	CouponReasonExpired            = "expired"
+	CouponReasonUsageLimitReached  = "usage_limit_reached"
+	CouponReasonProductNotEligible = "product_not_eligible"
+)
+
+// CouponValidation is the result of checking a coupon code
+type CouponValidation struct {
+	Valid  bool
+	Reason string  // CouponReason* constant when not valid
+	Coupon *Coupon // nil if no coupon has the code
+}
+
+// Validate checks whether a coupon code can be used now, before a checkout or payment link is
+// generated with it: the coupon must be active, within its dates and usage limit, and, if
+// productID is given, apply to that product. Codes are compared case-insensitively.
+// Per-customer limits depend on the customer's past orders, so they are not checked.
+// Required scope: payments/coupons.readonly
+func (s *CouponsService) Validate(locationID, code, productID string) (*CouponValidation, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("code is required")
+	}
+
+	result, err := s.List(&ListCouponsOptions{LocationID: locationID, Search: code, Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Data {
+		if strings.EqualFold(result.Data[i].Code, code) {
+			return validateCoupon(&result.Data[i], productID, time.Now()), nil
+		}
+	}
+	return &CouponValidation{Reason: CouponReasonNotFound}, nil
+}
+
+// validateCoupon checks a coupon against its status, dates, usage limit and products at now
+func validateCoupon(coupon *Coupon, productID string, now time.Time) *CouponValidation {
+	invalid := func(reason string) *CouponValidation {
+		return &CouponValidation{Reason: reason, Coupon: coupon}
+	}
+
+	switch coupon.Status {
+	case CouponStatusScheduled:
+		return invalid(CouponReasonNotStarted)
+	case CouponStatusExpired:
+		return invalid(CouponReasonExpired)
+	}
+	// The status is updated by the API periodically, so check the dates as well
+	if !coupon.StartDate.IsZero() && now.Before(coupon.StartDate.Time) {
+		return invalid(CouponReasonNotStarted)
+	}
+	if !coupon.EndDate.IsZero() && !now.Before(coupon.EndDate.Time) {
+		return invalid(CouponReasonExpired)
+	}
+	if coupon.UsageLimit > 0 && coupon.UsageCount >= coupon.UsageLimit {
+		return invalid(CouponReasonUsageLimitReached)
+	}
+	if productID != "" && len(coupon.ProductIDs) > 0 && !slices.Contains(coupon.ProductIDs, productID) {
+		return invalid(CouponReasonProductNotEligible)
+	}
+	return &CouponValidation{Valid: true, Coupon: coupon}
+}
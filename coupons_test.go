@@ -0,0 +1,69 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCouponsService_Validate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/payments/coupon/list" || q.Get("altId") != "loc-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		switch q.Get("search") {
+		case "SUMMER":
+			// The search also matches codes that only contain the term
+			_, _ = w.Write([]byte(`{"data":[
+				{"_id":"cp-2","code":"SUMMER2025","status":"expired"},
+				{"_id":"cp-1","code":"summer","status":"active","productIds":["prod-1"]}
+			],"totalCount":2}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[],"totalCount":0}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc-1"})
+
+	result, err := client.Coupons.Validate("", "SUMMER", "prod-1")
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !result.Valid || result.Coupon.ID != "cp-1" {
+		t.Errorf("result = %+v", result)
+	}
+
+	if result, _ = client.Coupons.Validate("", "SUMMER", "prod-2"); result.Valid || result.Reason != CouponReasonProductNotEligible {
+		t.Errorf("other product = %+v", result)
+	}
+	if result, _ = client.Coupons.Validate("", "NOPE", ""); result.Valid || result.Reason != CouponReasonNotFound || result.Coupon != nil {
+		t.Errorf("unknown code = %+v", result)
+	}
+}
+
+func TestValidateCoupon(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		coupon Coupon
+		reason string
+	}{
+		{"active", Coupon{Status: CouponStatusActive, StartDate: Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}}, ""},
+		{"scheduled", Coupon{Status: CouponStatusScheduled}, CouponReasonNotStarted},
+		{"starts later", Coupon{Status: CouponStatusActive, StartDate: Time{Time: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)}}, CouponReasonNotStarted},
+		{"ended", Coupon{Status: CouponStatusActive, EndDate: Time{Time: time.Date(2026, 5, 31, 23, 59, 59, 0, time.UTC)}}, CouponReasonExpired},
+		{"used up", Coupon{Status: CouponStatusActive, UsageLimit: 10, UsageCount: 10}, CouponReasonUsageLimitReached},
+		{"uses left", Coupon{Status: CouponStatusActive, UsageLimit: 10, UsageCount: 9}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validateCoupon(&tt.coupon, "", now)
+			if result.Valid != (tt.reason == "") || result.Reason != tt.reason {
+				t.Errorf("validateCoupon = %+v, want reason %q", result, tt.reason)
+			}
+		})
+	}
+}
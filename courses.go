@@ -0,0 +1,86 @@
+package gohighlevel
+
+import "fmt"
+
+// CoursesService handles operations related to courses and memberships
+type CoursesService struct {
+	client *Client
+}
+
+// CourseImportRequest represents a request to import course products into a location
+type CourseImportRequest struct {
+	LocationID string          `json:"locationId"`
+	UserID     string          `json:"userId,omitempty"`
+	Products   []CourseProduct `json:"products"`
+}
+
+// CourseProduct represents a membership product (course) to import
+type CourseProduct struct {
+	Title             string            `json:"title"`
+	Description       string            `json:"description"`
+	ImageURL          string            `json:"imageUrl,omitempty"`
+	Categories        []CourseCategory  `json:"categories"`
+	InstructorDetails *CourseInstructor `json:"instructorDetails,omitempty"`
+}
+
+// CourseInstructor describes the instructor shown on a course
+type CourseInstructor struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// CourseCategory represents a module of a course.
+// SubCategories are only supported one level deep.
+type CourseCategory struct {
+	Title         string              `json:"title"`
+	Visibility    string              `json:"visibility"` // "published" or "draft"
+	ThumbnailURL  string              `json:"thumbnailUrl,omitempty"`
+	Posts         []CoursePost        `json:"posts,omitempty"`
+	SubCategories []CourseSubCategory `json:"subCategories,omitempty"`
+}
+
+// CourseSubCategory represents a sub-module of a course
+type CourseSubCategory struct {
+	Title        string       `json:"title"`
+	Visibility   string       `json:"visibility"` // "published" or "draft"
+	ThumbnailURL string       `json:"thumbnailUrl,omitempty"`
+	Posts        []CoursePost `json:"posts,omitempty"`
+}
+
+// CoursePost represents a lesson of a course
+type CoursePost struct {
+	Title          string               `json:"title"`
+	Visibility     string               `json:"visibility"`  // "published" or "draft"
+	ContentType    string               `json:"contentType"` // "video", "assignment" or "quiz"
+	Description    string               `json:"description"`
+	BucketVideoURL string               `json:"bucketVideoUrl,omitempty"`
+	PostMaterials  []CoursePostMaterial `json:"postMaterials,omitempty"`
+}
+
+// CoursePostMaterial represents a downloadable file attached to a lesson
+type CoursePostMaterial struct {
+	Title string `json:"title"`
+	Type  string `json:"type"` // e.g. "pdf", "image", "docx", "zip"
+	URL   string `json:"url"`
+}
+
+// Import imports course products, with their categories and lessons, into a location.
+// The import runs asynchronously; the products appear in the location once it completes.
+// Required scope: courses.write
+func (s *CoursesService) Import(req *CourseImportRequest) error {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if len(req.Products) == 0 {
+		return fmt.Errorf("at least one product is required")
+	}
+	for i, product := range req.Products {
+		if product.Title == "" {
+			return fmt.Errorf("products[%d]: title is required", i)
+		}
+	}
+
+	return s.client.doRequest("POST", "/courses/courses-exporter/public/import", req, nil)
+}
@@ -0,0 +1,57 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCoursesService_Import(t *testing.T) {
+	var imported CourseImportRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /courses/courses-exporter/public/import": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&imported)
+			w.WriteHeader(http.StatusCreated)
+		},
+	})
+
+	req := &CourseImportRequest{
+		LocationID: "loc-1",
+		UserID:     "user-1",
+		Products: []CourseProduct{{
+			Title:       "Go Basics",
+			Description: "An introduction to Go",
+			Categories: []CourseCategory{{
+				Title:      "Getting started",
+				Visibility: "published",
+				Posts: []CoursePost{{
+					Title:       "Installing Go",
+					Visibility:  "published",
+					ContentType: "video",
+				}},
+				SubCategories: []CourseSubCategory{{Title: "Tooling", Visibility: "draft"}},
+			}},
+		}},
+	}
+	if err := client.Courses.Import(req); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if imported.LocationID != "loc-1" || imported.UserID != "user-1" || len(imported.Products) != 1 {
+		t.Fatalf("Unexpected import request: %+v", imported)
+	}
+	category := imported.Products[0].Categories[0]
+	if len(category.Posts) != 1 || category.Posts[0].ContentType != "video" || len(category.SubCategories) != 1 {
+		t.Errorf("Unexpected category: %+v", category)
+	}
+
+	if err := client.Courses.Import(&CourseImportRequest{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error for missing products")
+	}
+	if err := client.Courses.Import(&CourseImportRequest{LocationID: "loc-1", Products: []CourseProduct{{Title: "Go Basics"}, {}}}); err == nil {
+		t.Error("Expected error for a product without a title")
+	}
+
+	if err := client.Courses.Import(&CourseImportRequest{UserID: "user-1", Products: req.Products}); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
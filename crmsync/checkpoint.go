@@ -0,0 +1,86 @@
+package crmsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+// Checkpoint is the state of the last completed sync
+type Checkpoint struct {
+	// SyncedAt is when the sync completed
+	SyncedAt time.Time `json:"syncedAt"`
+	// Hashes holds the fingerprint of each synced record's fields by key, used to tell which
+	// side changed since
+	Hashes map[string]string `json:"hashes"`
+}
+
+// CheckpointStore persists checkpoints between runs
+type CheckpointStore interface {
+	// Load returns the last checkpoint, or nil if there is none
+	Load(ctx context.Context) (*Checkpoint, error)
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+}
+
+// MemoryCheckpointStore keeps the checkpoint in memory, e.g. for tests or long-running processes
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+// Load implements CheckpointStore
+func (s *MemoryCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoint, nil
+}
+
+// Save implements CheckpointStore
+func (s *MemoryCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = checkpoint
+	return nil
+}
+
+// FileCheckpointStore keeps the checkpoint in a JSON file
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore returns a checkpoint store backed by the file at path
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load implements CheckpointStore
+func (s *FileCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// Save implements CheckpointStore. The file is replaced atomically.
+func (s *FileCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,87 @@
+// Package crmsync reconciles records between GoHighLevel and other systems, such as another CRM
+// or a database. Implement Source for the system of record and Sink for the system being kept
+// in step, then run an Engine:
+//
+//	engine := &crmsync.Engine{
+//		Source:      postgresContacts,
+//		Sink:        crmsync.NewContactsStore(client.Contacts, locationID),
+//		Checkpoints: crmsync.NewFileCheckpointStore("ghl-sync.json"),
+//		Conflicts:   crmsync.NewestWins,
+//	}
+//	result, err := engine.Run(ctx)
+package crmsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+)
+
+// Record is a system-neutral view of an entity being synced
+type Record struct {
+	// Key identifies the same entity in both systems, e.g. a lowercased email or an external ID
+	Key string
+	// ID is the record's ID in the system it was read from
+	ID string
+	// Fields are the synced values; fields absent from the map are not synced
+	Fields map[string]string
+	// UpdatedAt is when the record last changed, used by NewestWins
+	UpdatedAt time.Time
+}
+
+// hash returns a fingerprint of the record's fields
+func (r Record) hash() string {
+	return r.hashOf(r.Fields)
+}
+
+// hashOf returns a fingerprint of the record's values for the fields named in fields, so that a
+// sink record carrying more fields than the source can be compared with it. Values are
+// normalized as ContactRecord formats them.
+func (r Record) hashOf(fields map[string]string) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(normalizedValue(name, r.Fields[name])))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Source is a system records are read from
+type Source interface {
+	// List returns every record to sync
+	List(ctx context.Context) ([]Record, error)
+}
+
+// Sink is a system records are written to
+type Sink interface {
+	Source
+	// Create creates a record and returns its ID
+	Create(ctx context.Context, record Record) (string, error)
+	// Update overwrites the fields of the record with the given ID
+	Update(ctx context.Context, id string, record Record) error
+	// Delete deletes the record with the given ID
+	Delete(ctx context.Context, id string) error
+}
+
+// ConflictPolicy decides whether the source record overwrites the sink record when both
+// changed since the last sync
+type ConflictPolicy func(source, sink Record) bool
+
+// SourceWins always overwrites the sink record
+func SourceWins(source, sink Record) bool { return true }
+
+// SinkWins keeps the sink record
+func SinkWins(source, sink Record) bool { return false }
+
+// NewestWins keeps whichever record was updated last, the source on a tie
+func NewestWins(source, sink Record) bool { return !sink.UpdatedAt.After(source.UpdatedAt) }
@@ -0,0 +1,365 @@
+package crmsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+)
+
+type memStore struct {
+	records map[string]Record // By ID
+	nextID  int
+	failOn  string // Key whose writes fail
+}
+
+func newMemStore(records ...Record) *memStore {
+	s := &memStore{records: make(map[string]Record)}
+	for _, r := range records {
+		if r.ID == "" {
+			s.nextID++
+			r.ID = "id-" + string(rune('0'+s.nextID))
+		}
+		s.records[r.ID] = r
+	}
+	return s
+}
+
+func (s *memStore) List(ctx context.Context) ([]Record, error) {
+	var records []Record
+	for _, r := range s.records {
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func (s *memStore) Create(ctx context.Context, r Record) (string, error) {
+	if r.Key == s.failOn {
+		return "", errors.New("boom")
+	}
+	s.nextID++
+	r.ID = "new-" + string(rune('0'+s.nextID))
+	s.records[r.ID] = r
+	return r.ID, nil
+}
+
+func (s *memStore) Update(ctx context.Context, id string, r Record) error {
+	if r.Key == s.failOn {
+		return errors.New("boom")
+	}
+	r.ID = id
+	s.records[id] = r
+	return nil
+}
+
+func (s *memStore) Delete(ctx context.Context, id string) error {
+	delete(s.records, id)
+	return nil
+}
+
+func (s *memStore) byKey(key string) (Record, bool) {
+	for _, r := range s.records {
+		if r.Key == key {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
+
+func rec(key, name string) Record {
+	return Record{Key: key, Fields: map[string]string{"name": name}}
+}
+
+func ops(result *Result) map[string]Op {
+	m := make(map[string]Op)
+	for _, a := range result.Actions {
+		m[a.Key] = a.Op
+	}
+	return m
+}
+
+func TestEngine_FirstRun(t *testing.T) {
+	source := newMemStore(rec("a", "Alice"), rec("b", "Bob"), rec("c", "Carol"))
+	sink := newMemStore(rec("b", "Bob"), rec("c", "Caroline"), rec("d", "Dave"))
+
+	engine := &Engine{Source: source, Sink: sink, DeleteMissing: true}
+	result, err := engine.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	want := map[string]Op{"a": OpCreate, "c": OpUpdate, "d": OpDelete}
+	if got := ops(result); !reflect.DeepEqual(got, want) {
+		t.Errorf("actions = %v, want %v", got, want)
+	}
+	if result.Unchanged != 1 {
+		t.Errorf("Unchanged = %d, want 1", result.Unchanged)
+	}
+	if r, _ := sink.byKey("c"); r.Fields["name"] != "Carol" {
+		t.Errorf("c = %q, want Carol", r.Fields["name"])
+	}
+	if _, ok := sink.byKey("d"); ok {
+		t.Error("d was not deleted")
+	}
+}
+
+func TestEngine_DryRun(t *testing.T) {
+	source := newMemStore(rec("a", "Alice"))
+	sink := newMemStore(rec("b", "Bob"))
+	checkpoints := &MemoryCheckpointStore{}
+
+	engine := &Engine{Source: source, Sink: sink, Checkpoints: checkpoints, DeleteMissing: true, DryRun: true}
+	result, err := engine.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Count(OpCreate) != 1 || result.Count(OpDelete) != 1 {
+		t.Errorf("actions = %v", ops(result))
+	}
+	if len(sink.records) != 1 {
+		t.Errorf("sink was modified: %v", sink.records)
+	}
+	if cp, _ := checkpoints.Load(context.Background()); cp != nil {
+		t.Error("checkpoint saved on dry run")
+	}
+}
+
+func TestEngine_Checkpoint(t *testing.T) {
+	ctx := context.Background()
+	source := newMemStore(rec("a", "Alice"), rec("b", "Bob"), rec("c", "Carol"))
+	sink := newMemStore()
+	checkpoints := &MemoryCheckpointStore{}
+	engine := &Engine{Source: source, Sink: sink, Checkpoints: checkpoints, Conflicts: SinkWins, DeleteMissing: true}
+
+	if _, err := engine.Run(ctx); err != nil {
+		t.Fatalf("first Run: %v", err)
+	}
+
+	// a: edited in the sink only, b: edited on both sides, c: edited in the source only,
+	// e: created in the sink only
+	edit := func(s *memStore, key, name string) {
+		r, _ := s.byKey(key)
+		r.Fields = map[string]string{"name": name}
+		s.records[r.ID] = r
+	}
+	edit(sink, "a", "Alicia")
+	edit(sink, "b", "Robert")
+	edit(source, "b", "Bobby")
+	edit(source, "c", "Caroline")
+	sink.records["manual"] = Record{Key: "e", ID: "manual", Fields: map[string]string{"name": "Eve"}}
+
+	result, err := engine.Run(ctx)
+	if err != nil {
+		t.Fatalf("second Run: %v", err)
+	}
+	want := map[string]Op{"b": OpSkip, "c": OpUpdate}
+	if got := ops(result); !reflect.DeepEqual(got, want) {
+		t.Errorf("actions = %v, want %v", got, want)
+	}
+	if r, _ := sink.byKey("a"); r.Fields["name"] != "Alicia" {
+		t.Errorf("sink edit of a was overwritten: %q", r.Fields["name"])
+	}
+	if r, _ := sink.byKey("b"); r.Fields["name"] != "Robert" {
+		t.Errorf("conflict on b not resolved for the sink: %q", r.Fields["name"])
+	}
+	if _, ok := sink.byKey("e"); !ok {
+		t.Error("record created in the sink was deleted")
+	}
+
+	// Removing a synced record from the source deletes it from the sink; the conflict on b was
+	// settled by the previous run
+	source.Delete(ctx, mustKey(t, source, "c").ID)
+	result, err = engine.Run(ctx)
+	if err != nil {
+		t.Fatalf("third Run: %v", err)
+	}
+	if got := ops(result); !reflect.DeepEqual(got, map[string]Op{"c": OpDelete}) {
+		t.Errorf("actions = %v", got)
+	}
+}
+
+func TestEngine_FailedWriteRetried(t *testing.T) {
+	ctx := context.Background()
+	source := newMemStore(rec("a", "Alice"))
+	sink := newMemStore()
+	sink.failOn = "a"
+	engine := &Engine{Source: source, Sink: sink, Checkpoints: &MemoryCheckpointStore{}}
+
+	result, err := engine.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(result.Errors()) != 1 {
+		t.Fatalf("Errors = %v, want 1", result.Errors())
+	}
+
+	sink.failOn = ""
+	result, err = engine.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Count(OpCreate) != 1 || len(result.Errors()) != 0 {
+		t.Errorf("actions = %v, errors = %v", ops(result), result.Errors())
+	}
+}
+
+func TestEngine_SubsetOfSinkFields(t *testing.T) {
+	ctx := context.Background()
+	// The source syncs two fields; the sink, like ContactsStore, reports every contact field
+	// with a lowercased email
+	source := newMemStore(Record{Key: "jane@example.com", Fields: map[string]string{FieldFirstName: "Jane", FieldEmail: "Jane@Example.com"}})
+	sink := newMemStore(Record{Key: "jane@example.com", Fields: map[string]string{
+		FieldFirstName:   "Jane",
+		FieldLastName:    "Doe",
+		FieldEmail:       "jane@example.com",
+		FieldPhone:       "+15550100",
+		FieldCompanyName: "Acme",
+		FieldTags:        "customer,vip",
+	}})
+	checkpoints := &MemoryCheckpointStore{}
+	engine := &Engine{Source: source, Sink: sink, Checkpoints: checkpoints, Conflicts: SinkWins}
+
+	result, err := engine.Run(ctx)
+	if err != nil {
+		t.Fatalf("first Run: %v", err)
+	}
+	if len(result.Actions) != 0 || result.Unchanged != 1 {
+		t.Errorf("first run actions = %v, unchanged %d; want none and 1", ops(result), result.Unchanged)
+	}
+
+	// Only the source changed since the checkpoint, so SinkWins has no conflict to settle
+	r := mustKey(t, source, "jane@example.com")
+	r.Fields = map[string]string{FieldFirstName: "Janet", FieldEmail: "Jane@Example.com"}
+	source.records[r.ID] = r
+
+	result, err = engine.Run(ctx)
+	if err != nil {
+		t.Fatalf("second Run: %v", err)
+	}
+	if got := ops(result); !reflect.DeepEqual(got, map[string]Op{"jane@example.com": OpUpdate}) {
+		t.Errorf("actions = %v, want an update", got)
+	}
+	if r, _ := sink.byKey("jane@example.com"); r.Fields[FieldFirstName] != "Janet" {
+		t.Errorf("first name = %q, want Janet", r.Fields[FieldFirstName])
+	}
+}
+
+func TestNewestWins(t *testing.T) {
+	older := Record{UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	newer := Record{UpdatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+	if !NewestWins(newer, older) {
+		t.Error("newer source should win")
+	}
+	if NewestWins(older, newer) {
+		t.Error("newer sink should win")
+	}
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "sync.json"))
+
+	cp, err := store.Load(ctx)
+	if err != nil || cp != nil {
+		t.Fatalf("Load on missing file = %v, %v", cp, err)
+	}
+
+	want := &Checkpoint{SyncedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Hashes: map[string]string{"a": "h"}}
+	if err := store.Save(ctx, want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !got.SyncedAt.Equal(want.SyncedAt) || !reflect.DeepEqual(got.Hashes, want.Hashes) {
+		t.Errorf("Load = %+v, want %+v", got, want)
+	}
+}
+
+func mustKey(t *testing.T, s *memStore, key string) Record {
+	t.Helper()
+	r, ok := s.byKey(key)
+	if !ok {
+		t.Fatalf("no record %q", key)
+	}
+	return r
+}
+
+type fakeContactsAPI struct {
+	contacts []ghl.Contact
+	created  []*ghl.CreateContactRequest
+	patches  map[string]map[string]interface{}
+	deleted  []string
+}
+
+func (f *fakeContactsAPI) List(opts *ghl.GetContactsOptions) (*ghl.ContactsResponse, error) {
+	return &ghl.ContactsResponse{Contacts: f.contacts}, nil
+}
+
+func (f *fakeContactsAPI) Create(req *ghl.CreateContactRequest) (*ghl.Contact, error) {
+	f.created = append(f.created, req)
+	return &ghl.Contact{ID: "created"}, nil
+}
+
+func (f *fakeContactsAPI) Patch(contactID string, patch *ghl.ContactPatch) (*ghl.Contact, error) {
+	data, _ := json.Marshal(patch)
+	var body map[string]interface{}
+	_ = json.Unmarshal(data, &body)
+	if f.patches == nil {
+		f.patches = make(map[string]map[string]interface{})
+	}
+	f.patches[contactID] = body
+	return &ghl.Contact{ID: contactID}, nil
+}
+
+func (f *fakeContactsAPI) Delete(contactID string) error {
+	f.deleted = append(f.deleted, contactID)
+	return nil
+}
+
+func TestContactsStore(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeContactsAPI{contacts: []ghl.Contact{
+		{ID: "c1", Email: "Alice@Example.com", FirstName: "Alice", Tags: []string{"VIP", "lead"}},
+		{ID: "c2", Phone: "+15550100"},
+	}}
+	store := NewContactsStore(api, "loc")
+
+	records, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("List returned %d records, want 1 (contacts without email are skipped)", len(records))
+	}
+	if r := records[0]; r.Key != "alice@example.com" || r.ID != "c1" || r.Fields[FieldTags] != "lead,vip" {
+		t.Errorf("record = %+v", r)
+	}
+
+	id, err := store.Create(ctx, Record{Key: "bob@example.com", Fields: map[string]string{FieldFirstName: "Bob", FieldTags: "a,b"}})
+	if err != nil || id != "created" {
+		t.Fatalf("Create = %q, %v", id, err)
+	}
+	if req := api.created[0]; req.LocationID != "loc" || req.Email != "bob@example.com" || !reflect.DeepEqual(req.Tags, []string{"a", "b"}) {
+		t.Errorf("create request = %+v", req)
+	}
+
+	err = store.Update(ctx, "c1", Record{Key: "alice@example.com", Fields: map[string]string{FieldFirstName: "Alicia", FieldLastName: ""}})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	want := map[string]interface{}{"firstName": "Alicia", "lastName": nil}
+	if got := api.patches["c1"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("patch = %v, want %v", got, want)
+	}
+
+	if err := store.Delete(ctx, "c1"); err != nil || !reflect.DeepEqual(api.deleted, []string{"c1"}) {
+		t.Errorf("Delete = %v, deleted %v", err, api.deleted)
+	}
+}
@@ -0,0 +1,203 @@
+package crmsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Op is an operation applied to the sink
+type Op string
+
+// Operations reported in a Result
+const (
+	OpCreate Op = "create"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+	OpSkip   Op = "skip" // A conflict resolved in favor of the sink
+)
+
+// Action is one operation of a sync run
+type Action struct {
+	Op     Op
+	Key    string
+	ID     string // ID of the sink record, empty for a create that failed or was not applied
+	Record Record // The source record, or the sink record for deletes and skips
+	Err    error
+}
+
+// Result summarizes a sync run
+type Result struct {
+	DryRun    bool
+	Actions   []Action
+	Unchanged int
+}
+
+// Count returns the number of actions of an operation
+func (r *Result) Count(op Op) int {
+	n := 0
+	for _, a := range r.Actions {
+		if a.Op == op {
+			n++
+		}
+	}
+	return n
+}
+
+// Errors returns the actions that failed
+func (r *Result) Errors() []Action {
+	var failed []Action
+	for _, a := range r.Actions {
+		if a.Err != nil {
+			failed = append(failed, a)
+		}
+	}
+	return failed
+}
+
+// Engine reconciles a sink with a source. Records are matched by Key:
+//
+//   - records only in the source are created in the sink
+//   - records whose fields differ are updated when only the source changed since the last
+//     checkpoint; when both sides changed, Conflicts decides
+//   - records only in the sink are deleted when DeleteMissing is set; with a checkpoint, only
+//     records that were synced before are deleted, so records created directly in the sink
+//     are left alone
+type Engine struct {
+	Source      Source
+	Sink        Sink
+	Checkpoints CheckpointStore // Optional; without it every difference overwrites the sink
+	Conflicts   ConflictPolicy  // Default SourceWins
+	// DeleteMissing deletes sink records whose key is no longer in the source
+	DeleteMissing bool
+	// DryRun reports the actions without applying them or saving a checkpoint
+	DryRun bool
+}
+
+// Run performs one sync. Errors applying individual records are reported in the result and do
+// not stop the run; the returned error is for failures reading either side or the checkpoint.
+func (e *Engine) Run(ctx context.Context) (*Result, error) {
+	conflicts := e.Conflicts
+	if conflicts == nil {
+		conflicts = SourceWins
+	}
+
+	var previous *Checkpoint
+	if e.Checkpoints != nil {
+		var err error
+		if previous, err = e.Checkpoints.Load(ctx); err != nil {
+			return nil, fmt.Errorf("load checkpoint: %w", err)
+		}
+	}
+
+	sourceRecords, err := e.Source.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list source: %w", err)
+	}
+	sinkRecords, err := e.Sink.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sink: %w", err)
+	}
+
+	sinkByKey := make(map[string]Record, len(sinkRecords))
+	for _, r := range sinkRecords {
+		sinkByKey[r.Key] = r
+	}
+
+	result := &Result{DryRun: e.DryRun}
+	next := &Checkpoint{Hashes: make(map[string]string, len(sourceRecords))}
+	inSource := make(map[string]bool, len(sourceRecords))
+
+	for _, src := range sourceRecords {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		inSource[src.Key] = true
+		srcHash := src.hash()
+
+		sink, exists := sinkByKey[src.Key]
+		if !exists {
+			action := Action{Op: OpCreate, Key: src.Key, Record: src}
+			if !e.DryRun {
+				action.ID, action.Err = e.Sink.Create(ctx, src)
+			}
+			e.record(result, next, previous, action, srcHash)
+			continue
+		}
+
+		// Only the fields the source syncs are compared; the sink may carry more
+		sinkHash := sink.hashOf(src.Fields)
+		if sinkHash == srcHash {
+			result.Unchanged++
+			next.Hashes[src.Key] = srcHash
+			continue
+		}
+
+		sourceChanged, sinkChanged := true, false
+		if previous != nil {
+			if last, ok := previous.Hashes[src.Key]; ok {
+				sourceChanged = last != srcHash
+				sinkChanged = last != sinkHash
+			}
+		}
+
+		var action Action
+		switch {
+		case !sourceChanged:
+			// Only the sink was edited since the last sync; keep the edit
+			result.Unchanged++
+			next.Hashes[src.Key] = srcHash
+			continue
+		case sinkChanged && !conflicts(src, sink):
+			action = Action{Op: OpSkip, Key: src.Key, ID: sink.ID, Record: sink}
+		default:
+			action = Action{Op: OpUpdate, Key: src.Key, ID: sink.ID, Record: src}
+			if !e.DryRun {
+				action.Err = e.Sink.Update(ctx, sink.ID, src)
+			}
+		}
+		e.record(result, next, previous, action, srcHash)
+	}
+
+	if e.DeleteMissing {
+		for _, sink := range sinkRecords {
+			if inSource[sink.Key] {
+				continue
+			}
+			if previous != nil {
+				if _, synced := previous.Hashes[sink.Key]; !synced {
+					continue
+				}
+			}
+			action := Action{Op: OpDelete, Key: sink.Key, ID: sink.ID, Record: sink}
+			if !e.DryRun {
+				action.Err = e.Sink.Delete(ctx, sink.ID)
+			}
+			if action.Err != nil && previous != nil {
+				next.Hashes[sink.Key] = previous.Hashes[sink.Key]
+			}
+			result.Actions = append(result.Actions, action)
+		}
+	}
+
+	if e.Checkpoints != nil && !e.DryRun {
+		next.SyncedAt = time.Now()
+		if err := e.Checkpoints.Save(ctx, next); err != nil {
+			return result, fmt.Errorf("save checkpoint: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// record adds an action to the result and the new checkpoint. A failed action keeps the key's
+// previous hash, so the record is retried as changed on the next run.
+func (e *Engine) record(result *Result, next, previous *Checkpoint, action Action, srcHash string) {
+	result.Actions = append(result.Actions, action)
+	if action.Err == nil {
+		next.Hashes[action.Key] = srcHash
+	} else if previous != nil {
+		if last, ok := previous.Hashes[action.Key]; ok {
+			next.Hashes[action.Key] = last
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package crmsync
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+)
+
+// Contact fields synced by ContactsStore
+const (
+	FieldFirstName   = "firstName"
+	FieldLastName    = "lastName"
+	FieldEmail       = "email"
+	FieldPhone       = "phone"
+	FieldCompanyName = "companyName"
+	FieldTags        = "tags" // Comma-separated, sorted
+)
+
+// ContactsAPI is the part of the contacts API used by ContactsStore. *ghl.ContactsService
+// implements it.
+type ContactsAPI interface {
+	List(opts *ghl.GetContactsOptions) (*ghl.ContactsResponse, error)
+	Create(req *ghl.CreateContactRequest) (*ghl.Contact, error)
+	Patch(contactID string, patch *ghl.ContactPatch) (*ghl.Contact, error)
+	Delete(contactID string) error
+}
+
+// ContactsStore is a Sink, and Source, over the contacts of a GoHighLevel location. Contacts
+// are keyed by lowercased email address; contacts without an email are not synced.
+type ContactsStore struct {
+	api        ContactsAPI
+	locationID string
+}
+
+// NewContactsStore returns a store over the contacts of a location
+func NewContactsStore(api ContactsAPI, locationID string) *ContactsStore {
+	return &ContactsStore{api: api, locationID: locationID}
+}
+
+// contactsPageSize is the page size used when listing contacts
+const contactsPageSize = 100
+
+// List returns every contact of the location that has an email address
+func (s *ContactsStore) List(ctx context.Context) ([]Record, error) {
+	var records []Record
+	opts := &ghl.GetContactsOptions{LocationID: s.locationID, Limit: contactsPageSize}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page, err := s.api.List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.Contacts {
+			if record, ok := ContactRecord(c); ok {
+				records = append(records, record)
+			}
+		}
+		if len(page.Contacts) < contactsPageSize {
+			return records, nil
+		}
+
+		last := page.Contacts[len(page.Contacts)-1]
+		opts.StartAfterID = last.ID
+		opts.StartAfter = strconv.FormatInt(last.DateAdded.UnixMilli(), 10)
+	}
+}
+
+// Create creates a contact from a record
+func (s *ContactsStore) Create(ctx context.Context, record Record) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	contact, err := s.api.Create(&ghl.CreateContactRequest{
+		LocationID:  s.locationID,
+		FirstName:   record.Fields[FieldFirstName],
+		LastName:    record.Fields[FieldLastName],
+		Email:       recordEmail(record),
+		Phone:       record.Fields[FieldPhone],
+		CompanyName: record.Fields[FieldCompanyName],
+		Tags:        splitTags(record.Fields[FieldTags]),
+	})
+	if err != nil {
+		return "", err
+	}
+	return contact.ID, nil
+}
+
+// Update patches the synced fields of a contact. Fields missing from the record are left
+// unchanged; fields present but empty are cleared.
+func (s *ContactsStore) Update(ctx context.Context, id string, record Record) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	patch := ghl.NewContactPatch()
+	for name, value := range record.Fields {
+		switch name {
+		case FieldFirstName:
+			setOrClear(value, patch.SetFirstName, patch.ClearFirstName)
+		case FieldLastName:
+			setOrClear(value, patch.SetLastName, patch.ClearLastName)
+		case FieldEmail:
+			setOrClear(value, patch.SetEmail, patch.ClearEmail)
+		case FieldPhone:
+			setOrClear(value, patch.SetPhone, patch.ClearPhone)
+		case FieldCompanyName:
+			setOrClear(value, patch.SetCompanyName, patch.ClearCompanyName)
+		case FieldTags:
+			patch.SetTags(splitTags(value))
+		}
+	}
+	if patch.IsEmpty() {
+		return nil
+	}
+	_, err := s.api.Patch(id, patch)
+	return err
+}
+
+// Delete deletes a contact
+func (s *ContactsStore) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.api.Delete(id)
+}
+
+// ContactRecord converts a contact to a record. It reports false for contacts without an email
+// address, which cannot be keyed.
+func ContactRecord(c ghl.Contact) (Record, bool) {
+	key := strings.ToLower(strings.TrimSpace(c.Email))
+	if key == "" {
+		return Record{}, false
+	}
+	updatedAt := c.DateUpdated.Time
+	if updatedAt.IsZero() {
+		updatedAt = c.DateAdded.Time
+	}
+	return Record{
+		Key: key,
+		ID:  c.ID,
+		Fields: map[string]string{
+			FieldFirstName:   c.FirstName,
+			FieldLastName:    c.LastName,
+			FieldEmail:       key,
+			FieldPhone:       c.Phone,
+			FieldCompanyName: c.CompanyName,
+			FieldTags:        JoinTags(c.Tags),
+		},
+		UpdatedAt: updatedAt,
+	}, true
+}
+
+// JoinTags formats tags as a FieldTags value. Tags are lowercased and sorted so that the same
+// set of tags always compares equal.
+func JoinTags(tags []string) string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, ",")
+}
+
+// normalizedValue formats a field value as ContactRecord does, so that a source keeping the
+// original case of an email or the order of tags compares equal to the contact
+func normalizedValue(name, value string) string {
+	switch name {
+	case FieldEmail:
+		return strings.ToLower(strings.TrimSpace(value))
+	case FieldTags:
+		return JoinTags(strings.Split(value, ","))
+	}
+	return value
+}
+
+// splitTags parses a FieldTags value
+func splitTags(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// recordEmail returns the email of a record, falling back to its key
+func recordEmail(record Record) string {
+	if email := record.Fields[FieldEmail]; email != "" {
+		return email
+	}
+	return record.Key
+}
+
+// setOrClear sets a patch field to value, or clears it when value is empty
+func setOrClear(value string, set func(string) *ghl.ContactPatch, clear func() *ghl.ContactPatch) {
+	if value == "" {
+		clear()
+	} else {
+		set(value)
+	}
+}
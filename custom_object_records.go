@@ -0,0 +1,252 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ObjectRecord represents a record of a custom object.
+// Well-known attributes are typed fields; the object-specific values live in Properties,
+// keyed by field key (without the "custom_objects.<object>." prefix).
+type ObjectRecord struct {
+	ID          string                 `json:"id,omitempty"`
+	Owner       []string               `json:"owner,omitempty"`
+	Followers   []string               `json:"followers,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	DateAdded   Time                   `json:"dateAdded,omitempty"`
+	DateUpdated Time                   `json:"dateUpdated,omitempty"`
+}
+
+// String returns the value of a text property, or "" if it is missing or not a string
+func (r *ObjectRecord) String(key string) string {
+	if v, ok := r.Properties[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// Number returns the value of a numeric property.
+// The second return value is false if the property is missing or not a number.
+func (r *ObjectRecord) Number(key string) (float64, bool) {
+	switch v := r.Properties[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Strings returns the value of a multi-select property, or nil if it is missing
+func (r *ObjectRecord) Strings(key string) []string {
+	switch v := r.Properties[key].(type) {
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// Set sets the value of a property
+func (r *ObjectRecord) Set(key string, value interface{}) {
+	if r.Properties == nil {
+		r.Properties = make(map[string]interface{})
+	}
+	r.Properties[key] = value
+}
+
+// Decode unmarshals the record properties into a caller-defined struct,
+// matching JSON tags against property keys
+func (r *ObjectRecord) Decode(v interface{}) error {
+	data, err := json.Marshal(r.Properties)
+	if err != nil {
+		return fmt.Errorf("failed to marshal record properties: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode record properties: %w", err)
+	}
+	return nil
+}
+
+// PropertiesFrom converts a caller-defined struct (or map) into a properties map
+// suitable for ObjectRecordRequest.Properties
+func PropertiesFrom(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal record properties: %w", err)
+	}
+
+	var properties map[string]interface{}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, fmt.Errorf("record properties must be a JSON object: %w", err)
+	}
+	return properties, nil
+}
+
+// ObjectRecordRequest represents a request to create or update a custom object record
+type ObjectRecordRequest struct {
+	LocationID string                 `json:"locationId"`
+	Properties map[string]interface{} `json:"properties"`
+	Owner      []string               `json:"owner,omitempty"`
+	Followers  []string               `json:"followers,omitempty"`
+}
+
+// SearchObjectRecordsRequest represents a request to search the records of a custom object
+type SearchObjectRecordsRequest struct {
+	LocationID  string         `json:"locationId"`
+	Query       string         `json:"query"`
+	Filters     []RecordFilter `json:"filters,omitempty"`
+	Sort        []RecordSort   `json:"sort,omitempty"`
+	Page        int            `json:"page,omitempty"`
+	PageLimit   int            `json:"pageLimit"`
+	SearchAfter []interface{}  `json:"searchAfter,omitempty"`
+	PageToken   string         `json:"-"` // Resumes a search from Page.NextPageToken, in place of Page
+}
+
+// ObjectRecordResponse represents a single custom object record API response
+type ObjectRecordResponse struct {
+	Record *ObjectRecord `json:"record,omitempty"`
+}
+
+// ObjectRecordsResponse represents a list of custom object records API response
+type ObjectRecordsResponse struct {
+	Records []ObjectRecord `json:"records,omitempty"`
+	Total   int            `json:"total,omitempty"`
+}
+
+// CreateRecord creates a record of a custom object
+// Required scope: objects/record.write
+func (s *CustomObjectsService) CreateRecord(objectKey string, req *ObjectRecordRequest) (*ObjectRecord, error) {
+	if objectKey == "" {
+		return nil, fmt.Errorf("objectKey is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result ObjectRecordResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/objects/%s/records", objectKey), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Record, nil
+}
+
+// GetRecord retrieves a record of a custom object by ID
+// Required scope: objects/record.readonly
+func (s *CustomObjectsService) GetRecord(objectKey, recordID string) (*ObjectRecord, error) {
+	if objectKey == "" {
+		return nil, fmt.Errorf("objectKey is required")
+	}
+	if recordID == "" {
+		return nil, fmt.Errorf("recordId is required")
+	}
+
+	var result ObjectRecordResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/objects/%s/records/%s", objectKey, recordID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Record, nil
+}
+
+// UpdateRecord updates a record of a custom object.
+// Only the properties present in the request are changed.
+// Required scope: objects/record.write
+func (s *CustomObjectsService) UpdateRecord(objectKey, recordID string, req *ObjectRecordRequest) (*ObjectRecord, error) {
+	if objectKey == "" {
+		return nil, fmt.Errorf("objectKey is required")
+	}
+	if recordID == "" {
+		return nil, fmt.Errorf("recordId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result ObjectRecordResponse
+	path := fmt.Sprintf("/objects/%s/records/%s?%s", objectKey, recordID, locationQuery(req.LocationID).Encode())
+	err := s.client.doRequest("PUT", path, req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Record, nil
+}
+
+// DeleteRecord deletes a record of a custom object
+// Required scope: objects/record.write
+func (s *CustomObjectsService) DeleteRecord(objectKey, recordID string) error {
+	if objectKey == "" {
+		return fmt.Errorf("objectKey is required")
+	}
+	if recordID == "" {
+		return fmt.Errorf("recordId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/objects/%s/records/%s", objectKey, recordID), nil, nil)
+}
+
+// applyPageDefaults sets the default page size and the page encoded in r.PageToken
+func (r *SearchObjectRecordsRequest) applyPageDefaults() error {
+	if r.PageLimit <= 0 {
+		r.PageLimit = 20
+	}
+	if r.PageToken != "" {
+		offset, err := parseOffsetPageToken(r.PageToken)
+		if err != nil {
+			return err
+		}
+		r.Page, r.PageToken = offset/r.PageLimit+1, ""
+	}
+	return nil
+}
+
+// SearchRecords searches the records of a custom object.
+// Query is matched against the object's searchable properties and Filters narrow the results
+// further; use Page (or SearchRecordsPage) to fetch the following pages.
+// Required scope: objects/record.readonly
+func (s *CustomObjectsService) SearchRecords(objectKey string, req *SearchObjectRecordsRequest) (*ObjectRecordsResponse, error) {
+	if objectKey == "" {
+		return nil, fmt.Errorf("objectKey is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if err := req.applyPageDefaults(); err != nil {
+		return nil, err
+	}
+	req.Sort = slices.Clone(req.Sort)
+	for i := range req.Sort {
+		if err := req.Sort[i].validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var result ObjectRecordsResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/objects/%s/records/search", objectKey), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
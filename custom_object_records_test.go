@@ -0,0 +1,141 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testPet struct {
+	Name   string   `json:"name"`
+	Age    float64  `json:"age"`
+	Colors []string `json:"colors"`
+}
+
+func TestObjectRecord_Accessors(t *testing.T) {
+	var record ObjectRecord
+	err := json.Unmarshal([]byte(`{"id":"rec-1","properties":{"name":"Rex","age":4,"colors":["black","tan"]}}`), &record)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal record: %v", err)
+	}
+
+	if got := record.String("name"); got != "Rex" {
+		t.Errorf("Expected name Rex, got %s", got)
+	}
+	if got, ok := record.Number("age"); !ok || got != 4 {
+		t.Errorf("Expected age 4, got %v (ok=%v)", got, ok)
+	}
+	if got := record.Strings("colors"); len(got) != 2 || got[1] != "tan" {
+		t.Errorf("Unexpected colors: %v", got)
+	}
+	if got := record.String("missing"); got != "" {
+		t.Errorf("Expected empty string for missing property, got %s", got)
+	}
+
+	var pet testPet
+	if err := record.Decode(&pet); err != nil {
+		t.Fatalf("Failed to decode record: %v", err)
+	}
+	if pet.Name != "Rex" || pet.Age != 4 || len(pet.Colors) != 2 {
+		t.Errorf("Unexpected decoded record: %+v", pet)
+	}
+}
+
+func TestPropertiesFrom(t *testing.T) {
+	properties, err := PropertiesFrom(testPet{Name: "Luna", Age: 2})
+	if err != nil {
+		t.Fatalf("Failed to convert properties: %v", err)
+	}
+	if properties["name"] != "Luna" {
+		t.Errorf("Expected name Luna, got %v", properties["name"])
+	}
+
+	if _, err := PropertiesFrom("not an object"); err == nil {
+		t.Error("Expected an error for a non-object value")
+	}
+}
+
+func TestPropertyFilters(t *testing.T) {
+	if f := PropertyFilter("breed", FilterEq, "Beagle"); f.Field != "properties.breed" || f.Operator != FilterEq {
+		t.Errorf("Unexpected property filter: %+v", f)
+	}
+	if f := PropertyFilter("properties.breed", FilterEq, "Beagle"); f.Field != "properties.breed" {
+		t.Errorf("Expected prefix not to be repeated, got %q", f.Field)
+	}
+
+	min := 2.0
+	f := PropertyRangeFilter("age", &min, nil)
+	bounds, ok := f.Value.(map[string]float64)
+	if !ok || bounds["gte"] != 2 || len(bounds) != 1 || f.Operator != FilterRange {
+		t.Errorf("Unexpected range filter: %+v", f)
+	}
+
+	group := AnyOf(PropertyFilter("name", FilterContains, "Rex"), PropertyFilter("name", FilterContains, "Max"))
+	if group.Group != "OR" || len(group.Filters) != 2 {
+		t.Errorf("Unexpected group: %+v", group)
+	}
+}
+
+func TestSearchRecordsPage(t *testing.T) {
+	var bodies []SearchObjectRecordsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/objects/custom_objects.pets/records/search" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body SearchObjectRecordsRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		if body.Page == 1 {
+			w.Write([]byte(`{"records":[{"id":"rec-1"},{"id":"rec-2"}],"total":3}`))
+		} else {
+			w.Write([]byte(`{"records":[{"id":"rec-3"}],"total":3}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	page, err := client.CustomObjects.SearchRecordsPage(context.Background(), "custom_objects.pets", &SearchObjectRecordsRequest{
+		LocationID: "loc-1",
+		Filters:    []RecordFilter{PropertyFilter("breed", FilterEq, "Beagle")},
+		Sort:       []RecordSort{{Field: "dateAdded"}},
+		Page:       1,
+		PageLimit:  2,
+	})
+	if err != nil {
+		t.Fatalf("SearchRecordsPage failed: %v", err)
+	}
+
+	var ids []string
+	for record, err := range page.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("Iteration failed: %v", err)
+		}
+		ids = append(ids, record.ID)
+	}
+	if len(ids) != 3 || ids[2] != "rec-3" {
+		t.Errorf("Unexpected records: %v", ids)
+	}
+	if len(bodies) != 2 || bodies[1].Page != 2 {
+		t.Fatalf("Expected a second request for page 2, got %+v", bodies)
+	}
+	first := bodies[0]
+	if len(first.Filters) != 1 || first.Filters[0].Field != "properties.breed" {
+		t.Errorf("Unexpected filters: %+v", first.Filters)
+	}
+	if len(first.Sort) != 1 || first.Sort[0].Direction != SortAsc {
+		t.Errorf("Expected default sort direction, got %+v", first.Sort)
+	}
+}
+
+func TestSearchRecords_InvalidSort(t *testing.T) {
+	client, _ := NewClient(Config{AccessToken: "token"})
+	_, err := client.CustomObjects.SearchRecords("custom_objects.pets", &SearchObjectRecordsRequest{
+		LocationID: "loc-1",
+		Sort:       []RecordSort{{Field: "dateAdded", Direction: "up"}},
+	})
+	if err == nil {
+		t.Error("Expected error for invalid sort direction")
+	}
+}
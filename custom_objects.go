@@ -0,0 +1,288 @@
+package gohighlevel
+
+import "fmt"
+
+// CustomObjectsService handles operations related to custom objects
+type CustomObjectsService struct {
+	client *Client
+}
+
+// CustomObject represents the schema of a custom (or standard) object
+type CustomObject struct {
+	ID                     string              `json:"id,omitempty"`
+	Key                    string              `json:"key,omitempty"`
+	Labels                 *CustomObjectLabels `json:"labels,omitempty"`
+	Description            string              `json:"description,omitempty"`
+	LocationID             string              `json:"locationId,omitempty"`
+	StandardObject         bool                `json:"standard,omitempty"`
+	PrimaryDisplayProperty string              `json:"primaryDisplayProperty,omitempty"`
+	SearchableProperties   []string            `json:"searchableProperties,omitempty"`
+	Type                   string              `json:"type,omitempty"`
+	DateAdded              Time                `json:"dateAdded,omitempty"`
+	DateUpdated            Time                `json:"dateUpdated,omitempty"`
+}
+
+// CustomObjectLabels holds the display names of a custom object
+type CustomObjectLabels struct {
+	Singular string `json:"singular"`
+	Plural   string `json:"plural"`
+}
+
+// ObjectField represents a field (property) of a custom object
+type ObjectField struct {
+	ID                string              `json:"id,omitempty"`
+	Name              string              `json:"name,omitempty"`
+	Description       string              `json:"description,omitempty"`
+	FieldKey          string              `json:"fieldKey,omitempty"`
+	ObjectKey         string              `json:"objectKey,omitempty"`
+	DataType          string              `json:"dataType,omitempty"`
+	ParentID          string              `json:"parentId,omitempty"`
+	LocationID        string              `json:"locationId,omitempty"`
+	Placeholder       string              `json:"placeholder,omitempty"`
+	ShowInForms       bool                `json:"showInForms,omitempty"`
+	Options           []ObjectFieldOption `json:"options,omitempty"`
+	AcceptedFormats   string              `json:"acceptedFormats,omitempty"`
+	MaxFileLimit      int                 `json:"maxFileLimit,omitempty"`
+	AllowCustomOption bool                `json:"allowCustomOption,omitempty"`
+	Standard          bool                `json:"standard,omitempty"`
+	DateAdded         Time                `json:"dateAdded,omitempty"`
+	DateUpdated       Time                `json:"dateUpdated,omitempty"`
+}
+
+// ObjectFieldOption represents a selectable option of a picklist field
+type ObjectFieldOption struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PrimaryDisplayProperty describes the field used as the display name of object records
+type PrimaryDisplayProperty struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	DataType string `json:"dataType"` // "TEXT" or "NUMERICAL"
+}
+
+// CreateCustomObjectRequest represents a request to create a custom object schema
+type CreateCustomObjectRequest struct {
+	LocationID                    string                  `json:"locationId"`
+	Key                           string                  `json:"key"` // e.g. "custom_objects.pets"
+	Labels                        *CustomObjectLabels     `json:"labels"`
+	Description                   string                  `json:"description,omitempty"`
+	PrimaryDisplayPropertyDetails *PrimaryDisplayProperty `json:"primaryDisplayPropertyDetails"`
+}
+
+// UpdateCustomObjectRequest represents a request to update a custom object schema
+type UpdateCustomObjectRequest struct {
+	LocationID           string              `json:"locationId"`
+	Labels               *CustomObjectLabels `json:"labels,omitempty"`
+	Description          string              `json:"description,omitempty"`
+	SearchableProperties []string            `json:"searchableProperties,omitempty"`
+}
+
+// ObjectFieldRequest represents a request to create or update a field of a custom object
+type ObjectFieldRequest struct {
+	LocationID        string              `json:"locationId"`
+	Name              string              `json:"name,omitempty"`
+	Description       string              `json:"description,omitempty"`
+	Placeholder       string              `json:"placeholder,omitempty"`
+	ShowInForms       bool                `json:"showInForms"`
+	Options           []ObjectFieldOption `json:"options,omitempty"`
+	AcceptedFormats   string              `json:"acceptedFormats,omitempty"`
+	MaxFileLimit      int                 `json:"maxFileLimit,omitempty"`
+	AllowCustomOption bool                `json:"allowCustomOption,omitempty"`
+
+	// The following are only used when creating a field
+	DataType  string `json:"dataType,omitempty"`  // e.g. "TEXT", "LARGE_TEXT", "NUMERICAL", "PHONE", "MONETORY", "CHECKBOX", "SINGLE_OPTIONS", "MULTIPLE_OPTIONS", "DATE", "FILE_UPLOAD"
+	FieldKey  string `json:"fieldKey,omitempty"`  // e.g. "custom_objects.pets.breed"
+	ObjectKey string `json:"objectKey,omitempty"` // e.g. "custom_objects.pets"
+	ParentID  string `json:"parentId,omitempty"`  // Folder the field is created in
+}
+
+// CustomObjectResponse represents a single custom object API response
+type CustomObjectResponse struct {
+	Object *CustomObject `json:"object,omitempty"`
+	Fields []ObjectField `json:"fields,omitempty"`
+}
+
+// CustomObjectsResponse represents a list of custom objects API response
+type CustomObjectsResponse struct {
+	Objects []CustomObject `json:"objects,omitempty"`
+}
+
+// ObjectFieldResponse represents a single object field API response
+type ObjectFieldResponse struct {
+	Field *ObjectField `json:"field,omitempty"`
+}
+
+// ObjectFieldsResponse represents a list of object fields API response
+type ObjectFieldsResponse struct {
+	Fields  []ObjectField `json:"fields,omitempty"`
+	Folders []ObjectField `json:"folders,omitempty"`
+}
+
+// List retrieves all objects (custom and standard) available in a location
+// Required scope: objects/schema.readonly
+func (s *CustomObjectsService) List(locationID string) ([]CustomObject, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result CustomObjectsResponse
+	err := s.client.doRequest("GET", "/objects/?"+locationQuery(locationID).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Objects, nil
+}
+
+// Get retrieves an object schema by key, including its fields
+// Required scope: objects/schema.readonly
+func (s *CustomObjectsService) Get(locationID, key string) (*CustomObjectResponse, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if key == "" {
+		return nil, fmt.Errorf("key is required")
+	}
+
+	query := locationQuery(locationID)
+	query.Set("fetchProperties", "true")
+
+	var result CustomObjectResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/objects/%s?%s", key, query.Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates a new custom object schema
+// Required scope: objects/schema.write
+func (s *CustomObjectsService) Create(req *CreateCustomObjectRequest) (*CustomObject, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Key == "" {
+		return nil, fmt.Errorf("key is required")
+	}
+	if req.Labels == nil {
+		return nil, fmt.Errorf("labels are required")
+	}
+	if req.PrimaryDisplayPropertyDetails == nil {
+		return nil, fmt.Errorf("primaryDisplayPropertyDetails is required")
+	}
+
+	var result CustomObjectResponse
+	err := s.client.doRequest("POST", "/objects/", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Object, nil
+}
+
+// Update updates a custom object schema
+// Required scope: objects/schema.write
+func (s *CustomObjectsService) Update(key string, req *UpdateCustomObjectRequest) (*CustomObject, error) {
+	if key == "" {
+		return nil, fmt.Errorf("key is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result CustomObjectResponse
+	err := s.client.doRequest("PUT", fmt.Sprintf("/objects/%s", key), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Object, nil
+}
+
+// ListFields retrieves the fields and field folders of an object
+// Required scope: locations/customFields.readonly
+func (s *CustomObjectsService) ListFields(locationID, objectKey string) (*ObjectFieldsResponse, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if objectKey == "" {
+		return nil, fmt.Errorf("objectKey is required")
+	}
+
+	var result ObjectFieldsResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/custom-fields/object-key/%s?%s", objectKey, locationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateField adds a field to an object
+// Required scope: locations/customFields.write
+func (s *CustomObjectsService) CreateField(req *ObjectFieldRequest) (*ObjectField, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.ObjectKey == "" {
+		return nil, fmt.Errorf("objectKey is required")
+	}
+	if req.FieldKey == "" {
+		return nil, fmt.Errorf("fieldKey is required")
+	}
+	if req.DataType == "" {
+		return nil, fmt.Errorf("dataType is required")
+	}
+
+	var result ObjectFieldResponse
+	err := s.client.doRequest("POST", "/custom-fields/", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Field, nil
+}
+
+// UpdateField updates a field of an object
+// Required scope: locations/customFields.write
+func (s *CustomObjectsService) UpdateField(fieldID string, req *ObjectFieldRequest) (*ObjectField, error) {
+	if fieldID == "" {
+		return nil, fmt.Errorf("fieldId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result ObjectFieldResponse
+	err := s.client.doRequest("PUT", fmt.Sprintf("/custom-fields/%s", fieldID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Field, nil
+}
+
+// DeleteField deletes a field of an object
+// Required scope: locations/customFields.write
+func (s *CustomObjectsService) DeleteField(fieldID string) error {
+	if fieldID == "" {
+		return fmt.Errorf("fieldId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/custom-fields/%s", fieldID), nil, nil)
+}
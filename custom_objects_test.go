@@ -0,0 +1,173 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCustomObjectsService_Schemas(t *testing.T) {
+	var created CreateCustomObjectRequest
+	var updated UpdateCustomObjectRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /objects/{$}": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("locationId") != "loc-1" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"objects":[{"key":"contact","standard":true},{"key":"custom_objects.pets"}]}`))
+		},
+		"GET /objects/custom_objects.pets": func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("locationId") != "loc-1" || q.Get("fetchProperties") != "true" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"object":{"key":"custom_objects.pets"},"fields":[{"fieldKey":"custom_objects.pets.name"}]}`))
+		},
+		"POST /objects/{$}": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"object":{"id":"obj-1","key":"custom_objects.pets"}}`))
+		},
+		"PUT /objects/custom_objects.pets": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"object":{"id":"obj-1","description":"Pets"}}`))
+		},
+	})
+
+	objects, err := client.CustomObjects.List("loc-1")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(objects) != 2 || !objects[0].StandardObject || objects[1].Key != "custom_objects.pets" {
+		t.Errorf("Unexpected objects: %+v", objects)
+	}
+
+	schema, err := client.CustomObjects.Get("loc-1", "custom_objects.pets")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if schema.Object == nil || len(schema.Fields) != 1 {
+		t.Errorf("Unexpected schema: %+v", schema)
+	}
+
+	object, err := client.CustomObjects.Create(&CreateCustomObjectRequest{
+		LocationID:                    "loc-1",
+		Key:                           "custom_objects.pets",
+		Labels:                        &CustomObjectLabels{Singular: "Pet", Plural: "Pets"},
+		PrimaryDisplayPropertyDetails: &PrimaryDisplayProperty{Key: "custom_objects.pets.name", Name: "Name", DataType: "TEXT"},
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if object.ID != "obj-1" || created.LocationID != "loc-1" || created.Labels.Plural != "Pets" {
+		t.Errorf("Unexpected create: %+v, %+v", object, created)
+	}
+
+	object, err = client.CustomObjects.Update("custom_objects.pets", &UpdateCustomObjectRequest{LocationID: "loc-1", Description: "Pets"})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if object.Description != "Pets" || updated.LocationID != "loc-1" {
+		t.Errorf("Unexpected update: %+v, %+v", object, updated)
+	}
+
+	labels := &CustomObjectLabels{Singular: "Pet", Plural: "Pets"}
+	display := &PrimaryDisplayProperty{Key: "custom_objects.pets.name", Name: "Name", DataType: "TEXT"}
+	invalid := map[string]*CreateCustomObjectRequest{
+		"key":     {LocationID: "loc-1", Labels: labels, PrimaryDisplayPropertyDetails: display},
+		"labels":  {LocationID: "loc-1", Key: "custom_objects.pets", PrimaryDisplayPropertyDetails: display},
+		"display": {LocationID: "loc-1", Key: "custom_objects.pets", Labels: labels},
+	}
+	for field, req := range invalid {
+		if _, err := client.CustomObjects.Create(req); err == nil {
+			t.Errorf("Expected error for missing %s", field)
+		}
+	}
+	if _, err := client.CustomObjects.Get("loc-1", ""); err == nil {
+		t.Error("Expected error for missing key")
+	}
+	if _, err := client.CustomObjects.Update("", &UpdateCustomObjectRequest{}); err == nil {
+		t.Error("Expected error for missing key")
+	}
+
+	if _, err := client.CustomObjects.List(""); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
+
+func TestCustomObjectsService_Fields(t *testing.T) {
+	var created, updated ObjectFieldRequest
+	var deleted bool
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /custom-fields/object-key/custom_objects.pets": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("locationId") != "loc-1" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"fields":[{"id":"field-1","dataType":"TEXT"}],"folders":[{"id":"folder-1"}]}`))
+		},
+		"POST /custom-fields/{$}": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"field":{"id":"field-2","fieldKey":"custom_objects.pets.breed"}}`))
+		},
+		"PUT /custom-fields/field-2": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"field":{"id":"field-2","name":"Breed"}}`))
+		},
+		"DELETE /custom-fields/field-2": func(w http.ResponseWriter, r *http.Request) {
+			deleted = true
+			_, _ = w.Write([]byte(`{"succeded":true}`))
+		},
+	})
+
+	fields, err := client.CustomObjects.ListFields("loc-1", "custom_objects.pets")
+	if err != nil {
+		t.Fatalf("ListFields failed: %v", err)
+	}
+	if len(fields.Fields) != 1 || len(fields.Folders) != 1 {
+		t.Errorf("Unexpected fields: %+v", fields)
+	}
+
+	field, err := client.CustomObjects.CreateField(&ObjectFieldRequest{
+		LocationID: "loc-1",
+		Name:       "Breed",
+		ObjectKey:  "custom_objects.pets",
+		FieldKey:   "custom_objects.pets.breed",
+		DataType:   "TEXT",
+	})
+	if err != nil {
+		t.Fatalf("CreateField failed: %v", err)
+	}
+	if field.ID != "field-2" || created.LocationID != "loc-1" || created.DataType != "TEXT" {
+		t.Errorf("Unexpected create: %+v, %+v", field, created)
+	}
+
+	field, err = client.CustomObjects.UpdateField("field-2", &ObjectFieldRequest{LocationID: "loc-1", Name: "Breed", ShowInForms: true})
+	if err != nil {
+		t.Fatalf("UpdateField failed: %v", err)
+	}
+	if field.Name != "Breed" || updated.LocationID != "loc-1" || !updated.ShowInForms {
+		t.Errorf("Unexpected update: %+v, %+v", field, updated)
+	}
+
+	if err := client.CustomObjects.DeleteField("field-2"); err != nil || !deleted {
+		t.Errorf("DeleteField failed: %v", err)
+	}
+
+	invalid := map[string]*ObjectFieldRequest{
+		"objectKey": {LocationID: "loc-1", FieldKey: "custom_objects.pets.breed", DataType: "TEXT"},
+		"fieldKey":  {LocationID: "loc-1", ObjectKey: "custom_objects.pets", DataType: "TEXT"},
+		"dataType":  {LocationID: "loc-1", ObjectKey: "custom_objects.pets", FieldKey: "custom_objects.pets.breed"},
+	}
+	for field, req := range invalid {
+		if _, err := client.CustomObjects.CreateField(req); err == nil {
+			t.Errorf("Expected error for missing %s", field)
+		}
+	}
+	if _, err := client.CustomObjects.ListFields("loc-1", ""); err == nil {
+		t.Error("Expected error for missing objectKey")
+	}
+	if _, err := client.CustomObjects.UpdateField("", &ObjectFieldRequest{}); err == nil {
+		t.Error("Expected error for missing fieldId")
+	}
+	if err := client.CustomObjects.DeleteField(""); err == nil {
+		t.Error("Expected error for missing fieldId")
+	}
+}
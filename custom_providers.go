@@ -0,0 +1,163 @@
+package gohighlevel
+
+import "fmt"
+
+// CustomProvidersService handles operations related to custom payment provider integrations
+type CustomProvidersService struct {
+	client *Client
+}
+
+// CustomProvider represents a custom payment provider integration for a location
+type CustomProvider struct {
+	ID               string                `json:"_id,omitempty"`
+	Name             string                `json:"name,omitempty"`
+	Description      string                `json:"description,omitempty"`
+	PaymentsURL      string                `json:"paymentsUrl,omitempty"`
+	QueryURL         string                `json:"queryUrl,omitempty"`
+	ImageURL         string                `json:"imageUrl,omitempty"`
+	LocationID       string                `json:"locationId,omitempty"`
+	MarketplaceAppID string                `json:"marketplaceAppId,omitempty"`
+	PaymentProvider  *CustomProviderConfig `json:"paymentProvider,omitempty"`
+	Deleted          bool                  `json:"deleted,omitempty"`
+	CreatedAt        Time                  `json:"createdAt,omitempty"`
+	UpdatedAt        Time                  `json:"updatedAt,omitempty"`
+	TraceID          string                `json:"traceId,omitempty"`
+}
+
+// CustomProviderConfig holds the live and test mode configuration of a custom provider
+type CustomProviderConfig struct {
+	Live *CustomProviderKeys `json:"live,omitempty"`
+	Test *CustomProviderKeys `json:"test,omitempty"`
+}
+
+// CustomProviderKeys holds the keys used by GoHighLevel to talk to a custom provider in one mode
+type CustomProviderKeys struct {
+	APIKey         string `json:"apiKey,omitempty"`
+	PublishableKey string `json:"publishableKey,omitempty"`
+	LiveMode       bool   `json:"liveMode,omitempty"`
+}
+
+// CreateCustomProviderRequest represents a request to register a custom provider for a location
+type CreateCustomProviderRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	PaymentsURL string `json:"paymentsUrl"`
+	QueryURL    string `json:"queryUrl"`
+	ImageURL    string `json:"imageUrl"`
+}
+
+// ConnectCustomProviderRequest represents a request to connect live and/or test keys for a location
+type ConnectCustomProviderRequest struct {
+	Live *CustomProviderKeys `json:"live,omitempty"`
+	Test *CustomProviderKeys `json:"test,omitempty"`
+}
+
+// disconnectCustomProviderRequest represents a request to disconnect one mode of a custom provider
+type disconnectCustomProviderRequest struct {
+	LiveMode bool `json:"liveMode"`
+}
+
+// Create registers the app as a custom payment provider for a location
+// Required scope: payments/custom-provider.write
+func (s *CustomProvidersService) Create(locationID string, req *CreateCustomProviderRequest) (*CustomProvider, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	var result CustomProvider
+	err := s.client.doRequest("POST", customProviderPath("provider", locationID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete removes the custom payment provider integration from a location
+// Required scope: payments/custom-provider.write
+func (s *CustomProvidersService) Delete(locationID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+
+	return s.client.doRequest("DELETE", customProviderPath("provider", locationID), nil, nil)
+}
+
+// GetConfig retrieves the custom provider configuration connected to a location
+// Required scope: payments/custom-provider.readonly
+func (s *CustomProvidersService) GetConfig(locationID string) (*CustomProvider, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result CustomProvider
+	err := s.client.doRequest("GET", customProviderPath("connect", locationID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Connect connects live and/or test mode keys for a location
+// Required scope: payments/custom-provider.write
+func (s *CustomProvidersService) Connect(locationID string, req *ConnectCustomProviderRequest) (*CustomProvider, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Live == nil && req.Test == nil {
+		return nil, fmt.Errorf("live or test configuration is required")
+	}
+
+	var result CustomProvider
+	err := s.client.doRequest("POST", customProviderPath("connect", locationID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Disconnect disconnects the live or test mode configuration for a location
+// Required scope: payments/custom-provider.write
+func (s *CustomProvidersService) Disconnect(locationID string, liveMode bool) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+
+	req := &disconnectCustomProviderRequest{LiveMode: liveMode}
+	return s.client.doRequest("POST", customProviderPath("disconnect", locationID), req, nil)
+}
+
+// VerifyConnection reports whether the location has a configuration connected for the given mode
+// Required scope: payments/custom-provider.readonly
+func (s *CustomProvidersService) VerifyConnection(locationID string, liveMode bool) (bool, error) {
+	provider, err := s.GetConfig(locationID)
+	if err != nil {
+		return false, err
+	}
+
+	if provider.Deleted || provider.PaymentProvider == nil {
+		return false, nil
+	}
+
+	keys := provider.PaymentProvider.Test
+	if liveMode {
+		keys = provider.PaymentProvider.Live
+	}
+
+	return keys != nil, nil
+}
+
+// customProviderPath builds a custom provider endpoint path scoped to a location
+func customProviderPath(endpoint, locationID string) string {
+	return fmt.Sprintf("/payments/custom-provider/%s?%s", endpoint, locationQuery(locationID).Encode())
+}
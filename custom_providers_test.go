@@ -0,0 +1,77 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCustomProvidersService(t *testing.T) {
+	var calls []string
+	var disconnect disconnectCustomProviderRequest
+	record := func(r *http.Request) {
+		if r.URL.Query().Get("locationId") != "loc-1" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /payments/custom-provider/provider": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_, _ = w.Write([]byte(`{"_id":"prov-1","name":"Acme Pay"}`))
+		},
+		"DELETE /payments/custom-provider/provider": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_, _ = w.Write([]byte(`{}`))
+		},
+		"GET /payments/custom-provider/connect": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_, _ = w.Write([]byte(`{"_id":"prov-1","paymentProvider":{"test":{"apiKey":"sk_test","liveMode":false}}}`))
+		},
+		"POST /payments/custom-provider/connect": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_, _ = w.Write([]byte(`{"_id":"prov-1"}`))
+		},
+		"POST /payments/custom-provider/disconnect": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewDecoder(r.Body).Decode(&disconnect)
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	provider, err := client.CustomProviders.Create("loc-1", &CreateCustomProviderRequest{Name: "Acme Pay", PaymentsURL: "https://pay.example.com"})
+	if err != nil || provider.ID != "prov-1" {
+		t.Errorf("Create = %+v, %v", provider, err)
+	}
+	if _, err := client.CustomProviders.Create("loc-1", &CreateCustomProviderRequest{}); err == nil {
+		t.Error("Expected error for missing name")
+	}
+
+	if _, err := client.CustomProviders.Connect("loc-1", &ConnectCustomProviderRequest{Test: &CustomProviderKeys{APIKey: "sk_test"}}); err != nil {
+		t.Errorf("Connect failed: %v", err)
+	}
+	if _, err := client.CustomProviders.Connect("loc-1", &ConnectCustomProviderRequest{}); err == nil {
+		t.Error("Expected error for missing live and test configuration")
+	}
+
+	if ok, err := client.CustomProviders.VerifyConnection("loc-1", false); err != nil || !ok {
+		t.Errorf("VerifyConnection(test) = %v, %v", ok, err)
+	}
+	if ok, err := client.CustomProviders.VerifyConnection("loc-1", true); err != nil || ok {
+		t.Errorf("VerifyConnection(live) = %v, %v", ok, err)
+	}
+
+	if err := client.CustomProviders.Disconnect("loc-1", true); err != nil || !disconnect.LiveMode {
+		t.Errorf("Disconnect failed: %v, %+v", err, disconnect)
+	}
+	if err := client.CustomProviders.Delete("loc-1"); err != nil {
+		t.Errorf("Delete failed: %v", err)
+	}
+
+	if err := client.CustomProviders.Delete(""); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+	if len(calls) != 6 {
+		t.Errorf("Unexpected calls: %v", calls)
+	}
+}
@@ -0,0 +1,239 @@
+// Package dedupe finds duplicate contacts in a GoHighLevel location and merges them.
+//
+// Deduplication runs in three steps that can be inspected in between:
+//
+//	contacts, err := dedupe.Scan(ctx, client.Contacts, locationID)
+//	groups := dedupe.FindDuplicates(contacts, dedupe.MatchEmail, dedupe.MatchPhone)
+//	plans := dedupe.PlanMerges(groups, dedupe.OldestWins)
+//	report := dedupe.Execute(ctx, client.Contacts, plans, dedupe.ExecuteOptions{DryRun: true})
+package dedupe
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+)
+
+// ContactsAPI is the part of the contacts API used by this package. *ghl.ContactsService
+// implements it.
+type ContactsAPI interface {
+	List(opts *ghl.GetContactsOptions) (*ghl.ContactsResponse, error)
+	Patch(contactID string, patch *ghl.ContactPatch) (*ghl.Contact, error)
+	AddTags(contactID string, tags []string) error
+	Delete(contactID string) error
+}
+
+// scanPageSize is the page size used when scanning a location
+const scanPageSize = 100
+
+// Scan retrieves every contact in a location, page by page
+func Scan(ctx context.Context, api ContactsAPI, locationID string) ([]ghl.Contact, error) {
+	var contacts []ghl.Contact
+	opts := &ghl.GetContactsOptions{LocationID: locationID, Limit: scanPageSize}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return contacts, err
+		}
+		page, err := api.List(opts)
+		if err != nil {
+			return contacts, err
+		}
+		contacts = append(contacts, page.Contacts...)
+		if len(page.Contacts) < scanPageSize {
+			return contacts, nil
+		}
+
+		last := page.Contacts[len(page.Contacts)-1]
+		opts.StartAfterID = last.ID
+		opts.StartAfter = strconv.FormatInt(last.DateAdded.UnixMilli(), 10)
+	}
+}
+
+// Matcher derives the keys under which a contact is considered a duplicate of others.
+// Contacts sharing any key, directly or through other contacts, form one duplicate group.
+type Matcher struct {
+	Name string
+	Keys func(c ghl.Contact) []string
+}
+
+// MatchEmail matches contacts with the same email address, ignoring case
+var MatchEmail = Matcher{Name: "email", Keys: func(c ghl.Contact) []string {
+	var keys []string
+	for _, email := range append([]string{c.Email}, c.AdditionalEmails...) {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			keys = append(keys, email)
+		}
+	}
+	return keys
+}}
+
+// MatchPhone matches contacts with the same phone number, ignoring formatting
+var MatchPhone = Matcher{Name: "phone", Keys: func(c ghl.Contact) []string {
+	var keys []string
+	for _, phone := range append([]string{c.Phone}, c.AdditionalPhones...) {
+		if normalized, err := ghl.NormalizePhoneE164(phone); err == nil {
+			keys = append(keys, normalized)
+		} else if digits := digitsOnly(phone); len(digits) >= 7 {
+			keys = append(keys, digits)
+		}
+	}
+	return keys
+}}
+
+// MatchName matches contacts with the same first and last name in the same postal code or
+// company. The name alone is too weak a signal to merge on.
+var MatchName = Matcher{Name: "name", Keys: func(c ghl.Contact) []string {
+	first, last := normalizeName(c.FirstName), normalizeName(c.LastName)
+	if first == "" || last == "" {
+		return nil
+	}
+	var keys []string
+	if postal := strings.ToLower(strings.ReplaceAll(c.PostalCode, " ", "")); postal != "" {
+		keys = append(keys, first+" "+last+"|postal:"+postal)
+	}
+	if company := normalizeName(c.CompanyName); company != "" {
+		keys = append(keys, first+" "+last+"|company:"+company)
+	}
+	return keys
+}}
+
+// Group is a set of contacts considered duplicates of each other
+type Group struct {
+	Contacts []ghl.Contact
+	// MatchedOn lists the matchers that linked the contacts, e.g. ["email", "phone"]
+	MatchedOn []string
+}
+
+// FindDuplicates groups contacts that share a key under any of the matchers (MatchEmail and
+// MatchPhone when none are given). Only groups of two or more contacts are returned, ordered
+// by the ID of their first contact.
+func FindDuplicates(contacts []ghl.Contact, matchers ...Matcher) []Group {
+	if len(matchers) == 0 {
+		matchers = []Matcher{MatchEmail, MatchPhone}
+	}
+
+	uf := newUnionFind(len(contacts))
+	firstWithKey := make(map[string]int)
+	matchedOn := make(map[[2]int]string)
+
+	for _, m := range matchers {
+		for i, c := range contacts {
+			for _, key := range m.Keys(c) {
+				key = m.Name + ":" + key
+				j, seen := firstWithKey[key]
+				if !seen {
+					firstWithKey[key] = i
+					continue
+				}
+				if uf.union(i, j) {
+					matchedOn[[2]int{i, j}] = m.Name
+				}
+			}
+		}
+	}
+
+	members := make(map[int][]int)
+	for i := range contacts {
+		root := uf.find(i)
+		members[root] = append(members[root], i)
+	}
+	reasons := make(map[int]map[string]bool)
+	for pair, name := range matchedOn {
+		root := uf.find(pair[0])
+		if reasons[root] == nil {
+			reasons[root] = make(map[string]bool)
+		}
+		reasons[root][name] = true
+	}
+
+	var groups []Group
+	for root, idx := range members {
+		if len(idx) < 2 {
+			continue
+		}
+		group := Group{}
+		for _, i := range idx {
+			group.Contacts = append(group.Contacts, contacts[i])
+		}
+		for _, m := range matchers {
+			if reasons[root][m.Name] {
+				group.MatchedOn = append(group.MatchedOn, m.Name)
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(a, b int) bool {
+		return groups[a].Contacts[0].ID < groups[b].Contacts[0].ID
+	})
+	return groups
+}
+
+// unionFind is a disjoint-set forest over contact indexes
+type unionFind struct {
+	parent []int
+}
+
+func newUnionFind(n int) *unionFind {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &unionFind{parent: parent}
+}
+
+func (u *unionFind) find(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+// union joins the sets of i and j and reports whether they were separate
+func (u *unionFind) union(i, j int) bool {
+	ri, rj := u.find(i), u.find(j)
+	if ri == rj {
+		return false
+	}
+	if ri < rj {
+		u.parent[rj] = ri
+	} else {
+		u.parent[ri] = rj
+	}
+	return true
+}
+
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// normalizeName lowercases a name and drops everything but letters, digits and single spaces
+func normalizeName(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			space = true
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,212 @@
+package dedupe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+)
+
+type fakeAPI struct {
+	contacts []ghl.Contact
+	patches  map[string][]map[string]interface{}
+	tagged   map[string][]string
+	deleted  []string
+	failOn   string
+}
+
+func (f *fakeAPI) List(opts *ghl.GetContactsOptions) (*ghl.ContactsResponse, error) {
+	start := 0
+	if opts.StartAfterID != "" {
+		for i, c := range f.contacts {
+			if c.ID == opts.StartAfterID {
+				start = i + 1
+			}
+		}
+	}
+	end := start + opts.Limit
+	if end > len(f.contacts) {
+		end = len(f.contacts)
+	}
+	return &ghl.ContactsResponse{Contacts: f.contacts[start:end]}, nil
+}
+
+func (f *fakeAPI) Patch(contactID string, patch *ghl.ContactPatch) (*ghl.Contact, error) {
+	if contactID == f.failOn {
+		return nil, errors.New("boom")
+	}
+	data, _ := json.Marshal(patch)
+	var body map[string]interface{}
+	_ = json.Unmarshal(data, &body)
+	if f.patches == nil {
+		f.patches = make(map[string][]map[string]interface{})
+	}
+	f.patches[contactID] = append(f.patches[contactID], body)
+	return &ghl.Contact{ID: contactID}, nil
+}
+
+func (f *fakeAPI) AddTags(contactID string, tags []string) error {
+	if f.tagged == nil {
+		f.tagged = make(map[string][]string)
+	}
+	f.tagged[contactID] = append(f.tagged[contactID], tags...)
+	return nil
+}
+
+func (f *fakeAPI) Delete(contactID string) error {
+	f.deleted = append(f.deleted, contactID)
+	return nil
+}
+
+func day(n int) ghl.Time {
+	return ghl.Time{Time: time.Date(2024, 1, n, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestScan(t *testing.T) {
+	api := &fakeAPI{}
+	for i := 0; i < 250; i++ {
+		api.contacts = append(api.contacts, ghl.Contact{ID: fmt.Sprintf("c%03d", i)})
+	}
+
+	contacts, err := Scan(context.Background(), api, "loc")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if len(contacts) != 250 || contacts[249].ID != "c249" {
+		t.Errorf("scanned %d contacts", len(contacts))
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	contacts := []ghl.Contact{
+		{ID: "a", Email: "Ada@Example.com"},
+		{ID: "b", Email: "ada@example.com ", Phone: "+1 555 010 9999"},
+		{ID: "c", Phone: "+15550109999"},
+		{ID: "d", Email: "grace@example.com"},
+		{ID: "e", FirstName: "Alan", LastName: "Turing", PostalCode: "SW1A 1AA"},
+		{ID: "f", FirstName: "alan", LastName: "TURING", PostalCode: "sw1a1aa"},
+	}
+
+	groups := FindDuplicates(contacts)
+	if len(groups) != 1 {
+		t.Fatalf("got %d groups, want 1: %+v", len(groups), groups)
+	}
+	if ids := contactIDs(groups[0].Contacts); !reflect.DeepEqual(ids, []string{"a", "b", "c"}) {
+		t.Errorf("group = %v, want a, b, c linked through b", ids)
+	}
+	if !reflect.DeepEqual(groups[0].MatchedOn, []string{"email", "phone"}) {
+		t.Errorf("MatchedOn = %v", groups[0].MatchedOn)
+	}
+
+	groups = FindDuplicates(contacts, MatchName)
+	if len(groups) != 1 || !reflect.DeepEqual(contactIDs(groups[0].Contacts), []string{"e", "f"}) {
+		t.Errorf("name groups = %+v", groups)
+	}
+}
+
+func TestPlanAndExecute(t *testing.T) {
+	group := Group{
+		MatchedOn: []string{"email"},
+		Contacts: []ghl.Contact{
+			{ID: "new", Email: "ada@example.com", Phone: "+15550109999", City: "London", Tags: []string{"VIP", "webinar"}, DateAdded: day(5),
+				CustomFields: []ghl.CustomField{{ID: "cf1", Value: "loser value"}, {ID: "cf2", Value: "from loser"}}},
+			{ID: "old", FirstName: "Ada", Tags: []string{"vip"}, DateAdded: day(1),
+				CustomFields: []ghl.CustomField{{ID: "cf1", Value: "winner value"}}},
+		},
+	}
+
+	plans := PlanMerges([]Group{group}, nil)
+	plan := plans[0]
+	if plan.Winner.ID != "old" || len(plan.Losers) != 1 {
+		t.Fatalf("winner = %s, want the oldest contact", plan.Winner.ID)
+	}
+	if !reflect.DeepEqual(plan.AddedTags, []string{"webinar"}) {
+		t.Errorf("AddedTags = %v, want [webinar]", plan.AddedTags)
+	}
+	if got := plan.Patch.Fields(); !reflect.DeepEqual(got, []string{"city", "customField", "tags"}) {
+		t.Errorf("Patch fields = %v", got)
+	}
+	if got := plan.IdentifierPatch.Fields(); !reflect.DeepEqual(got, []string{"email", "phone"}) {
+		t.Errorf("IdentifierPatch fields = %v", got)
+	}
+
+	api := &fakeAPI{}
+	report := Execute(context.Background(), api, plans, ExecuteOptions{DryRun: true, DeleteLosers: true})
+	if len(api.patches) != 0 || len(api.deleted) != 0 || !report.DryRun {
+		t.Fatal("dry run changed contacts")
+	}
+	if len(report.Merges) != 1 || len(report.Merges[0].UpdatedFields) != 5 {
+		t.Errorf("dry run report = %+v", report.Merges)
+	}
+
+	report = Execute(context.Background(), api, plans, ExecuteOptions{DeleteLosers: true})
+	if len(report.Failed()) != 0 {
+		t.Fatalf("merge failed: %+v", report.Failed())
+	}
+	if !reflect.DeepEqual(api.deleted, []string{"new"}) || len(api.patches["old"]) != 2 || len(api.tagged) != 0 {
+		t.Errorf("deleted = %v, patches = %v", api.deleted, api.patches)
+	}
+	customFields := api.patches["old"][0]["customField"].([]interface{})
+	if len(customFields) != 1 || customFields[0].(map[string]interface{})["id"] != "cf2" {
+		t.Errorf("custom fields patch = %v, want only cf2", customFields)
+	}
+}
+
+func TestExecute_WinnerUpdateFailureKeepsLosers(t *testing.T) {
+	plans := PlanMerges([]Group{{Contacts: []ghl.Contact{
+		{ID: "w", DateAdded: day(1)},
+		{ID: "l", City: "Paris", DateAdded: day(2)},
+	}}}, nil)
+
+	api := &fakeAPI{failOn: "w"}
+	report := Execute(context.Background(), api, plans, ExecuteOptions{DeleteLosers: true})
+	if len(report.Failed()) != 1 || len(api.deleted) != 0 {
+		t.Errorf("failed = %+v, deleted = %v", report.Failed(), api.deleted)
+	}
+}
+
+func TestExecute_TagsLosersByDefault(t *testing.T) {
+	plans := PlanMerges([]Group{{Contacts: []ghl.Contact{
+		{ID: "w", DateAdded: day(1)},
+		{ID: "l1", City: "Paris", Email: "ada@example.com", DateAdded: day(2)},
+		{ID: "l2", DateAdded: day(3)},
+	}}}, nil)
+
+	api := &fakeAPI{}
+	report := Execute(context.Background(), api, plans, ExecuteOptions{})
+	if len(report.Failed()) != 0 {
+		t.Fatalf("merge failed: %+v", report.Failed())
+	}
+	if len(api.deleted) != 0 || !reflect.DeepEqual(report.Merges[0].TaggedIDs, []string{"l1", "l2"}) {
+		t.Errorf("deleted = %v, tagged = %v", api.deleted, report.Merges[0].TaggedIDs)
+	}
+	if !reflect.DeepEqual(api.tagged, map[string][]string{"l1": {DefaultDuplicateTag}, "l2": {DefaultDuplicateTag}}) {
+		t.Errorf("tags = %v", api.tagged)
+	}
+	// The email stays on the loser, so it is not copied to the winner
+	if len(api.patches["w"]) != 1 || api.patches["w"][0]["email"] != nil {
+		t.Errorf("winner patches = %v", api.patches["w"])
+	}
+	if !reflect.DeepEqual(report.Merges[0].UpdatedFields, []string{"city"}) {
+		t.Errorf("UpdatedFields = %v", report.Merges[0].UpdatedFields)
+	}
+
+	api = &fakeAPI{}
+	Execute(context.Background(), api, plans, ExecuteOptions{DuplicateTag: "merged-into-w"})
+	if !reflect.DeepEqual(api.tagged["l1"], []string{"merged-into-w"}) {
+		t.Errorf("tags = %v, want the custom tag", api.tagged)
+	}
+}
+
+func contactIDs(contacts []ghl.Contact) []string {
+	ids := make([]string, len(contacts))
+	for i, c := range contacts {
+		ids[i] = c.ID
+	}
+	return ids
+}
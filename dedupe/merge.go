@@ -0,0 +1,307 @@
+package dedupe
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+)
+
+// WinnerPolicy picks the contact of a duplicate group that survives the merge and returns
+// its index
+type WinnerPolicy func(contacts []ghl.Contact) int
+
+// OldestWins keeps the contact that was added first
+func OldestWins(contacts []ghl.Contact) int {
+	winner := 0
+	for i, c := range contacts {
+		if c.DateAdded.Before(contacts[winner].DateAdded.Time) {
+			winner = i
+		}
+	}
+	return winner
+}
+
+// NewestWins keeps the contact that was updated most recently
+func NewestWins(contacts []ghl.Contact) int {
+	winner := 0
+	for i, c := range contacts {
+		if c.DateUpdated.After(contacts[winner].DateUpdated.Time) {
+			winner = i
+		}
+	}
+	return winner
+}
+
+// MostCompleteWins keeps the contact with the most fields filled in, the oldest on a tie
+func MostCompleteWins(contacts []ghl.Contact) int {
+	winner := 0
+	for i, c := range contacts {
+		score, best := completeness(c), completeness(contacts[winner])
+		if score > best || score == best && c.DateAdded.Before(contacts[winner].DateAdded.Time) {
+			winner = i
+		}
+	}
+	return winner
+}
+
+func completeness(c ghl.Contact) int {
+	score := len(c.Tags) + len(c.CustomFields)
+	for _, f := range mergeFields {
+		if f.get(c) != "" {
+			score++
+		}
+	}
+	return score
+}
+
+// mergeField is a contact field filled in on the winner from the losers when it is empty
+type mergeField struct {
+	name       string
+	identifier bool // Email and phone must be unique in some locations
+	get        func(c ghl.Contact) string
+	set        func(p *ghl.ContactPatch, v string) *ghl.ContactPatch
+}
+
+var mergeFields = []mergeField{
+	{"firstName", false, func(c ghl.Contact) string { return c.FirstName }, (*ghl.ContactPatch).SetFirstName},
+	{"lastName", false, func(c ghl.Contact) string { return c.LastName }, (*ghl.ContactPatch).SetLastName},
+	{"email", true, func(c ghl.Contact) string { return c.Email }, (*ghl.ContactPatch).SetEmail},
+	{"phone", true, func(c ghl.Contact) string { return c.Phone }, (*ghl.ContactPatch).SetPhone},
+	{"address1", false, func(c ghl.Contact) string { return c.Address1 }, (*ghl.ContactPatch).SetAddress1},
+	{"city", false, func(c ghl.Contact) string { return c.City }, (*ghl.ContactPatch).SetCity},
+	{"state", false, func(c ghl.Contact) string { return c.State }, (*ghl.ContactPatch).SetState},
+	{"postalCode", false, func(c ghl.Contact) string { return c.PostalCode }, (*ghl.ContactPatch).SetPostalCode},
+	{"country", false, func(c ghl.Contact) string { return c.Country }, (*ghl.ContactPatch).SetCountry},
+	{"companyName", false, func(c ghl.Contact) string { return c.CompanyName }, (*ghl.ContactPatch).SetCompanyName},
+	{"website", false, func(c ghl.Contact) string { return c.Website }, (*ghl.ContactPatch).SetWebsite},
+	{"source", false, func(c ghl.Contact) string { return c.Source }, (*ghl.ContactPatch).SetSource},
+	{"timezone", false, func(c ghl.Contact) string { return c.Timezone }, (*ghl.ContactPatch).SetTimezone},
+}
+
+// MergePlan describes how one duplicate group is merged into its winner
+type MergePlan struct {
+	Winner    ghl.Contact
+	Losers    []ghl.Contact
+	MatchedOn []string
+	// Patch fills empty winner fields from the losers and adds their tags and custom fields.
+	// It is applied before the losers are deleted or tagged.
+	Patch *ghl.ContactPatch
+	// IdentifierPatch fills an empty winner email or phone from the losers. It is applied only
+	// after the losers are deleted, since locations that disallow duplicates reject it before.
+	IdentifierPatch *ghl.ContactPatch
+	// AddedTags are the loser tags the winner does not have yet
+	AddedTags []string
+}
+
+// PlanMerges builds a merge plan for each group, choosing winners with policy (OldestWins if nil).
+// Winner values always take precedence; losers only fill in what the winner lacks, and earlier
+// losers take precedence over later ones.
+func PlanMerges(groups []Group, policy WinnerPolicy) []MergePlan {
+	if policy == nil {
+		policy = OldestWins
+	}
+
+	plans := make([]MergePlan, 0, len(groups))
+	for _, group := range groups {
+		w := policy(group.Contacts)
+		plan := MergePlan{
+			Winner:          group.Contacts[w],
+			MatchedOn:       group.MatchedOn,
+			Patch:           ghl.NewContactPatch(),
+			IdentifierPatch: ghl.NewContactPatch(),
+		}
+		for i, c := range group.Contacts {
+			if i != w {
+				plan.Losers = append(plan.Losers, c)
+			}
+		}
+
+		for _, f := range mergeFields {
+			if f.get(plan.Winner) != "" {
+				continue
+			}
+			for _, loser := range plan.Losers {
+				if v := f.get(loser); v != "" {
+					if f.identifier {
+						f.set(plan.IdentifierPatch, v)
+					} else {
+						f.set(plan.Patch, v)
+					}
+					break
+				}
+			}
+		}
+
+		tags, added := unionTags(plan.Winner.Tags, plan.Losers)
+		if len(added) > 0 {
+			plan.Patch.SetTags(tags)
+			plan.AddedTags = added
+		}
+
+		has := make(map[string]bool)
+		for _, field := range plan.Winner.CustomFields {
+			if field.Value != nil && field.Value != "" {
+				has[field.ID] = true
+			}
+		}
+		for _, loser := range plan.Losers {
+			for _, field := range loser.CustomFields {
+				if field.ID == "" || has[field.ID] || field.Value == nil || field.Value == "" {
+					continue
+				}
+				plan.Patch.SetCustomField(field.ID, field.Value)
+				has[field.ID] = true
+			}
+		}
+
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// unionTags returns the winner's tags followed by the loser tags it lacks, compared
+// case-insensitively, and the added tags
+func unionTags(winnerTags []string, losers []ghl.Contact) (all, added []string) {
+	seen := make(map[string]bool)
+	for _, tag := range winnerTags {
+		seen[strings.ToLower(tag)] = true
+	}
+	all = append(all, winnerTags...)
+	for _, loser := range losers {
+		for _, tag := range loser.Tags {
+			if key := strings.ToLower(tag); !seen[key] {
+				seen[key] = true
+				all = append(all, tag)
+				added = append(added, tag)
+			}
+		}
+	}
+	return all, added
+}
+
+// DefaultDuplicateTag is the tag added to losers when ExecuteOptions.DuplicateTag is empty
+const DefaultDuplicateTag = "duplicate"
+
+// ExecuteOptions configures Execute
+type ExecuteOptions struct {
+	// DryRun reports what would be merged without changing anything
+	DryRun bool
+	// DeleteLosers deletes the losers once the winner is updated. Deleting a contact also
+	// deletes its conversations, opportunities, notes, tasks and appointments, which are not
+	// moved to the winner. When false, the losers are tagged with DuplicateTag instead, to be
+	// reviewed and removed by hand, and the winner's email and phone are left as they are.
+	DeleteLosers bool
+	// DuplicateTag is the tag added to losers that are not deleted, DefaultDuplicateTag if empty
+	DuplicateTag string
+}
+
+// MergeResult is the audit record of one merge
+type MergeResult struct {
+	WinnerID      string        `json:"winnerId"`
+	MatchedOn     []string      `json:"matchedOn"`
+	UpdatedFields []string      `json:"updatedFields,omitempty"`
+	AddedTags     []string      `json:"addedTags,omitempty"`
+	DeletedIDs    []string      `json:"deletedIds,omitempty"`
+	TaggedIDs     []string      `json:"taggedIds,omitempty"`
+	Losers        []ghl.Contact `json:"losers"` // Snapshot of the merged contacts, for recovery
+	Error         string        `json:"error,omitempty"`
+}
+
+// Report is the audit report of a deduplication run. It marshals to JSON for archiving.
+type Report struct {
+	DryRun     bool          `json:"dryRun"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt time.Time     `json:"finishedAt"`
+	Merges     []MergeResult `json:"merges"`
+}
+
+// Failed returns the merges that did not complete
+func (r *Report) Failed() []MergeResult {
+	var failed []MergeResult
+	for _, m := range r.Merges {
+		if m.Error != "" {
+			failed = append(failed, m)
+		}
+	}
+	return failed
+}
+
+// Execute applies merge plans. For each plan it patches the winner and tags the losers, or with
+// DeleteLosers deletes them and then fills the winner's email and phone. A merge stops at its
+// first error, which is recorded in the report; losers are never tagged or deleted if the winner
+// could not be updated. Execute stops starting new merges when ctx is cancelled.
+func Execute(ctx context.Context, api ContactsAPI, plans []MergePlan, opts ExecuteOptions) *Report {
+	report := &Report{DryRun: opts.DryRun, StartedAt: time.Now()}
+	if opts.DuplicateTag == "" {
+		opts.DuplicateTag = DefaultDuplicateTag
+	}
+
+	for _, plan := range plans {
+		result := MergeResult{
+			WinnerID:      plan.Winner.ID,
+			MatchedOn:     plan.MatchedOn,
+			UpdatedFields: plan.Patch.Fields(),
+			AddedTags:     plan.AddedTags,
+			Losers:        plan.Losers,
+		}
+		if opts.DeleteLosers {
+			result.UpdatedFields = append(result.UpdatedFields, plan.IdentifierPatch.Fields()...)
+		}
+		if err := ctx.Err(); err != nil {
+			result.Error = err.Error()
+		} else if !opts.DryRun && opts.DeleteLosers {
+			result.DeletedIDs, result.Error = executePlan(api, plan)
+		} else if !opts.DryRun {
+			result.TaggedIDs, result.Error = tagLosers(api, plan, opts.DuplicateTag)
+		}
+		report.Merges = append(report.Merges, result)
+	}
+
+	report.FinishedAt = time.Now()
+	return report
+}
+
+// executePlan merges one group and returns the IDs of the deleted losers and the error, if any
+func executePlan(api ContactsAPI, plan MergePlan) ([]string, string) {
+	if !plan.Patch.IsEmpty() {
+		if _, err := api.Patch(plan.Winner.ID, plan.Patch); err != nil {
+			return nil, "update winner: " + err.Error()
+		}
+	}
+
+	var deleted []string
+	for _, loser := range plan.Losers {
+		if err := api.Delete(loser.ID); err != nil {
+			return deleted, "delete " + loser.ID + ": " + err.Error()
+		}
+		deleted = append(deleted, loser.ID)
+	}
+
+	if !plan.IdentifierPatch.IsEmpty() {
+		if _, err := api.Patch(plan.Winner.ID, plan.IdentifierPatch); err != nil {
+			return deleted, "update winner email/phone: " + err.Error()
+		}
+	}
+	return deleted, ""
+}
+
+// tagLosers updates the winner and tags the losers with tag, returning the IDs of the tagged
+// losers and the error, if any
+func tagLosers(api ContactsAPI, plan MergePlan, tag string) ([]string, string) {
+	if !plan.Patch.IsEmpty() {
+		if _, err := api.Patch(plan.Winner.ID, plan.Patch); err != nil {
+			return nil, "update winner: " + err.Error()
+		}
+	}
+
+	var tagged []string
+	for _, loser := range plan.Losers {
+		if err := api.AddTags(loser.ID, []string{tag}); err != nil {
+			return tagged, "tag " + loser.ID + ": " + err.Error()
+		}
+		tagged = append(tagged, loser.ID)
+	}
+	return tagged, ""
+}
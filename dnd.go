@@ -0,0 +1,66 @@
+package gohighlevel
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDNDActive is matched by the error returned when a message is blocked because the contact
+// has do not disturb enabled
+var ErrDNDActive = errors.New("contact has do not disturb enabled")
+
+// DNDError is returned when a contact has do not disturb enabled for the channel of a message.
+// It matches ErrDNDActive with errors.Is.
+type DNDError struct {
+	ContactID string
+	Channel   string // Message type, e.g. "SMS"
+	Global    bool   // The contact has DND enabled for every channel
+	Status    string // Status of the channel setting, e.g. "permanent"; empty when Global
+}
+
+func (e *DNDError) Error() string {
+	if e.Global {
+		return fmt.Sprintf("contact %s has do not disturb enabled for all channels", e.ContactID)
+	}
+	return fmt.Sprintf("contact %s has do not disturb %s for %s", e.ContactID, e.Status, e.Channel)
+}
+
+func (e *DNDError) Unwrap() error {
+	return ErrDNDActive
+}
+
+// CheckDND returns a *DNDError if the contact must not be sent a message of the given type,
+// either because DND is enabled globally or because the channel setting is active or permanent.
+// Inbound DND settings only block messages from the contact, so they are not checked.
+func (c *Contact) CheckDND(messageType string) error {
+	if c.DND {
+		return &DNDError{ContactID: c.ID, Channel: messageType, Global: true}
+	}
+	if setting := c.DNDSettings.forMessageType(messageType); setting != nil {
+		if setting.Status == DNDStatusActive || setting.Status == DNDStatusPermanent {
+			return &DNDError{ContactID: c.ID, Channel: messageType, Status: setting.Status}
+		}
+	}
+	return nil
+}
+
+// forMessageType returns the setting for the channel a message type is sent over, or nil if
+// the channel has no setting of its own
+func (s *DNDSettings) forMessageType(messageType string) *DNDSetting {
+	if s == nil {
+		return nil
+	}
+	switch messageType {
+	case MessageTypeSMS:
+		return s.SMS
+	case MessageTypeEmail:
+		return s.Email
+	case MessageTypeWhatsApp:
+		return s.WhatsApp
+	case MessageTypeGMB:
+		return s.GMB
+	case MessageTypeFB:
+		return s.FB
+	}
+	return nil
+}
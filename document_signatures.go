@@ -0,0 +1,135 @@
+package gohighlevel
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Signature event types
+const (
+	SignatureEventStatusChanged = "status_changed" // The document moved to a new status
+	SignatureEventSigned        = "signed"         // A recipient completed signing
+	SignatureEventCompleted     = "completed"      // Every recipient has signed
+)
+
+// SignatureEvent is a change in the signature state of a document, reported by WatchSignatures
+type SignatureEvent struct {
+	Type           string
+	Document       Document
+	Recipient      *DocumentRecipient // Set for SignatureEventSigned
+	PreviousStatus string             // Set for SignatureEventStatusChanged
+	ObservedAt     time.Time          // When the change was seen, not when it happened
+}
+
+// WatchSignaturesOptions configures WatchSignatures
+type WatchSignaturesOptions struct {
+	LocationID string
+	Interval   time.Duration // Time between polls (default 1m)
+}
+
+// WatchSignatures polls the documents of a location and calls fn for each signature status
+// change until ctx is done, then returns ctx.Err(). The first poll records the current state
+// without reporting events. Polling errors are returned; rate limits and transient failures are
+// already retried by the client.
+// Required scope: documents_contracts/list.readonly
+func (s *DocumentsService) WatchSignatures(ctx context.Context, opts *WatchSignaturesOptions, fn func(SignatureEvent)) error {
+	o := WatchSignaturesOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = time.Minute
+	}
+
+	known, err := s.listAllDocuments(ctx, o.LocationID)
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(o.Interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		current, err := s.listAllDocuments(ctx, o.LocationID)
+		if err != nil {
+			return err
+		}
+		for _, event := range diffSignatures(known, current, time.Now()) {
+			fn(event)
+		}
+		known = current
+		timer.Reset(o.Interval)
+	}
+}
+
+// listAllDocuments retrieves every document of a location, keyed by ID
+func (s *DocumentsService) listAllDocuments(ctx context.Context, locationID string) (map[string]Document, error) {
+	const pageSize = 100
+	documents := map[string]Document{}
+	for skip := 0; ; skip += pageSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := s.List(&ListDocumentsOptions{LocationID: locationID, Limit: pageSize, Skip: skip})
+		if err != nil {
+			return nil, err
+		}
+		for _, document := range result.Documents {
+			documents[document.ID] = document
+		}
+		if len(result.Documents) < pageSize {
+			return documents, nil
+		}
+	}
+}
+
+// diffSignatures returns the events that turn the previous state of documents into the current
+// one, in document ID order. Documents seen for the first time report their status if it is
+// past draft.
+func diffSignatures(previous, current map[string]Document, now time.Time) []SignatureEvent {
+	var events []SignatureEvent
+	for _, id := range slices.Sorted(maps.Keys(current)) {
+		document := current[id]
+		before, seen := previous[id]
+		if !seen {
+			before = Document{Status: DocumentStatusDraft}
+		}
+
+		if document.Status != before.Status {
+			events = append(events, SignatureEvent{
+				Type:           SignatureEventStatusChanged,
+				Document:       document,
+				PreviousStatus: before.Status,
+				ObservedAt:     now,
+			})
+		}
+
+		signed := map[string]bool{}
+		for _, recipient := range before.Recipients {
+			signed[recipient.ID] = recipient.HasCompleted
+		}
+		for i := range document.Recipients {
+			recipient := document.Recipients[i]
+			if recipient.HasCompleted && !signed[recipient.ID] {
+				events = append(events, SignatureEvent{
+					Type:       SignatureEventSigned,
+					Document:   document,
+					Recipient:  &recipient,
+					ObservedAt: now,
+				})
+			}
+		}
+
+		if document.Status == DocumentStatusCompleted && before.Status != DocumentStatusCompleted {
+			events = append(events, SignatureEvent{Type: SignatureEventCompleted, Document: document, ObservedAt: now})
+		}
+	}
+	return events
+}
@@ -0,0 +1,115 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// DocumentsService handles operations related to documents and contracts (proposals)
+type DocumentsService struct {
+	client *Client
+}
+
+// Document represents a document or contract sent out for signature
+type Document struct {
+	ID            string              `json:"_id,omitempty"`
+	LocationID    string              `json:"locationId,omitempty"`
+	Name          string              `json:"name,omitempty"`
+	Status        string              `json:"status,omitempty"` // See DocumentStatus* constants
+	PaymentStatus string              `json:"paymentStatus,omitempty"`
+	Recipients    []DocumentRecipient `json:"recipients,omitempty"`
+	CreatedAt     Time                `json:"createdAt,omitempty"`
+	UpdatedAt     Time                `json:"updatedAt,omitempty"`
+}
+
+// DocumentRecipient represents a signer of a document
+type DocumentRecipient struct {
+	ID           string `json:"id,omitempty"` // Contact ID
+	Email        string `json:"email,omitempty"`
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	Role         string `json:"role,omitempty"` // e.g. "signer"
+	HasCompleted bool   `json:"hasCompleted,omitempty"`
+}
+
+// ListDocumentsOptions represents query options for listing documents
+type ListDocumentsOptions struct {
+	LocationID string
+	Status     string // See DocumentStatus* constants; all statuses when empty
+	Query      string // Matches the document name
+	Limit      int    // Default 20
+	Skip       int
+}
+
+// DocumentsResponse represents a list of documents API response
+type DocumentsResponse struct {
+	Documents []Document `json:"documents,omitempty"`
+	Total     int        `json:"total,omitempty"`
+}
+
+// SendDocumentRequest represents a request to send a document to its recipients for signature
+type SendDocumentRequest struct {
+	LocationID   string `json:"locationId"`
+	DocumentID   string `json:"documentId"`
+	DocumentName string `json:"documentName,omitempty"`
+	Medium       string `json:"medium,omitempty"` // "email" (default) or "link"
+	SentBy       string `json:"sentBy"`           // User ID of the sender
+}
+
+// List retrieves a page of the documents of a location
+// Required scope: documents_contracts/list.readonly
+func (s *DocumentsService) List(opts *ListDocumentsOptions) (*DocumentsResponse, error) {
+	if opts == nil {
+		opts = &ListDocumentsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := url.Values{}
+	query.Set("locationId", opts.LocationID)
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if opts.Query != "" {
+		query.Set("query", opts.Query)
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("skip", strconv.Itoa(opts.Skip))
+
+	var result DocumentsResponse
+	err := s.client.doRequest("GET", "/proposals/document?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Send sends a draft document to its recipients for signature
+// Required scope: documents_contracts/sendLink.write
+func (s *DocumentsService) Send(req *SendDocumentRequest) error {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if req.DocumentID == "" {
+		return fmt.Errorf("documentId is required")
+	}
+	if req.SentBy == "" {
+		return fmt.Errorf("sentBy is required")
+	}
+	if req.Medium == "" {
+		req.Medium = "email"
+	}
+
+	return s.client.doRequest("POST", "/proposals/document/send", req, nil)
+}
@@ -0,0 +1,104 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDocuments_Send(t *testing.T) {
+	var sent SendDocumentRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/proposals/document/send" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc-1"})
+	if err := client.Documents.Send(&SendDocumentRequest{DocumentID: "doc-1", SentBy: "user-1"}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if sent.LocationID != "loc-1" || sent.DocumentID != "doc-1" || sent.Medium != "email" {
+		t.Errorf("Unexpected request body: %+v", sent)
+	}
+
+	if err := client.Documents.Send(&SendDocumentRequest{DocumentID: "doc-1"}); err == nil {
+		t.Error("Expected error for missing sender")
+	}
+}
+
+func TestDiffSignatures(t *testing.T) {
+	previous := map[string]Document{
+		"doc-1": {ID: "doc-1", Status: DocumentStatusSent, Recipients: []DocumentRecipient{
+			{ID: "c1", HasCompleted: true}, {ID: "c2"},
+		}},
+		"doc-2": {ID: "doc-2", Status: DocumentStatusViewed},
+	}
+	current := map[string]Document{
+		"doc-1": {ID: "doc-1", Status: DocumentStatusCompleted, Recipients: []DocumentRecipient{
+			{ID: "c1", HasCompleted: true}, {ID: "c2", HasCompleted: true},
+		}},
+		"doc-2": {ID: "doc-2", Status: DocumentStatusViewed},
+		"doc-3": {ID: "doc-3", Status: DocumentStatusSent},
+	}
+
+	events := diffSignatures(previous, current, time.Now())
+	var types []string
+	for _, event := range events {
+		types = append(types, event.Document.ID+":"+event.Type)
+	}
+	want := []string{
+		"doc-1:" + SignatureEventStatusChanged,
+		"doc-1:" + SignatureEventSigned,
+		"doc-1:" + SignatureEventCompleted,
+		"doc-3:" + SignatureEventStatusChanged,
+	}
+	if len(types) != len(want) {
+		t.Fatalf("Expected events %v, got %v", want, types)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Errorf("Event %d: expected %s, got %s", i, want[i], types[i])
+		}
+	}
+	if events[0].PreviousStatus != DocumentStatusSent || events[1].Recipient.ID != "c2" {
+		t.Errorf("Unexpected event details: %+v %+v", events[0], events[1])
+	}
+}
+
+func TestDocuments_WatchSignatures(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&polls, 1) == 1 {
+			w.Write([]byte(`{"documents":[{"_id":"doc-1","status":"sent","recipients":[{"id":"c1"}]}]}`))
+			return
+		}
+		w.Write([]byte(`{"documents":[{"_id":"doc-1","status":"completed","recipients":[{"id":"c1","hasCompleted":true}]}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var events []SignatureEvent
+	err := client.Documents.WatchSignatures(ctx, &WatchSignaturesOptions{LocationID: "loc-1", Interval: 10 * time.Millisecond}, func(event SignatureEvent) {
+		events = append(events, event)
+		if event.Type == SignatureEventCompleted {
+			cancel()
+		}
+	})
+	if err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if len(events) != 3 || events[1].Type != SignatureEventSigned || events[1].Recipient.ID != "c1" {
+		t.Errorf("Unexpected events: %+v", events)
+	}
+}
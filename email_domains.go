@@ -0,0 +1,140 @@
+package gohighlevel
+
+import "fmt"
+
+// SendingDomain represents an email sending domain configured for a location
+type SendingDomain struct {
+	ID          string            `json:"id,omitempty"`
+	LocationID  string            `json:"locationId,omitempty"`
+	Domain      string            `json:"domain,omitempty"`
+	Status      string            `json:"status,omitempty"` // e.g. "pending", "verified", "failed"
+	IsDedicated bool              `json:"isDedicated,omitempty"`
+	IsDefault   bool              `json:"isDefault,omitempty"`
+	DNSRecords  []DomainDNSRecord `json:"dnsRecords,omitempty"`
+	DateAdded   Time              `json:"dateAdded,omitempty"`
+	DateUpdated Time              `json:"dateUpdated,omitempty"`
+}
+
+// DomainDNSRecord represents a DNS record that must exist for a sending domain to verify
+type DomainDNSRecord struct {
+	Type    string `json:"type,omitempty"` // "TXT", "CNAME" or "MX"
+	Host    string `json:"host,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Purpose string `json:"purpose,omitempty"` // e.g. "spf", "dkim", "dmarc", "tracking"
+	Valid   bool   `json:"valid"`
+}
+
+// Verified reports whether the domain is verified and all of its DNS records are in place
+func (d *SendingDomain) Verified() bool {
+	if d.Status != "verified" {
+		return false
+	}
+	for _, record := range d.DNSRecords {
+		if !record.Valid {
+			return false
+		}
+	}
+	return true
+}
+
+// PendingDNSRecords returns the DNS records that have not been found yet
+func (d *SendingDomain) PendingDNSRecords() []DomainDNSRecord {
+	var pending []DomainDNSRecord
+	for _, record := range d.DNSRecords {
+		if !record.Valid {
+			pending = append(pending, record)
+		}
+	}
+	return pending
+}
+
+// AddSendingDomainRequest represents a request to add a sending domain to a location
+type AddSendingDomainRequest struct {
+	LocationID  string `json:"locationId"`
+	Domain      string `json:"domain"` // e.g. "mail.example.com"
+	IsDedicated bool   `json:"isDedicated,omitempty"`
+}
+
+// SendingDomainsResponse represents a list of sending domains API response
+type SendingDomainsResponse struct {
+	Domains []SendingDomain `json:"domains,omitempty"`
+}
+
+// sendingDomainResponse represents a single sending domain API response
+type sendingDomainResponse struct {
+	Domain *SendingDomain `json:"domain,omitempty"`
+}
+
+// ListSendingDomains retrieves the sending domains configured for a location
+// Required scope: emails/domains.readonly
+func (s *EmailsService) ListSendingDomains(locationID string) ([]SendingDomain, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result SendingDomainsResponse
+	err := s.client.doRequest("GET", "/emails/domains?"+locationQuery(locationID).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Domains, nil
+}
+
+// AddSendingDomain adds a sending domain to a location.
+// The returned domain lists the DNS records that have to be created before it can be verified.
+// Required scope: emails/domains.write
+func (s *EmailsService) AddSendingDomain(req *AddSendingDomainRequest) (*SendingDomain, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Domain == "" {
+		return nil, fmt.Errorf("domain is required")
+	}
+
+	var result sendingDomainResponse
+	err := s.client.doRequest("POST", "/emails/domains", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Domain, nil
+}
+
+// VerifySendingDomain re-checks the DNS records of a sending domain and returns its updated status
+// Required scope: emails/domains.write
+func (s *EmailsService) VerifySendingDomain(locationID, domainID string) (*SendingDomain, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if domainID == "" {
+		return nil, fmt.Errorf("domainId is required")
+	}
+
+	var result sendingDomainResponse
+	path := fmt.Sprintf("/emails/domains/%s/verify?%s", domainID, locationQuery(locationID).Encode())
+	err := s.client.doRequest("POST", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Domain, nil
+}
+
+// DeleteSendingDomain removes a sending domain from a location
+// Required scope: emails/domains.write
+func (s *EmailsService) DeleteSendingDomain(locationID, domainID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if domainID == "" {
+		return fmt.Errorf("domainId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/emails/domains/%s?%s", domainID, locationQuery(locationID).Encode()), nil, nil)
+}
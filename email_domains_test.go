@@ -0,0 +1,104 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSendingDomain_Verified(t *testing.T) {
+	domain := &SendingDomain{
+		Status: "verified",
+		DNSRecords: []DomainDNSRecord{
+			{Type: "TXT", Purpose: "spf", Valid: true},
+			{Type: "CNAME", Purpose: "dkim", Valid: false},
+		},
+	}
+	if domain.Verified() {
+		t.Error("Expected a domain with a missing DNS record not to be verified")
+	}
+	if pending := domain.PendingDNSRecords(); len(pending) != 1 || pending[0].Purpose != "dkim" {
+		t.Errorf("Unexpected pending records: %+v", pending)
+	}
+
+	domain.DNSRecords[1].Valid = true
+	if !domain.Verified() || len(domain.PendingDNSRecords()) != 0 {
+		t.Errorf("Expected domain to be verified: %+v", domain)
+	}
+
+	domain.Status = "pending"
+	if domain.Verified() {
+		t.Error("Expected a pending domain not to be verified")
+	}
+}
+
+func TestEmailsService_SendingDomains(t *testing.T) {
+	var added AddSendingDomainRequest
+	var deleted bool
+	checkQuery := func(r *http.Request) {
+		if r.URL.Query().Get("locationId") != "loc-1" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /emails/domains": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r)
+			_, _ = w.Write([]byte(`{"domains":[{"id":"dom-1","domain":"mail.example.com","status":"verified"}]}`))
+		},
+		"POST /emails/domains": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&added)
+			_, _ = w.Write([]byte(`{"domain":{"id":"dom-2","domain":"news.example.com","status":"pending","dnsRecords":[{"type":"TXT","purpose":"spf","valid":false}]}}`))
+		},
+		"POST /emails/domains/dom-2/verify": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r)
+			_, _ = w.Write([]byte(`{"domain":{"id":"dom-2","status":"verified","dnsRecords":[{"type":"TXT","purpose":"spf","valid":true}]}}`))
+		},
+		"DELETE /emails/domains/dom-2": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r)
+			deleted = true
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	domains, err := client.Emails.ListSendingDomains("loc-1")
+	if err != nil {
+		t.Fatalf("ListSendingDomains failed: %v", err)
+	}
+	if len(domains) != 1 || domains[0].Domain != "mail.example.com" {
+		t.Errorf("Unexpected domains: %+v", domains)
+	}
+
+	domain, err := client.Emails.AddSendingDomain(&AddSendingDomainRequest{LocationID: "loc-1", Domain: "news.example.com"})
+	if err != nil {
+		t.Fatalf("AddSendingDomain failed: %v", err)
+	}
+	if domain.ID != "dom-2" || len(domain.PendingDNSRecords()) != 1 {
+		t.Errorf("Unexpected domain: %+v", domain)
+	}
+	if added.LocationID != "loc-1" || added.Domain != "news.example.com" {
+		t.Errorf("Unexpected add request: %+v", added)
+	}
+
+	domain, err = client.Emails.VerifySendingDomain("loc-1", "dom-2")
+	if err != nil || !domain.Verified() {
+		t.Errorf("VerifySendingDomain = %+v, %v", domain, err)
+	}
+
+	if err := client.Emails.DeleteSendingDomain("loc-1", "dom-2"); err != nil || !deleted {
+		t.Errorf("DeleteSendingDomain failed: %v", err)
+	}
+
+	if _, err := client.Emails.AddSendingDomain(&AddSendingDomainRequest{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error for missing domain")
+	}
+	if _, err := client.Emails.VerifySendingDomain("loc-1", ""); err == nil {
+		t.Error("Expected error for missing domainId")
+	}
+	if err := client.Emails.DeleteSendingDomain("loc-1", ""); err == nil {
+		t.Error("Expected error for missing domainId")
+	}
+
+	if _, err := client.Emails.ListSendingDomains(""); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
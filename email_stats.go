@@ -0,0 +1,86 @@
+package gohighlevel
+
+import "context"
+
+// Sent returns the number of emails sent: delivered, bounced and failed
+func (s *EmailCampaignStats) Sent() int {
+	return s.Delivered + s.Bounced + s.Failed
+}
+
+// DeliveryRate returns the share of sent emails that were delivered, or 0 when none were sent
+func (s *EmailCampaignStats) DeliveryRate() float64 {
+	return ratio(s.Delivered, s.Sent())
+}
+
+// OpenRate returns the share of delivered emails that were opened
+func (s *EmailCampaignStats) OpenRate() float64 {
+	return ratio(s.Opened, s.Delivered)
+}
+
+// ClickRate returns the share of delivered emails with a clicked link
+func (s *EmailCampaignStats) ClickRate() float64 {
+	return ratio(s.Clicked, s.Delivered)
+}
+
+// BounceRate returns the share of sent emails that bounced
+func (s *EmailCampaignStats) BounceRate() float64 {
+	return ratio(s.Bounced, s.Sent())
+}
+
+// Add adds the counts of other to s
+func (s *EmailCampaignStats) Add(other *EmailCampaignStats) {
+	if other == nil {
+		return
+	}
+	s.Delivered += other.Delivered
+	s.Opened += other.Opened
+	s.Clicked += other.Clicked
+	s.Bounced += other.Bounced
+	s.Failed += other.Failed
+	s.Unsubscribed += other.Unsubscribed
+	s.Complained += other.Complained
+}
+
+// ratio returns n/total, or 0 when total is 0
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+// emailCampaignsPageSize is the number of campaigns fetched per request by CampaignStats
+const emailCampaignsPageSize = 100
+
+// CampaignStats retrieves every email campaign matching opts with its delivery, open, click and
+// bounce counts, and returns them with the totals across all of them. opts.ShowStats is
+// implied, and opts.Limit and opts.Offset are ignored.
+// Required scope: emails/schedule.readonly
+func (s *EmailsService) CampaignStats(ctx context.Context, opts *ListEmailCampaignsOptions) ([]EmailCampaign, *EmailCampaignStats, error) {
+	query := ListEmailCampaignsOptions{}
+	if opts != nil {
+		query = *opts
+	}
+	query.ShowStats = true
+	query.Limit = emailCampaignsPageSize
+
+	var campaigns []EmailCampaign
+	totals := &EmailCampaignStats{}
+	for query.Offset = 0; ; query.Offset += emailCampaignsPageSize {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		page := query
+		result, err := s.ListCampaigns(&page)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, campaign := range result.Campaigns {
+			totals.Add(campaign.Stats)
+		}
+		campaigns = append(campaigns, result.Campaigns...)
+		if len(result.Campaigns) < emailCampaignsPageSize {
+			return campaigns, totals, nil
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEmailCampaignStats_Rates(t *testing.T) {
+	stats := &EmailCampaignStats{Delivered: 90, Bounced: 8, Failed: 2, Opened: 45, Clicked: 9}
+	if stats.Sent() != 100 || stats.DeliveryRate() != 0.9 || stats.BounceRate() != 0.08 {
+		t.Errorf("unexpected send rates: sent %d, delivery %v, bounce %v", stats.Sent(), stats.DeliveryRate(), stats.BounceRate())
+	}
+	if stats.OpenRate() != 0.5 || stats.ClickRate() != 0.1 {
+		t.Errorf("unexpected engagement rates: open %v, click %v", stats.OpenRate(), stats.ClickRate())
+	}
+	if (&EmailCampaignStats{}).OpenRate() != 0 {
+		t.Error("expected zero rate without deliveries")
+	}
+}
+
+func TestEmailsService_CampaignStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/emails/schedule" || query.Get("showStats") != "true" || query.Get("status") != "complete" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if query.Get("offset") == "" {
+			campaigns := make([]string, emailCampaignsPageSize)
+			for i := range campaigns {
+				campaigns[i] = fmt.Sprintf(`{"id":"camp-%d","stats":{"delivered":10,"opened":5}}`, i)
+			}
+			_, _ = w.Write([]byte(`{"schedules":[` + strings.Join(campaigns, ",") + `]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"schedules":[{"id":"camp-last","stats":{"delivered":10,"opened":5,"bounced":2}},{"id":"camp-draft"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	campaigns, totals, err := client.Emails.CampaignStats(context.Background(), &ListEmailCampaignsOptions{LocationID: "loc-1", Status: "complete"})
+	if err != nil {
+		t.Fatalf("CampaignStats failed: %v", err)
+	}
+	if len(campaigns) != emailCampaignsPageSize+2 {
+		t.Errorf("expected %d campaigns, got %d", emailCampaignsPageSize+2, len(campaigns))
+	}
+	if totals.Delivered != 1010 || totals.Opened != 505 || totals.Bounced != 2 {
+		t.Errorf("unexpected totals %+v", totals)
+	}
+}
@@ -0,0 +1,419 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// EmailsService handles operations related to email templates and campaigns
+type EmailsService struct {
+	client *Client
+}
+
+// EmailTemplate represents a GoHighLevel email template
+type EmailTemplate struct {
+	ID                  string `json:"id,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Title               string `json:"title,omitempty"`
+	Type                string `json:"type,omitempty"`
+	LocationID          string `json:"locationId,omitempty"`
+	ParentID            string `json:"parentId,omitempty"`
+	Version             string `json:"version,omitempty"`
+	BuilderVersion      string `json:"builderVersion,omitempty"`
+	IsPlainText         bool   `json:"isPlainText,omitempty"`
+	Archived            bool   `json:"archived,omitempty"`
+	PreviewURL          string `json:"previewUrl,omitempty"`
+	TemplateDataURL     string `json:"templateDataUrl,omitempty"`
+	TemplateDownloadURL string `json:"templateDownloadUrl,omitempty"`
+	UpdatedBy           string `json:"updatedBy,omitempty"`
+	DateAdded           Time   `json:"dateAdded,omitempty"`
+	LastUpdated         Time   `json:"lastUpdated,omitempty"`
+}
+
+// ListEmailTemplatesOptions represents query options for listing email templates
+type ListEmailTemplatesOptions struct {
+	LocationID     string
+	Limit          int
+	Offset         int
+	Search         string
+	SortByDate     string // "asc" or "desc"
+	Archived       bool
+	BuilderVersion string // "1" or "2"
+	Name           string
+	ParentID       string
+	OriginID       string
+}
+
+// EmailTemplatesResponse represents a list of email templates API response
+type EmailTemplatesResponse struct {
+	Templates []EmailTemplate `json:"builders,omitempty"`
+	Total     []struct {
+		Total int `json:"total,omitempty"`
+	} `json:"total,omitempty"`
+}
+
+// CreateEmailTemplateRequest represents a request to create an email template
+type CreateEmailTemplateRequest struct {
+	LocationID      string `json:"locationId"`
+	Title           string `json:"title,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Type            string `json:"type"` // "html", "folder", "import", "builder" or "blank"
+	UpdatedBy       string `json:"updatedBy,omitempty"`
+	BuilderVersion  string `json:"builderVersion,omitempty"`
+	ParentID        string `json:"parentId,omitempty"`
+	TemplateDataURL string `json:"templateDataUrl,omitempty"`
+	ImportProvider  string `json:"importProvider,omitempty"`
+	ImportURL       string `json:"importURL,omitempty"`
+	TemplateSource  string `json:"templateSource,omitempty"`
+	IsPlainText     bool   `json:"isPlainText,omitempty"`
+}
+
+// CreateEmailTemplateResponse represents the response to an email template creation
+type CreateEmailTemplateResponse struct {
+	ID       string `json:"id,omitempty"`
+	Redirect string `json:"redirect,omitempty"`
+	TraceID  string `json:"traceId,omitempty"`
+}
+
+// UpdateEmailTemplateRequest represents a request to update the content of an email template
+type UpdateEmailTemplateRequest struct {
+	LocationID  string          `json:"locationId"`
+	TemplateID  string          `json:"templateId"`
+	UpdatedBy   string          `json:"updatedBy,omitempty"`
+	HTML        string          `json:"html,omitempty"`
+	EditorType  string          `json:"editorType,omitempty"` // "html" or "builder"
+	PreviewText string          `json:"previewText,omitempty"`
+	IsPlainText bool            `json:"isPlainText,omitempty"`
+	DND         json.RawMessage `json:"dnd,omitempty"` // Drag-and-drop builder design JSON
+}
+
+// CreateBuilderEmailRequest represents a request to create an email using the drag-and-drop builder
+type CreateBuilderEmailRequest struct {
+	LocationID     string
+	Title          string
+	ParentID       string
+	UpdatedBy      string
+	BuilderVersion string // Defaults to "2"
+}
+
+// UpdateBuilderEmailRequest represents a request to replace the design of a builder email.
+// Design is marshaled to JSON unless it is already a json.RawMessage or []byte.
+type UpdateBuilderEmailRequest struct {
+	LocationID  string
+	TemplateID  string
+	UpdatedBy   string
+	Design      interface{}
+	HTML        string
+	PreviewText string
+}
+
+// UpdateEmailTemplateResponse represents the response to an email template update
+type UpdateEmailTemplateResponse struct {
+	OK                  string `json:"ok,omitempty"`
+	TraceID             string `json:"traceId,omitempty"`
+	PreviewURL          string `json:"previewUrl,omitempty"`
+	TemplateDownloadURL string `json:"templateDownloadUrl,omitempty"`
+}
+
+// ListTemplates retrieves email templates for a location
+// Required scope: emails/builder.readonly
+func (s *EmailsService) ListTemplates(opts *ListEmailTemplatesOptions) (*EmailTemplatesResponse, error) {
+	if opts == nil {
+		opts = &ListEmailTemplatesOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := url.Values{}
+	query.Set("locationId", opts.LocationID)
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if opts.SortByDate != "" {
+		query.Set("sortByDate", opts.SortByDate)
+	}
+	if opts.Archived {
+		query.Set("archived", "true")
+	}
+	if opts.BuilderVersion != "" {
+		query.Set("builderVersion", opts.BuilderVersion)
+	}
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if opts.ParentID != "" {
+		query.Set("parentId", opts.ParentID)
+	}
+	if opts.OriginID != "" {
+		query.Set("originId", opts.OriginID)
+	}
+
+	var result EmailTemplatesResponse
+	err := s.client.doRequest("GET", "/emails/builder?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetTemplate retrieves a single email template by ID.
+// The API has no single-template endpoint, so this pages through ListTemplates until the template is found.
+// Required scope: emails/builder.readonly
+func (s *EmailsService) GetTemplate(locationID, templateID string) (*EmailTemplate, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if templateID == "" {
+		return nil, fmt.Errorf("templateId is required")
+	}
+
+	const pageSize = 100
+	for offset := 0; ; offset += pageSize {
+		page, err := s.ListTemplates(&ListEmailTemplatesOptions{
+			LocationID: locationID,
+			Limit:      pageSize,
+			Offset:     offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Templates {
+			if page.Templates[i].ID == templateID {
+				return &page.Templates[i], nil
+			}
+		}
+
+		if len(page.Templates) < pageSize {
+			return nil, fmt.Errorf("email template %s not found", templateID)
+		}
+	}
+}
+
+// CreateTemplate creates a new email template
+// Required scope: emails/builder.write
+func (s *EmailsService) CreateTemplate(req *CreateEmailTemplateRequest) (*CreateEmailTemplateResponse, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Type == "" {
+		return nil, fmt.Errorf("type is required")
+	}
+
+	var result CreateEmailTemplateResponse
+	err := s.client.doRequest("POST", "/emails/builder", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateTemplate updates the content of an existing email template
+// Required scope: emails/builder.write
+func (s *EmailsService) UpdateTemplate(req *UpdateEmailTemplateRequest) (*UpdateEmailTemplateResponse, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.TemplateID == "" {
+		return nil, fmt.Errorf("templateId is required")
+	}
+
+	var result UpdateEmailTemplateResponse
+	err := s.client.doRequest("POST", "/emails/builder/data", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteTemplate deletes an email template
+// Required scope: emails/builder.write
+func (s *EmailsService) DeleteTemplate(locationID, templateID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if templateID == "" {
+		return fmt.Errorf("templateId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/emails/builder/%s/%s", locationID, templateID), nil, nil)
+}
+
+// CreateBuilderEmail creates an empty email that is edited with the drag-and-drop builder
+// Required scope: emails/builder.write
+func (s *EmailsService) CreateBuilderEmail(req *CreateBuilderEmailRequest) (*CreateEmailTemplateResponse, error) {
+	builderVersion := req.BuilderVersion
+	if builderVersion == "" {
+		builderVersion = "2"
+	}
+
+	return s.CreateTemplate(&CreateEmailTemplateRequest{
+		LocationID:     req.LocationID,
+		Title:          req.Title,
+		Type:           "builder",
+		BuilderVersion: builderVersion,
+		ParentID:       req.ParentID,
+		UpdatedBy:      req.UpdatedBy,
+	})
+}
+
+// UpdateBuilderEmail replaces the JSON design (and optionally the rendered HTML) of a builder email
+// Required scope: emails/builder.write
+func (s *EmailsService) UpdateBuilderEmail(req *UpdateBuilderEmailRequest) (*UpdateEmailTemplateResponse, error) {
+	if req.Design == nil {
+		return nil, fmt.Errorf("design is required")
+	}
+
+	var design json.RawMessage
+	switch d := req.Design.(type) {
+	case json.RawMessage:
+		design = d
+	case []byte:
+		design = d
+	default:
+		data, err := json.Marshal(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal email design: %w", err)
+		}
+		design = data
+	}
+
+	return s.UpdateTemplate(&UpdateEmailTemplateRequest{
+		LocationID:  req.LocationID,
+		TemplateID:  req.TemplateID,
+		UpdatedBy:   req.UpdatedBy,
+		HTML:        req.HTML,
+		EditorType:  "builder",
+		PreviewText: req.PreviewText,
+		DND:         design,
+	})
+}
+
+// EmailCampaign represents a scheduled email campaign
+type EmailCampaign struct {
+	ID                string              `json:"id,omitempty"`
+	Name              string              `json:"name,omitempty"`
+	LocationID        string              `json:"locationId,omitempty"`
+	ParentID          string              `json:"parentId,omitempty"`
+	Status            string              `json:"status,omitempty"`
+	CampaignType      string              `json:"campaignType,omitempty"`
+	Type              string              `json:"type,omitempty"`
+	TemplateID        string              `json:"templateId,omitempty"`
+	TemplateType      string              `json:"templateType,omitempty"`
+	DocumentID        string              `json:"documentId,omitempty"`
+	ChildCount        int                 `json:"childCount,omitempty"`
+	RepeatAfter       string              `json:"repeatAfter,omitempty"`
+	SendDays          []string            `json:"sendDays,omitempty"`
+	HasTracking       bool                `json:"hasTracking,omitempty"`
+	HasUTMTracking    bool                `json:"hasUtmTracking,omitempty"`
+	IsPlainText       bool                `json:"isPlainText,omitempty"`
+	Archived          bool                `json:"archived,omitempty"`
+	BulkActionVersion string              `json:"bulkActionVersion,omitempty"`
+	CreatedAt         Time                `json:"createdAt,omitempty"`
+	UpdatedAt         Time                `json:"updatedAt,omitempty"`
+	Stats             *EmailCampaignStats `json:"stats,omitempty"`
+}
+
+// EmailCampaignStats represents delivery statistics for an email campaign.
+// Only populated when the campaigns are listed with ShowStats enabled.
+type EmailCampaignStats struct {
+	Delivered    int `json:"delivered,omitempty"`
+	Opened       int `json:"opened,omitempty"`
+	Clicked      int `json:"clicked,omitempty"`
+	Bounced      int `json:"bounced,omitempty"`
+	Failed       int `json:"failed,omitempty"`
+	Unsubscribed int `json:"unsubscribed,omitempty"`
+	Complained   int `json:"complained,omitempty"`
+}
+
+// ListEmailCampaignsOptions represents query options for listing email campaigns
+type ListEmailCampaignsOptions struct {
+	LocationID    string
+	Limit         int
+	Offset        int
+	Status        string // "active", "pause", "complete", "cancelled", "retry", "draft" or "resend-scheduled"
+	EmailStatus   string // "all", "not-started", "paused", "cancelled", "processing", "resumed", "rescheduled" or "completed"
+	Name          string
+	ParentID      string
+	SchedulesType string
+	ShowStats     bool
+	Archived      bool
+}
+
+// EmailCampaignsResponse represents a list of email campaigns API response
+type EmailCampaignsResponse struct {
+	Campaigns []EmailCampaign `json:"schedules,omitempty"`
+	Total     []struct {
+		Total int `json:"total,omitempty"`
+	} `json:"total,omitempty"`
+}
+
+// ListCampaigns retrieves email campaigns and their schedules for a location
+// Required scope: emails/schedule.readonly
+func (s *EmailsService) ListCampaigns(opts *ListEmailCampaignsOptions) (*EmailCampaignsResponse, error) {
+	if opts == nil {
+		opts = &ListEmailCampaignsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := url.Values{}
+	query.Set("locationId", opts.LocationID)
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if opts.EmailStatus != "" {
+		query.Set("emailStatus", opts.EmailStatus)
+	}
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if opts.ParentID != "" {
+		query.Set("parentId", opts.ParentID)
+	}
+	if opts.SchedulesType != "" {
+		query.Set("schedulesType", opts.SchedulesType)
+	}
+	if opts.ShowStats {
+		query.Set("showStats", "true")
+	}
+	if opts.Archived {
+		query.Set("archived", "true")
+	}
+
+	var result EmailCampaignsResponse
+	err := s.client.doRequest("GET", "/emails/schedule?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
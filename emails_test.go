@@ -0,0 +1,170 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestEmailsService_Templates(t *testing.T) {
+	var created CreateEmailTemplateRequest
+	var updated UpdateEmailTemplateRequest
+	deleted := false
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /emails/builder": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("locationId") != "loc-1" || q.Get("limit") != "100" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			if q.Get("search") == "welcome" && (q.Get("sortByDate") != "desc" || q.Get("archived") != "true") {
+				t.Errorf("filters not sent: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"builders":[{"id":"tpl-1","name":"Welcome"},{"id":"tpl-2","name":"Receipt"}],"total":[{"total":2}]}`))
+		},
+		"POST /emails/builder": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"id":"tpl-3"}`))
+		},
+		"POST /emails/builder/data": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"ok":"true"}`))
+		},
+		"DELETE /emails/builder/loc-1/tpl-1": func(w http.ResponseWriter, r *http.Request) {
+			deleted = true
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	list, err := client.Emails.ListTemplates(&ListEmailTemplatesOptions{LocationID: "loc-1", Limit: 100, Search: "welcome", SortByDate: "desc", Archived: true})
+	if err != nil {
+		t.Fatalf("ListTemplates failed: %v", err)
+	}
+	if len(list.Templates) != 2 || list.Total[0].Total != 2 {
+		t.Errorf("Unexpected templates: %+v", list)
+	}
+
+	template, err := client.Emails.GetTemplate("loc-1", "tpl-2")
+	if err != nil || template.Name != "Receipt" {
+		t.Errorf("GetTemplate = %+v, %v", template, err)
+	}
+	if _, err := client.Emails.GetTemplate("loc-1", "tpl-9"); err == nil {
+		t.Error("Expected error for a missing template")
+	}
+
+	resp, err := client.Emails.CreateTemplate(&CreateEmailTemplateRequest{LocationID: "loc-1", Title: "Promo", Type: "html"})
+	if err != nil || resp.ID != "tpl-3" {
+		t.Errorf("CreateTemplate = %+v, %v", resp, err)
+	}
+	if created.Type != "html" {
+		t.Errorf("Unexpected create request: %+v", created)
+	}
+	if _, err := client.Emails.CreateTemplate(&CreateEmailTemplateRequest{LocationID: "loc-1", Title: "Promo"}); err == nil {
+		t.Error("Expected error for missing type")
+	}
+
+	if _, err := client.Emails.UpdateTemplate(&UpdateEmailTemplateRequest{LocationID: "loc-1", TemplateID: "tpl-1", HTML: "<p>Hi</p>"}); err != nil {
+		t.Fatalf("UpdateTemplate failed: %v", err)
+	}
+	if updated.TemplateID != "tpl-1" || updated.HTML != "<p>Hi</p>" {
+		t.Errorf("Unexpected update request: %+v", updated)
+	}
+	if _, err := client.Emails.UpdateTemplate(&UpdateEmailTemplateRequest{LocationID: "loc-1", HTML: "<p>Hi</p>"}); err == nil {
+		t.Error("Expected error for missing templateId")
+	}
+
+	if err := client.Emails.DeleteTemplate("loc-1", "tpl-1"); err != nil || !deleted {
+		t.Errorf("DeleteTemplate failed: %v", err)
+	}
+	if err := client.Emails.DeleteTemplate("loc-1", ""); err == nil {
+		t.Error("Expected error for missing templateId")
+	}
+}
+
+func TestEmailsService_BuilderEmail(t *testing.T) {
+	var created CreateEmailTemplateRequest
+	var updated UpdateEmailTemplateRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /emails/builder": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"id":"tpl-1"}`))
+		},
+		"POST /emails/builder/data": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"ok":"true"}`))
+		},
+	})
+
+	if _, err := client.Emails.CreateBuilderEmail(&CreateBuilderEmailRequest{LocationID: "loc-1", Title: "Newsletter"}); err != nil {
+		t.Fatalf("CreateBuilderEmail failed: %v", err)
+	}
+	if created.Type != "builder" || created.BuilderVersion != "2" || created.Title != "Newsletter" {
+		t.Errorf("Unexpected create request: %+v", created)
+	}
+
+	design := map[string]interface{}{"elements": []string{"header"}}
+	if _, err := client.Emails.UpdateBuilderEmail(&UpdateBuilderEmailRequest{LocationID: "loc-1", TemplateID: "tpl-1", Design: design}); err != nil {
+		t.Fatalf("UpdateBuilderEmail failed: %v", err)
+	}
+	if updated.EditorType != "builder" || string(updated.DND) != `{"elements":["header"]}` {
+		t.Errorf("Unexpected update request: %+v", updated)
+	}
+
+	raw := json.RawMessage(`{"elements":[]}`)
+	if _, err := client.Emails.UpdateBuilderEmail(&UpdateBuilderEmailRequest{LocationID: "loc-1", TemplateID: "tpl-1", Design: raw}); err != nil {
+		t.Fatalf("UpdateBuilderEmail failed: %v", err)
+	}
+	if string(updated.DND) != string(raw) {
+		t.Errorf("Expected the raw design to be sent as is, got %s", updated.DND)
+	}
+	if _, err := client.Emails.UpdateBuilderEmail(&UpdateBuilderEmailRequest{LocationID: "loc-1", TemplateID: "tpl-1"}); err == nil {
+		t.Error("Expected error for missing design")
+	}
+}
+
+// Integration tests for Emails API
+// These tests use the same environment variables as the Contacts tests.
+
+func TestEmailsIntegration_ListTemplates(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	templates, err := client.Emails.ListTemplates(&ListEmailTemplatesOptions{
+		LocationID: locationID,
+		Limit:      10,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list email templates: %v", err)
+	}
+
+	t.Logf("Found %d email templates", len(templates.Templates))
+}
+
+func TestEmailsIntegration_ListCampaigns(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	campaigns, err := client.Emails.ListCampaigns(&ListEmailCampaignsOptions{
+		LocationID: locationID,
+		Limit:      10,
+		ShowStats:  true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list email campaigns: %v", err)
+	}
+
+	for _, campaign := range campaigns.Campaigns {
+		if campaign.ID == "" {
+			t.Error("Campaign has no ID")
+		}
+	}
+
+	t.Logf("Found %d email campaigns", len(campaigns.Campaigns))
+}
@@ -0,0 +1,131 @@
+package gohighlevel
+
+// Named values for the status and type fields used across the API. The fields themselves are
+// plain strings, so these can be assigned directly and a misspelled constant fails to compile.
+
+// Contact types
+const (
+	ContactTypeLead     = "lead"
+	ContactTypeCustomer = "customer"
+)
+
+// DND statuses (DNDSetting.Status)
+const (
+	DNDStatusActive    = "active"
+	DNDStatusInactive  = "inactive"
+	DNDStatusPermanent = "permanent"
+)
+
+// Message types
+const (
+	MessageTypeSMS      = "SMS"
+	MessageTypeEmail    = "Email"
+	MessageTypeWhatsApp = "WhatsApp"
+	MessageTypeGMB      = "GMB"
+	MessageTypeIG       = "IG"
+	MessageTypeFB       = "FB"
+	MessageTypeLiveChat = "Live_Chat"
+	MessageTypeCustom   = "Custom"
+)
+
+// Message directions
+const (
+	MessageDirectionInbound  = "inbound"
+	MessageDirectionOutbound = "outbound"
+)
+
+// Message statuses
+const (
+	MessageStatusPending     = "pending"
+	MessageStatusScheduled   = "scheduled"
+	MessageStatusSent        = "sent"
+	MessageStatusDelivered   = "delivered"
+	MessageStatusRead        = "read"
+	MessageStatusUndelivered = "undelivered"
+	MessageStatusFailed      = "failed"
+)
+
+// Opportunity statuses
+const (
+	OpportunityStatusOpen      = "open"
+	OpportunityStatusWon       = "won"
+	OpportunityStatusLost      = "lost"
+	OpportunityStatusAbandoned = "abandoned"
+)
+
+// Appointment statuses
+const (
+	AppointmentStatusNew       = "new"
+	AppointmentStatusConfirmed = "confirmed"
+	AppointmentStatusCancelled = "cancelled"
+	AppointmentStatusShowed    = "showed"
+	AppointmentStatusNoShow    = "noshow"
+	AppointmentStatusInvalid   = "invalid"
+)
+
+// Invoice statuses
+const (
+	InvoiceStatusDraft             = "draft"
+	InvoiceStatusSent              = "sent"
+	InvoiceStatusPaid              = "paid"
+	InvoiceStatusPartiallyPaid     = "partially_paid"
+	InvoiceStatusVoid              = "void"
+	InvoiceStatusPaymentProcessing = "payment_processing"
+)
+
+// Subscription statuses
+const (
+	SubscriptionStatusActive     = "active"
+	SubscriptionStatusTrialing   = "trialing"
+	SubscriptionStatusPastDue    = "past_due"
+	SubscriptionStatusCanceled   = "canceled"
+	SubscriptionStatusUnpaid     = "unpaid"
+	SubscriptionStatusIncomplete = "incomplete"
+)
+
+// Coupon statuses
+const (
+	CouponStatusScheduled = "scheduled"
+	CouponStatusActive    = "active"
+	CouponStatusExpired   = "expired"
+)
+
+// Price types
+const (
+	PriceTypeOneTime   = "one_time"
+	PriceTypeRecurring = "recurring"
+)
+
+// Blog post statuses
+const (
+	BlogPostStatusDraft     = "DRAFT"
+	BlogPostStatusPublished = "PUBLISHED"
+	BlogPostStatusScheduled = "SCHEDULED"
+	BlogPostStatusArchived  = "ARCHIVED"
+)
+
+// Social post statuses
+const (
+	SocialPostStatusDraft     = "draft"
+	SocialPostStatusScheduled = "scheduled"
+	SocialPostStatusPublished = "published"
+	SocialPostStatusFailed    = "failed"
+	SocialPostStatusInReview  = "in_review"
+)
+
+// Document (proposal, estimate or contract) statuses
+const (
+	DocumentStatusDraft     = "draft"
+	DocumentStatusSent      = "sent"
+	DocumentStatusViewed    = "viewed"
+	DocumentStatusCompleted = "completed"
+	DocumentStatusAccepted  = "accepted"
+)
+
+// Social post approval statuses
+const (
+	SocialApprovalPending     = "pending"
+	SocialApprovalApproved    = "approved"
+	SocialApprovalRejected    = "rejected"
+	SocialApprovalNotRequired = "not_required"
+)
@@ -0,0 +1,34 @@
+package gohighlevel
+
+import "strings"
+
+// Environment bundles the endpoints a client talks to, so the API and the OAuth token endpoint
+// can be pointed at a mock server or proxy together
+type Environment struct {
+	Name     string
+	BaseURL  string // Base URL for API requests
+	TokenURL string // OAuth token endpoint (default: BaseURL + "/oauth/token")
+}
+
+// ProductionEnvironment returns the GoHighLevel production environment
+func ProductionEnvironment() *Environment {
+	return &Environment{Name: "production", BaseURL: DefaultBaseURL, TokenURL: OAuthTokenURL}
+}
+
+// MockEnvironment returns an environment that sends API and OAuth requests to a mock server,
+// e.g. an httptest.Server in tests
+func MockEnvironment(serverURL string) *Environment {
+	serverURL = strings.TrimSuffix(serverURL, "/")
+	return &Environment{Name: "mock", BaseURL: serverURL, TokenURL: serverURL + "/oauth/token"}
+}
+
+// tokenURL returns the OAuth token endpoint of the environment
+func (e *Environment) tokenURL() string {
+	if e.TokenURL != "" {
+		return e.TokenURL
+	}
+	if e.BaseURL == "" {
+		return OAuthTokenURL
+	}
+	return strings.TrimSuffix(e.BaseURL, "/") + "/oauth/token"
+}
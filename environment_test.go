@@ -0,0 +1,57 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEnvironment_Mock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_, _ = w.Write([]byte(`{"access_token":"mock-access","refresh_token":"mock-refresh","expires_in":3600}`))
+		case "/contacts/c1":
+			_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(
+		WithCredentials("id", "secret"),
+		WithEnvironment(MockEnvironment(server.URL)),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+
+	if err := client.AuthorizeWithRefreshToken("refresh"); err != nil {
+		t.Fatalf("AuthorizeWithRefreshToken against mock failed: %v", err)
+	}
+	if client.GetAccessToken() != "mock-access" {
+		t.Errorf("access token = %q, want mock-access", client.GetAccessToken())
+	}
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Errorf("Get against mock failed: %v", err)
+	}
+}
+
+func TestEnvironment_Defaults(t *testing.T) {
+	client, _ := NewClient(Config{})
+	if client.BaseURL != DefaultBaseURL || client.tokenURL != OAuthTokenURL {
+		t.Errorf("default environment = %q, %q", client.BaseURL, client.tokenURL)
+	}
+
+	client, _ = NewClient(Config{
+		Environment: &Environment{Name: "staging", BaseURL: "https://staging.example.com"},
+		BaseURL:     "https://proxy.example.com",
+	})
+	if client.BaseURL != "https://proxy.example.com" {
+		t.Errorf("BaseURL = %q, explicit BaseURL should take precedence", client.BaseURL)
+	}
+	if client.tokenURL != "https://staging.example.com/oauth/token" {
+		t.Errorf("tokenURL = %q, want derived from environment", client.tokenURL)
+	}
+}
@@ -0,0 +1,174 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// knownFieldsCache caches the JSON field names of model types, keyed by reflect.Type
+var knownFieldsCache sync.Map
+
+// knownJSONFields returns the set of JSON field names declared by a struct type, in lowercase.
+// encoding/json matches object keys to fields case-insensitively, so keys are looked up folded.
+func knownJSONFields(t reflect.Type) map[string]bool {
+	if cached, ok := knownFieldsCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+
+	fields := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n := strings.Split(tag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+		fields[strings.ToLower(name)] = true
+	}
+
+	knownFieldsCache.Store(t, fields)
+	return fields
+}
+
+// unmarshalExtra returns the fields of a JSON object that are not declared by model,
+// or nil if there are none
+func unmarshalExtra(data []byte, model interface{}) (map[string]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	known := knownJSONFields(reflect.TypeOf(model))
+	var extra map[string]json.RawMessage
+	for key, value := range raw {
+		if known[strings.ToLower(key)] {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]json.RawMessage)
+		}
+		extra[key] = value
+	}
+	return extra, nil
+}
+
+// marshalWithExtra marshals model and adds the extra fields that it does not already contain,
+// comparing keys case-insensitively
+func marshalWithExtra(model interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(model)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	present := make(map[string]bool, len(merged))
+	for key := range merged {
+		present[strings.ToLower(key)] = true
+	}
+	for key, value := range extra {
+		if !present[strings.ToLower(key)] {
+			merged[key] = value
+		}
+	}
+	return json.Marshal(merged)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra
+func (c *Contact) UnmarshalJSON(data []byte) error {
+	type contact Contact
+	var model contact
+	if err := json.Unmarshal(data, &model); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, model)
+	if err != nil {
+		return err
+	}
+	*c = Contact(model)
+	c.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing back the fields kept in Extra
+func (c Contact) MarshalJSON() ([]byte, error) {
+	type contact Contact
+	return marshalWithExtra(contact(c), c.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra
+func (i *Invoice) UnmarshalJSON(data []byte) error {
+	type invoice Invoice
+	var model invoice
+	if err := json.Unmarshal(data, &model); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, model)
+	if err != nil {
+		return err
+	}
+	*i = Invoice(model)
+	i.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing back the fields kept in Extra
+func (i Invoice) MarshalJSON() ([]byte, error) {
+	type invoice Invoice
+	return marshalWithExtra(invoice(i), i.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra
+func (p *Product) UnmarshalJSON(data []byte) error {
+	type product Product
+	var model product
+	if err := json.Unmarshal(data, &model); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, model)
+	if err != nil {
+		return err
+	}
+	*p = Product(model)
+	p.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing back the fields kept in Extra
+func (p Product) MarshalJSON() ([]byte, error) {
+	type product Product
+	return marshalWithExtra(product(p), p.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra
+func (s *Subscription) UnmarshalJSON(data []byte) error {
+	type subscription Subscription
+	var model subscription
+	if err := json.Unmarshal(data, &model); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, model)
+	if err != nil {
+		return err
+	}
+	*s = Subscription(model)
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing back the fields kept in Extra
+func (s Subscription) MarshalJSON() ([]byte, error) {
+	type subscription Subscription
+	return marshalWithExtra(subscription(s), s.Extra)
+}
@@ -0,0 +1,73 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestContact_ExtraFieldsRoundTrip(t *testing.T) {
+	data := `{"id":"c1","firstName":"Ada","newField":{"nested":true},"score":42}`
+
+	var contact Contact
+	if err := json.Unmarshal([]byte(data), &contact); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if contact.ID != "c1" || contact.FirstName != "Ada" {
+		t.Errorf("Known fields not decoded: %+v", contact)
+	}
+	if len(contact.Extra) != 2 || string(contact.Extra["score"]) != "42" {
+		t.Errorf("Unexpected extra fields: %v", contact.Extra)
+	}
+
+	out, err := json.Marshal(contact)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var roundTrip map[string]json.RawMessage
+	if err := json.Unmarshal(out, &roundTrip); err != nil {
+		t.Fatalf("Unmarshal of output failed: %v", err)
+	}
+	if string(roundTrip["newField"]) != `{"nested":true}` {
+		t.Errorf("Expected newField to survive round-trip, got %s", out)
+	}
+	if string(roundTrip["firstName"]) != `"Ada"` {
+		t.Errorf("Expected firstName in output, got %s", out)
+	}
+}
+
+func TestProduct_NoExtraFields(t *testing.T) {
+	var product Product
+	if err := json.Unmarshal([]byte(`{"_id":"p1","name":"Widget"}`), &product); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if product.Extra != nil {
+		t.Errorf("Expected no extra fields, got %v", product.Extra)
+	}
+}
+
+func TestContact_ExtraFieldsCaseInsensitive(t *testing.T) {
+	var contact Contact
+	if err := json.Unmarshal([]byte(`{"ID":"c1","FirstName":"Ada"}`), &contact); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if contact.FirstName != "Ada" {
+		t.Errorf("Expected FirstName to be decoded, got %+v", contact)
+	}
+	if contact.Extra != nil {
+		t.Errorf("Expected no extra fields, got %v", contact.Extra)
+	}
+
+	out, err := json.Marshal(Contact{FirstName: "Ada", Extra: map[string]json.RawMessage{"FIRSTNAME": []byte(`"Bob"`)}})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(out, &fields); err != nil {
+		t.Fatalf("Unmarshal of output failed: %v", err)
+	}
+	if _, ok := fields["FIRSTNAME"]; ok || string(fields["firstName"]) != `"Ada"` {
+		t.Errorf("Expected firstName to be written once, got %s", out)
+	}
+}
@@ -0,0 +1,219 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormsService handles operations related to forms and their submissions
+type FormsService struct {
+	client *Client
+}
+
+// FormSubmission represents a submission of a form
+type FormSubmission struct {
+	ID        string `json:"id,omitempty"`
+	ContactID string `json:"contactId,omitempty"`
+	FormID    string `json:"formId,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	CreatedAt Time   `json:"createdAt,omitempty"`
+	// Others holds every answer keyed by field key or custom field ID, plus submission metadata
+	Others map[string]interface{} `json:"others,omitempty"`
+}
+
+// ListFormSubmissionsOptions represents query options for listing form submissions
+type ListFormSubmissionsOptions struct {
+	LocationID string
+	FormID     string // All forms when empty
+	Query      string // Matches contact name, email or phone
+	StartAt    time.Time
+	EndAt      time.Time
+	Page       int
+	Limit      int // At most 100 (default 20)
+}
+
+// FormSubmissionsResponse represents a list of form submissions API response
+type FormSubmissionsResponse struct {
+	Submissions []FormSubmission `json:"submissions,omitempty"`
+	Meta        *SubmissionsMeta `json:"meta,omitempty"`
+}
+
+// SubmissionsMeta represents the pagination metadata of form and survey submissions
+type SubmissionsMeta struct {
+	Total       int  `json:"total,omitempty"`
+	CurrentPage int  `json:"currentPage,omitempty"`
+	NextPage    *int `json:"nextPage,omitempty"`
+	PrevPage    *int `json:"prevPage,omitempty"`
+}
+
+// ListSubmissions retrieves a page of form submissions
+// Required scope: forms.readonly
+func (s *FormsService) ListSubmissions(opts *ListFormSubmissionsOptions) (*FormSubmissionsResponse, error) {
+	if opts == nil {
+		opts = &ListFormSubmissionsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := submissionsQuery(opts.LocationID, opts.Query, opts.StartAt, opts.EndAt, opts.Page, opts.Limit)
+	if opts.FormID != "" {
+		query.Set("formId", opts.FormID)
+	}
+
+	var result FormSubmissionsResponse
+	err := s.client.doRequest("GET", "/forms/submissions?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// submissionsQuery builds the query shared by the form and survey submission listings
+func submissionsQuery(locationID, q string, startAt, endAt time.Time, page, limit int) url.Values {
+	query := locationQuery(locationID)
+	if q != "" {
+		query.Set("q", q)
+	}
+	if !startAt.IsZero() {
+		query.Set("startAt", startAt.Format(time.DateOnly))
+	}
+	if !endAt.IsZero() {
+		query.Set("endAt", endAt.Format(time.DateOnly))
+	}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	return query
+}
+
+// submissionsPageSize is the page size used when walking every submission
+const submissionsPageSize = 100
+
+// eachSubmissionPage calls fn with every page of form submissions matching opts
+func (s *FormsService) eachSubmissionPage(ctx context.Context, opts *ListFormSubmissionsOptions, fn func([]FormSubmission) error) error {
+	pageOpts := *opts
+	pageOpts.Limit = submissionsPageSize
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pageOpts.Page = page
+		result, err := s.ListSubmissions(&pageOpts)
+		if err != nil {
+			return err
+		}
+		if err := fn(result.Submissions); err != nil {
+			return err
+		}
+		if len(result.Submissions) == 0 || result.Meta == nil || result.Meta.NextPage == nil {
+			return nil
+		}
+	}
+}
+
+// submissionColumns are the CSV columns written for every submission, before the answers
+var submissionColumns = []string{"id", "formId", "contactId", "name", "email", "createdAt"}
+
+// internalSubmissionKey reports whether a key of FormSubmission.Others is submission metadata
+// rather than an answer
+func internalSubmissionKey(key string) bool {
+	return strings.HasPrefix(key, "__") || key == "eventData" || key == "fieldsOriSequance"
+}
+
+// ExportSubmissions writes the submissions matching opts to w as CSV, one row per submission.
+// The columns are the submission's ID, form, contact, name, email and date, followed by one
+// column per answer: the given answer columns (field keys or custom field IDs) in order, or when
+// none are given, every answer key found in the submissions, sorted. Finding the keys takes an
+// extra pass over the submissions before any row is written, so pass columns to skip it.
+// Submissions are written page by page as they are fetched. Answers with several values are
+// joined with "; ", and structured answers such as file uploads are written as JSON.
+// Required scope: forms.readonly
+func (s *FormsService) ExportSubmissions(ctx context.Context, opts *ListFormSubmissionsOptions, w io.Writer, columns ...string) error {
+	if opts == nil {
+		opts = &ListFormSubmissionsOptions{}
+	}
+
+	if len(columns) == 0 {
+		keys := make(map[string]bool)
+		err := s.eachSubmissionPage(ctx, opts, func(submissions []FormSubmission) error {
+			for _, sub := range submissions {
+				for key := range sub.Others {
+					if !internalSubmissionKey(key) {
+						keys[key] = true
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for key := range keys {
+			columns = append(columns, key)
+		}
+		sort.Strings(columns)
+	}
+
+	out := csv.NewWriter(w)
+	if err := out.Write(append(append([]string{}, submissionColumns...), columns...)); err != nil {
+		return err
+	}
+
+	err := s.eachSubmissionPage(ctx, opts, func(submissions []FormSubmission) error {
+		for _, sub := range submissions {
+			row := []string{sub.ID, sub.FormID, sub.ContactID, sub.Name, sub.Email, sub.CreatedAt.iso()}
+			for _, column := range columns {
+				row = append(row, flattenAnswer(sub.Others[column]))
+			}
+			if err := out.Write(row); err != nil {
+				return err
+			}
+		}
+		out.Flush()
+		return out.Error()
+	})
+	if err != nil {
+		return err
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// flattenAnswer renders a submission answer as a single CSV cell
+func flattenAnswer(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = flattenAnswer(item)
+		}
+		return strings.Join(parts, "; ")
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
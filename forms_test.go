@@ -0,0 +1,65 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newFormSubmissionsClient serves two pages of submissions of form-1 for January 2026
+func newFormSubmissionsClient(t *testing.T) *Client {
+	return newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /forms/submissions": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("formId") != "form-1" || q.Get("startAt") != "2026-01-01" || q.Get("endAt") != "2026-01-31" {
+				t.Errorf("unexpected request %s", r.URL)
+			}
+			switch q.Get("page") {
+			case "1":
+				_, _ = w.Write([]byte(`{"submissions":[
+					{"id":"s1","formId":"form-1","contactId":"c1","name":"Ann","email":"ann@example.com","createdAt":"2026-01-02T10:00:00Z",
+					 "others":{"phone":"+15550100","interests":["Yoga","Pilates"],"eventData":{"source":"ads"}}}
+				],"meta":{"total":2,"currentPage":1,"nextPage":2}}`))
+			case "2":
+				_, _ = w.Write([]byte(`{"submissions":[
+					{"id":"s2","formId":"form-1","contactId":"c2","name":"Bo, Jr.","createdAt":"2026-01-03T10:00:00Z",
+					 "others":{"cf_abc123":42}}
+				],"meta":{"total":2,"currentPage":2,"nextPage":null}}`))
+			default:
+				t.Errorf("unexpected page %s", q.Get("page"))
+			}
+		},
+	})
+}
+
+func TestFormsService_ExportSubmissions(t *testing.T) {
+	client := newFormSubmissionsClient(t)
+	opts := &ListFormSubmissionsOptions{
+		LocationID: "loc-1",
+		FormID:     "form-1",
+		StartAt:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndAt:      time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	var out strings.Builder
+	if err := client.Forms.ExportSubmissions(context.Background(), opts, &out); err != nil {
+		t.Fatalf("ExportSubmissions failed: %v", err)
+	}
+	want := "id,formId,contactId,name,email,createdAt,cf_abc123,interests,phone\n" +
+		"s1,form-1,c1,Ann,ann@example.com,2026-01-02T10:00:00Z,,Yoga; Pilates,+15550100\n" +
+		"s2,form-1,c2,\"Bo, Jr.\",,2026-01-03T10:00:00Z,42,,\n"
+	if out.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", out.String(), want)
+	}
+
+	// Explicit columns skip key discovery and keep the given order
+	out.Reset()
+	if err := client.Forms.ExportSubmissions(context.Background(), opts, &out, "phone", "cf_abc123"); err != nil {
+		t.Fatalf("ExportSubmissions with columns failed: %v", err)
+	}
+	if header, _, _ := strings.Cut(out.String(), "\n"); header != "id,formId,contactId,name,email,createdAt,phone,cf_abc123" {
+		t.Errorf("header = %s", header)
+	}
+}
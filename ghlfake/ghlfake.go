@@ -0,0 +1,215 @@
+// Package ghlfake generates realistic, deterministic GoHighLevel model values for unit tests
+// and local development. A Generator created with the same seed always produces the same
+// sequence of values, so tests built on it are reproducible.
+//
+//	gen := ghlfake.New(42)
+//	contact := gen.Contact("location-id")
+package ghlfake
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+)
+
+// epoch is the fixed reference time generated timestamps are based on
+var epoch = time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+var (
+	firstNames = []string{"Ada", "Grace", "Alan", "Linus", "Margaret", "Dennis", "Barbara", "Ken", "Frances", "Edsger", "Radia", "Donald"}
+	lastNames  = []string{"Lovelace", "Hopper", "Turing", "Torvalds", "Hamilton", "Ritchie", "Liskov", "Thompson", "Allen", "Dijkstra", "Perlman", "Knuth"}
+	companies  = []string{"Acme Corp", "Globex", "Initech", "Umbrella Ltd", "Stark Industries", "Wayne Enterprises", "Hooli", "Vandelay Industries"}
+	domains    = []string{"example.com", "example.org", "example.net"}
+	cities     = []struct{ city, state, country, timezone string }{
+		{"Austin", "TX", "US", "America/Chicago"},
+		{"Denver", "CO", "US", "America/Denver"},
+		{"Seattle", "WA", "US", "America/Los_Angeles"},
+		{"Boston", "MA", "US", "America/New_York"},
+		{"Toronto", "ON", "CA", "America/Toronto"},
+		{"Cape Town", "WC", "ZA", "Africa/Johannesburg"},
+	}
+	streets  = []string{"Main St", "Oak Ave", "Maple Dr", "Cedar Ln", "Park Rd", "Elm St"}
+	sources  = []string{"website", "facebook", "google ads", "referral", "walk-in"}
+	tags     = []string{"lead", "customer", "vip", "newsletter", "webinar", "trial", "follow-up"}
+	products = []string{"Starter Plan", "Pro Plan", "Coaching Session", "Workshop Ticket", "E-book", "Consultation"}
+)
+
+// Generator produces deterministic fake values
+type Generator struct {
+	rnd *rand.Rand
+	seq int
+}
+
+// New returns a generator seeded with seed
+func New(seed int64) *Generator {
+	return &Generator{rnd: rand.New(rand.NewSource(seed))}
+}
+
+// pick returns a random element of values
+func (g *Generator) pick(values []string) string {
+	return values[g.rnd.Intn(len(values))]
+}
+
+// ID returns a unique 20 character alphanumeric ID in the style of GoHighLevel IDs
+func (g *Generator) ID() string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	g.seq++
+	id := make([]byte, 20)
+	for i := range id {
+		id[i] = alphabet[g.rnd.Intn(len(alphabet))]
+	}
+	// Encode the sequence number in the tail so IDs never collide
+	copy(id[14:], fmt.Sprintf("%06d", g.seq%1000000))
+	return string(id)
+}
+
+// Phone returns a phone number in E.164 format from the reserved 555-01xx range
+func (g *Generator) Phone() string {
+	return fmt.Sprintf("+1%03d55501%02d", 200+g.rnd.Intn(800), g.rnd.Intn(100))
+}
+
+// Time returns a timestamp within a year after a fixed reference date
+func (g *Generator) Time() time.Time {
+	return epoch.Add(time.Duration(g.rnd.Int63n(int64(365 * 24 * time.Hour)))).Truncate(time.Second)
+}
+
+// Contact returns a contact in the given location
+func (g *Generator) Contact(locationID string) ghl.Contact {
+	first, last := g.pick(firstNames), g.pick(lastNames)
+	place := cities[g.rnd.Intn(len(cities))]
+	added := g.Time()
+
+	contactType := ghl.ContactTypeLead
+	if g.rnd.Intn(3) == 0 {
+		contactType = ghl.ContactTypeCustomer
+	}
+
+	contactTags := []string{g.pick(tags)}
+	if extra := g.pick(tags); extra != contactTags[0] {
+		contactTags = append(contactTags, extra)
+	}
+
+	return ghl.Contact{
+		ID:          g.ID(),
+		LocationID:  locationID,
+		ContactName: strings.ToLower(first + " " + last),
+		FirstName:   first,
+		LastName:    last,
+		Email:       fmt.Sprintf("%s.%s%d@%s", strings.ToLower(first), strings.ToLower(last), g.rnd.Intn(100), g.pick(domains)),
+		Phone:       g.Phone(),
+		Type:        contactType,
+		Source:      g.pick(sources),
+		Address1:    fmt.Sprintf("%d %s", 1+g.rnd.Intn(9999), g.pick(streets)),
+		City:        place.city,
+		State:       place.state,
+		Country:     place.country,
+		PostalCode:  fmt.Sprintf("%05d", g.rnd.Intn(100000)),
+		CompanyName: g.pick(companies),
+		Timezone:    place.timezone,
+		Tags:        contactTags,
+		DateOfBirth: ghl.Date{Time: time.Date(1950+g.rnd.Intn(55), time.Month(1+g.rnd.Intn(12)), 1+g.rnd.Intn(28), 0, 0, 0, 0, time.UTC)},
+		DateAdded:   ghl.Time{Time: added},
+		DateUpdated: ghl.Time{Time: added.Add(time.Duration(g.rnd.Intn(30*24)) * time.Hour)},
+	}
+}
+
+// Contacts returns n contacts in the given location
+func (g *Generator) Contacts(locationID string, n int) []ghl.Contact {
+	contacts := make([]ghl.Contact, n)
+	for i := range contacts {
+		contacts[i] = g.Contact(locationID)
+	}
+	return contacts
+}
+
+// CreateContactRequest returns a request that passes client-side validation
+func (g *Generator) CreateContactRequest(locationID string) *ghl.CreateContactRequest {
+	c := g.Contact(locationID)
+	return &ghl.CreateContactRequest{
+		LocationID:  locationID,
+		FirstName:   c.FirstName,
+		LastName:    c.LastName,
+		Email:       c.Email,
+		Phone:       c.Phone,
+		Address1:    c.Address1,
+		City:        c.City,
+		State:       c.State,
+		Country:     c.Country,
+		PostalCode:  c.PostalCode,
+		CompanyName: c.CompanyName,
+		Source:      c.Source,
+		Tags:        c.Tags,
+	}
+}
+
+// Product returns a product in the given location
+func (g *Generator) Product(locationID string) ghl.Product {
+	name := g.pick(products)
+	created := g.Time()
+	return ghl.Product{
+		ID:               g.ID(),
+		LocationID:       locationID,
+		Name:             name,
+		Description:      "Fake " + strings.ToLower(name) + " for testing",
+		ProductType:      "DIGITAL",
+		AvailableInStore: g.rnd.Intn(2) == 0,
+		Slug:             strings.ReplaceAll(strings.ToLower(name), " ", "-"),
+		CreatedAt:        ghl.Time{Time: created},
+		UpdatedAt:        ghl.Time{Time: created},
+	}
+}
+
+// Invoice returns an invoice in the given location billed to contact
+func (g *Generator) Invoice(locationID string, contact ghl.Contact) ghl.Invoice {
+	items := make([]ghl.InvoiceItem, 1+g.rnd.Intn(3))
+	var total float64
+	for i := range items {
+		amount := float64(10+g.rnd.Intn(490)) + 0.99
+		qty := float64(1 + g.rnd.Intn(3))
+		items[i] = ghl.InvoiceItem{
+			Name:     g.pick(products),
+			Currency: "USD",
+			Amount:   amount,
+			Qty:      qty,
+			Type:     "one_time",
+		}
+		total += amount * qty
+	}
+	total = float64(int64(total*100+0.5)) / 100
+
+	status := []string{ghl.InvoiceStatusDraft, ghl.InvoiceStatusSent, ghl.InvoiceStatusPaid}[g.rnd.Intn(3)]
+	var paid float64
+	if status == ghl.InvoiceStatusPaid {
+		paid = total
+	}
+
+	issued := g.Time()
+	id := g.ID()
+	return ghl.Invoice{
+		ID:            id,
+		AltID:         locationID,
+		AltType:       "location",
+		Name:          fmt.Sprintf("Invoice for %s %s", contact.FirstName, contact.LastName),
+		Status:        status,
+		InvoiceNumber: fmt.Sprintf("INV-%05d", g.seq),
+		Currency:      "USD",
+		Items:         items,
+		ContactDetails: &ghl.InvoiceContactDetails{
+			ID:          contact.ID,
+			Name:        strings.TrimSpace(contact.FirstName + " " + contact.LastName),
+			Email:       contact.Email,
+			PhoneNo:     contact.Phone,
+			CompanyName: contact.CompanyName,
+		},
+		IssueDate:  ghl.Date{Time: issued.Truncate(24 * time.Hour)},
+		DueDate:    ghl.Date{Time: issued.AddDate(0, 0, 30).Truncate(24 * time.Hour)},
+		Total:      total,
+		AmountPaid: paid,
+		AmountDue:  total - paid,
+		CreatedAt:  ghl.Time{Time: issued},
+		UpdatedAt:  ghl.Time{Time: issued},
+	}
+}
@@ -0,0 +1,57 @@
+package ghlfake
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerator_Deterministic(t *testing.T) {
+	a, b := New(7), New(7)
+	for i := 0; i < 20; i++ {
+		if ca, cb := a.Contact("loc"), b.Contact("loc"); !reflect.DeepEqual(ca, cb) {
+			t.Fatalf("contact %d differs for the same seed:\n%+v\n%+v", i, ca, cb)
+		}
+	}
+
+	if New(1).Contact("loc").ID == New(2).Contact("loc").ID {
+		t.Error("different seeds produced the same contact ID")
+	}
+}
+
+func TestGenerator_UniqueIDs(t *testing.T) {
+	g := New(1)
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		id := g.ID()
+		if seen[id] {
+			t.Fatalf("duplicate ID %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestGenerator_ValidRequests(t *testing.T) {
+	g := New(3)
+	for i := 0; i < 50; i++ {
+		req := g.CreateContactRequest("loc")
+		if err := req.Validate(); err != nil {
+			t.Fatalf("generated request failed validation: %v", err)
+		}
+	}
+}
+
+func TestGenerator_Invoice(t *testing.T) {
+	g := New(5)
+	contact := g.Contact("loc")
+	invoice := g.Invoice("loc", contact)
+
+	if invoice.ContactDetails == nil || invoice.ContactDetails.ID != contact.ID {
+		t.Errorf("invoice not billed to contact: %+v", invoice.ContactDetails)
+	}
+	if len(invoice.Items) == 0 || invoice.Total <= 0 {
+		t.Errorf("invoice has no total: %+v", invoice)
+	}
+	if invoice.AmountPaid+invoice.AmountDue != invoice.Total {
+		t.Errorf("paid %v + due %v != total %v", invoice.AmountPaid, invoice.AmountDue, invoice.Total)
+	}
+}
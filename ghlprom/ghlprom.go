@@ -0,0 +1,140 @@
+// Package ghlprom exports GoHighLevel client metrics as Prometheus collectors.
+//
+// It lives in its own module so the SDK itself stays free of dependencies:
+//
+//	metrics := ghlprom.New(ghlprom.Options{Namespace: "myapp"})
+//	prometheus.MustRegister(metrics)
+//	client, err := ghl.NewClientWithOptions(ghl.WithAccessToken(token), ghl.WithMetrics(metrics))
+package ghlprom
+
+import (
+	"strconv"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Options configures the exported metrics
+type Options struct {
+	// Namespace and Subsystem prefix the metric names (default subsystem "gohighlevel")
+	Namespace string
+	Subsystem string
+	// Buckets are the request duration histogram buckets in seconds (default prometheus.DefBuckets)
+	Buckets []float64
+	// ConstLabels are added to every metric
+	ConstLabels prometheus.Labels
+}
+
+// Metrics implements ghl.MetricsHook and prometheus.Collector
+type Metrics struct {
+	requests       *prometheus.CounterVec
+	duration       *prometheus.HistogramVec
+	retries        *prometheus.CounterVec
+	tokenRefreshes *prometheus.CounterVec
+	remaining      *prometheus.GaugeVec
+	dailyRemaining *prometheus.GaugeVec
+}
+
+var _ ghl.MetricsHook = (*Metrics)(nil)
+var _ prometheus.Collector = (*Metrics)(nil)
+
+// New creates the collectors. Register the result with a prometheus.Registerer and pass it to
+// the client with ghl.WithMetrics or Config.Metrics.
+func New(opts Options) *Metrics {
+	if opts.Subsystem == "" {
+		opts.Subsystem = "gohighlevel"
+	}
+	if opts.Buckets == nil {
+		opts.Buckets = prometheus.DefBuckets
+	}
+
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "requests_total",
+			Help:        "API request attempts by method and status code (0 when no response was received).",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "request_duration_seconds",
+			Help:        "API request attempt latency.",
+			Buckets:     opts.Buckets,
+			ConstLabels: opts.ConstLabels,
+		}, []string{"method"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "retries_total",
+			Help:        "API request retries by method and the status code that caused them.",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"method", "code"}),
+		tokenRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "token_refreshes_total",
+			Help:        "Automatic OAuth token refreshes by result.",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"result"}),
+		remaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "rate_limit_remaining",
+			Help:        "Requests remaining in the current burst interval, as last reported by the API.",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"location"}),
+		dailyRemaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "rate_limit_daily_remaining",
+			Help:        "Requests remaining today, as last reported by the API.",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"location"}),
+	}
+}
+
+// ObserveRequest implements ghl.MetricsHook
+func (m *Metrics) ObserveRequest(info ghl.RequestInfo) {
+	m.requests.WithLabelValues(info.Method, strconv.Itoa(info.StatusCode)).Inc()
+	m.duration.WithLabelValues(info.Method).Observe(info.Duration.Seconds())
+}
+
+// ObserveRetry implements ghl.MetricsHook
+func (m *Metrics) ObserveRetry(info ghl.RequestInfo) {
+	m.retries.WithLabelValues(info.Method, strconv.Itoa(info.StatusCode)).Inc()
+}
+
+// ObserveTokenRefresh implements ghl.MetricsHook
+func (m *Metrics) ObserveTokenRefresh(err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	m.tokenRefreshes.WithLabelValues(result).Inc()
+}
+
+// ObserveRateLimit implements ghl.MetricsHook
+func (m *Metrics) ObserveRateLimit(locationID string, remaining, dailyRemaining int) {
+	m.remaining.WithLabelValues(locationID).Set(float64(remaining))
+	m.dailyRemaining.WithLabelValues(locationID).Set(float64(dailyRemaining))
+}
+
+// Describe implements prometheus.Collector
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range m.collectors() {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range m.collectors() {
+		c.Collect(ch)
+	}
+}
+
+func (m *Metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.requests, m.duration, m.retries, m.tokenRefreshes, m.remaining, m.dailyRemaining}
+}
@@ -0,0 +1,60 @@
+package ghlprom
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ghl "github.com/checkoutjoy/gohighlevel-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Header().Set("X-RateLimit-Daily-Remaining", "199999")
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	metrics := New(Options{Namespace: "test"})
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics)
+
+	client, err := ghl.NewClientWithOptions(
+		ghl.WithAccessToken("token"),
+		ghl.WithBaseURL(server.URL),
+		ghl.WithLocationID("loc1"),
+		ghl.WithMetrics(metrics),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	metrics.ObserveTokenRefresh(errors.New("invalid_grant"))
+
+	expected := `
+# HELP test_gohighlevel_rate_limit_remaining Requests remaining in the current burst interval, as last reported by the API.
+# TYPE test_gohighlevel_rate_limit_remaining gauge
+test_gohighlevel_rate_limit_remaining{location="loc1"} 99
+# HELP test_gohighlevel_requests_total API request attempts by method and status code (0 when no response was received).
+# TYPE test_gohighlevel_requests_total counter
+test_gohighlevel_requests_total{code="200",method="GET"} 1
+# HELP test_gohighlevel_token_refreshes_total Automatic OAuth token refreshes by result.
+# TYPE test_gohighlevel_token_refreshes_total counter
+test_gohighlevel_token_refreshes_total{result="error"} 1
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"test_gohighlevel_rate_limit_remaining", "test_gohighlevel_requests_total", "test_gohighlevel_token_refreshes_total")
+	if err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(metrics, "test_gohighlevel_request_duration_seconds"); n != 1 {
+		t.Errorf("duration series = %d, want 1", n)
+	}
+}
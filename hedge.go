@@ -0,0 +1,118 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HedgePolicy configures hedged requests: when a GET has not completed after Delay, the same
+// request is sent again and whichever response arrives first is used, the other is cancelled.
+// This trims tail latency for interactive lookups at the cost of some extra requests. Only GETs
+// are hedged, so writes are never duplicated. With AdaptiveConcurrency, a hedge is only sent
+// when a concurrency slot is free.
+type HedgePolicy struct {
+	// Delay before sending a hedge, e.g. the p95 latency of the API as observed with a
+	// RequestHook. Requests faster than this are never hedged.
+	Delay time.Duration
+	// MaxHedges is the number of extra requests sent per GET, each Delay after the previous
+	// one (default 1)
+	MaxHedges int
+}
+
+// hedgeOutcome is the outcome of one copy of a hedged request
+type hedgeOutcome struct {
+	statusCode int
+	header     http.Header
+	respBody   []byte
+	err        error
+}
+
+// usable reports whether the outcome can be returned without waiting for other copies
+func (o hedgeOutcome) usable() bool {
+	return o.err == nil && o.statusCode < 500
+}
+
+// executeHedged sends a GET and hedges it according to the hedge policy. Responses are read in
+// full before one is decoded into result, so copies never decode into result concurrently.
+func (c *Client) executeHedged(ctx context.Context, method, path string, result interface{}) (int, http.Header, []byte, error) {
+	maxHedges := c.hedging.MaxHedges
+	if maxHedges <= 0 {
+		maxHedges = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so copies that lose the race can finish without blocking
+	outcomes := make(chan hedgeOutcome, maxHedges+1)
+	send := func(hedge bool) bool {
+		// The first copy holds the slot sendRequest acquired. A hedge needs a slot of its own
+		// and is skipped when none is free, so hedging never exceeds the concurrency limit.
+		acquired := hedge && c.concurrency != nil
+		if acquired && !c.concurrency.tryAcquire() {
+			return false
+		}
+		go func() {
+			var o hedgeOutcome
+			if hedge && c.rateLimiter != nil {
+				o.err = c.rateLimiter.Wait(ctx)
+			}
+			if o.err == nil {
+				o.statusCode, o.header, o.respBody, o.err = c.executeRequest(ctx, method, path, nil, nil)
+			}
+			if acquired {
+				if ctx.Err() != nil {
+					// Cancelled because another copy won, which says nothing about load
+					c.concurrency.releaseCancelled()
+				} else {
+					c.concurrency.release(isOverloaded(o.statusCode, o.err))
+				}
+			}
+			outcomes <- o
+		}()
+		return true
+	}
+
+	send(false)
+	inFlight, hedges := 1, 0
+	timer := time.NewTimer(c.hedging.Delay)
+	defer timer.Stop()
+
+	var last hedgeOutcome
+	for {
+		select {
+		case <-timer.C:
+			if hedges < maxHedges {
+				if send(true) {
+					inFlight++
+					hedges++
+				}
+				timer.Reset(c.hedging.Delay)
+			}
+		case last = <-outcomes:
+			inFlight--
+			if !last.usable() && inFlight > 0 {
+				// Another copy may still succeed
+				continue
+			}
+			return decodeHedged(last, result)
+		}
+	}
+}
+
+// decodeHedged decodes the body of a successful hedged response into result, mirroring how
+// executeRequest handles a response
+func decodeHedged(o hedgeOutcome, result interface{}) (int, http.Header, []byte, error) {
+	if o.err != nil || result == nil || o.statusCode < 200 || o.statusCode >= 300 {
+		return o.statusCode, o.header, o.respBody, o.err
+	}
+	if len(o.respBody) > 0 {
+		if err := json.Unmarshal(o.respBody, result); err != nil {
+			return o.statusCode, o.header, nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+	return o.statusCode, o.header, nil, nil
+}
@@ -0,0 +1,98 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newSlowFirstClient serves contact c1, delaying the first request it receives by slow and
+// answering the rest at once
+func newSlowFirstClient(t *testing.T, config Config, slow time.Duration) (*Client, *int32) {
+	var requests int32
+	client := newTestClient(t, config, map[string]http.HandlerFunc{
+		"/contacts/": func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				select {
+				case <-time.After(slow):
+				case <-r.Context().Done():
+					return
+				}
+			}
+			_, _ = w.Write([]byte(`{"contact":{"id":"c1","firstName":"Ada"}}`))
+		},
+	})
+	return client, &requests
+}
+
+func TestHedging_SlowGet(t *testing.T) {
+	client, requests := newSlowFirstClient(t, Config{Hedging: &HedgePolicy{Delay: 20 * time.Millisecond}}, 2*time.Second)
+
+	start := time.Now()
+	contact, err := client.Contacts.Get("c1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Get took %v, the hedge should have answered", elapsed)
+	}
+	if contact.FirstName != "Ada" {
+		t.Errorf("contact = %+v", contact)
+	}
+	if n := atomic.LoadInt32(requests); n != 2 {
+		t.Errorf("server received %d requests, want 2", n)
+	}
+}
+
+func TestHedging_FastGetNotHedged(t *testing.T) {
+	client, requests := newSlowFirstClient(t, Config{Hedging: &HedgePolicy{Delay: time.Second}}, 0)
+
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("server received %d requests, want 1", n)
+	}
+}
+
+func TestHedging_WritesNotHedged(t *testing.T) {
+	client, requests := newSlowFirstClient(t, Config{Hedging: &HedgePolicy{Delay: 10 * time.Millisecond}}, 100*time.Millisecond)
+
+	if _, err := client.Contacts.Create(&CreateContactRequest{LocationID: "loc", FirstName: "Ada"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("server received %d requests for a POST, want 1", n)
+	}
+}
+
+func TestHedging_RespectsConcurrency(t *testing.T) {
+	full := NewAdaptiveConcurrency(1, 1)
+	client, requests := newSlowFirstClient(t, Config{Concurrency: full, Hedging: &HedgePolicy{Delay: 10 * time.Millisecond}}, 100*time.Millisecond)
+
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("server received %d requests with no free slot, want 1", n)
+	}
+
+	free := NewAdaptiveConcurrency(2, 2)
+	client, requests2 := newSlowFirstClient(t, Config{Concurrency: free, Hedging: &HedgePolicy{Delay: 10 * time.Millisecond}}, 2*time.Second)
+
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if n := atomic.LoadInt32(requests2); n != 2 {
+		t.Errorf("server received %d requests with a free slot, want 2", n)
+	}
+	// The cancelled copy gives its slot back without lowering the limit
+	deadline := time.Now().Add(time.Second)
+	for free.InFlight() != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if free.InFlight() != 0 || free.Limit() != 2 {
+		t.Errorf("in flight %d, limit %d after hedging, want 0 and 2", free.InFlight(), free.Limit())
+	}
+}
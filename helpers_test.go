@@ -0,0 +1,42 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestClient starts a server with handlers, keyed by http.ServeMux patterns such as
+// "GET /contacts/{contactId}", and returns a client sending requests to it. config is used as
+// is except for BaseURL, and AccessToken when empty. Requests matching no pattern fail the test.
+// The server is closed when the test ends.
+func newTestClient(t *testing.T, config Config, handlers map[string]http.HandlerFunc) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for pattern, handler := range handlers {
+		mux.HandleFunc(pattern, handler)
+	}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	if config.AccessToken == "" {
+		config.AccessToken = "token"
+	}
+	config.BaseURL = server.URL
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	return client
+}
+
+// writeJSON writes v as the JSON body of a test response
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,109 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// InstalledLocation represents a location of an agency and whether the app is installed in it
+type InstalledLocation struct {
+	ID          string                 `json:"_id,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Address     string                 `json:"address,omitempty"`
+	IsInstalled bool                   `json:"isInstalled"`
+	Trial       map[string]interface{} `json:"trial,omitempty"`
+}
+
+// GetInstalledLocationsOptions represents query options for listing installed locations
+type GetInstalledLocationsOptions struct {
+	AppID       string // Required
+	IsInstalled *bool  // nil lists all locations; true or false filters by installation state
+	Query       string // Matches location name
+	VersionID   string
+	OnTrial     *bool
+	PlanID      string
+	Limit       int
+	Skip        int
+}
+
+// InstalledLocationsResponse represents a list of installed locations API response
+type InstalledLocationsResponse struct {
+	Locations                []InstalledLocation `json:"locations,omitempty"`
+	Count                    int                 `json:"count,omitempty"`
+	InstallToFutureLocations bool                `json:"installToFutureLocations,omitempty"`
+}
+
+// GetInstalledLocations lists the locations of an agency together with whether the app is
+// installed in them. Use Limit/Skip to page through large agencies.
+// This must be called with an agency (company) access token.
+// Required scope: oauth.readonly
+func (c *Client) GetInstalledLocations(companyID string, opts *GetInstalledLocationsOptions) (*InstalledLocationsResponse, error) {
+	if companyID == "" {
+		return nil, fmt.Errorf("companyId is required")
+	}
+	if opts == nil || opts.AppID == "" {
+		return nil, fmt.Errorf("appId is required")
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	query := url.Values{}
+	query.Set("companyId", companyID)
+	query.Set("appId", opts.AppID)
+	query.Set("limit", fmt.Sprintf("%d", limit))
+	query.Set("skip", fmt.Sprintf("%d", opts.Skip))
+	if opts.IsInstalled != nil {
+		query.Set("isInstalled", fmt.Sprintf("%t", *opts.IsInstalled))
+	}
+	if opts.Query != "" {
+		query.Set("query", opts.Query)
+	}
+	if opts.VersionID != "" {
+		query.Set("versionId", opts.VersionID)
+	}
+	if opts.OnTrial != nil {
+		query.Set("onTrial", fmt.Sprintf("%t", *opts.OnTrial))
+	}
+	if opts.PlanID != "" {
+		query.Set("planId", opts.PlanID)
+	}
+
+	var result InstalledLocationsResponse
+	err := c.doRequest("GET", "/oauth/installedLocations?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetAllInstalledLocations pages through GetInstalledLocations and returns every matching location
+// Required scope: oauth.readonly
+func (c *Client) GetAllInstalledLocations(companyID string, opts *GetInstalledLocationsOptions) ([]InstalledLocation, error) {
+	if opts == nil {
+		opts = &GetInstalledLocationsOptions{}
+	}
+
+	page := *opts
+	if page.Limit <= 0 {
+		page.Limit = 100
+	}
+
+	var locations []InstalledLocation
+	for {
+		result, err := c.GetInstalledLocations(companyID, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, result.Locations...)
+		page.Skip += len(result.Locations)
+
+		if len(result.Locations) < page.Limit || (result.Count > 0 && page.Skip >= result.Count) {
+			return locations, nil
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestClient_GetInstalledLocations(t *testing.T) {
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /oauth/installedLocations": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("companyId") != "comp-1" || q.Get("appId") != "app-1" || q.Get("limit") != "20" || q.Get("skip") != "0" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			if q.Get("isInstalled") != "true" || q.Get("onTrial") != "false" || q.Get("query") != "acme" || q.Has("planId") {
+				t.Errorf("unexpected filters %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"locations":[{"_id":"loc-1","name":"Acme","isInstalled":true}],"count":1}`))
+		},
+	})
+
+	installed, onTrial := true, false
+	result, err := client.GetInstalledLocations("comp-1", &GetInstalledLocationsOptions{
+		AppID:       "app-1",
+		IsInstalled: &installed,
+		OnTrial:     &onTrial,
+		Query:       "acme",
+	})
+	if err != nil {
+		t.Fatalf("GetInstalledLocations failed: %v", err)
+	}
+	if result.Count != 1 || len(result.Locations) != 1 || !result.Locations[0].IsInstalled {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	if _, err := client.GetInstalledLocations("", &GetInstalledLocationsOptions{AppID: "app-1"}); err == nil {
+		t.Error("Expected error for missing companyId")
+	}
+	if _, err := client.GetInstalledLocations("comp-1", nil); err == nil {
+		t.Error("Expected error for missing appId")
+	}
+}
+
+func TestClient_GetAllInstalledLocations(t *testing.T) {
+	const total = 250
+	var skips []string
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /oauth/installedLocations": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("limit") != "100" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			skips = append(skips, q.Get("skip"))
+			skip, _ := strconv.Atoi(q.Get("skip"))
+			var locations []InstalledLocation
+			for i := skip; i < min(skip+100, total); i++ {
+				locations = append(locations, InstalledLocation{ID: fmt.Sprintf("loc-%d", i)})
+			}
+			writeJSON(w, InstalledLocationsResponse{Locations: locations, Count: total})
+		},
+	})
+
+	opts := &GetInstalledLocationsOptions{AppID: "app-1"}
+	locations, err := client.GetAllInstalledLocations("comp-1", opts)
+	if err != nil {
+		t.Fatalf("GetAllInstalledLocations failed: %v", err)
+	}
+	if len(locations) != total || locations[total-1].ID != "loc-249" {
+		t.Errorf("Expected %d locations, got %d", total, len(locations))
+	}
+	if len(skips) != 3 || skips[1] != "100" || skips[2] != "200" {
+		t.Errorf("Unexpected pages: %v", skips)
+	}
+	if opts.Limit != 0 || opts.Skip != 0 {
+		t.Errorf("GetAllInstalledLocations modified the caller's options: %+v", opts)
+	}
+}
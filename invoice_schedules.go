@@ -0,0 +1,76 @@
+package gohighlevel
+
+import "fmt"
+
+// InvoiceSchedule represents a recurring invoice schedule
+type InvoiceSchedule struct {
+	ID          string              `json:"_id,omitempty"`
+	AltID       string              `json:"altId,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	Status      string              `json:"status,omitempty"`
+	LiveMode    bool                `json:"liveMode,omitempty"`
+	Currency    string              `json:"currency,omitempty"`
+	Total       float64             `json:"total,omitempty"`
+	AutoPayment *InvoiceAutoPayment `json:"autoPayment,omitempty"`
+	CreatedAt   Time                `json:"createdAt,omitempty"`
+	UpdatedAt   Time                `json:"updatedAt,omitempty"`
+}
+
+// InvoiceAutoPayment configures charging a saved payment method for each invoice of a schedule
+type InvoiceAutoPayment struct {
+	Enable          bool                `json:"enable"`
+	Type            string              `json:"type,omitempty"`            // e.g. "card" or "us_bank_account"
+	PaymentMethodID string              `json:"paymentMethodId,omitempty"` // Saved payment method of the customer
+	CustomerID      string              `json:"customerId,omitempty"`      // Payment provider customer ID
+	Card            *AutoPaymentCard    `json:"card,omitempty"`
+	USBankAccount   *AutoPaymentAccount `json:"usBankAccount,omitempty"`
+}
+
+// AutoPaymentCard describes the saved card charged by auto-payment
+type AutoPaymentCard struct {
+	Brand string `json:"brand,omitempty"`
+	Last4 string `json:"last4,omitempty"`
+}
+
+// AutoPaymentAccount describes the saved US bank account charged by auto-payment
+type AutoPaymentAccount struct {
+	BankName string `json:"bank_name,omitempty"`
+	Last4    string `json:"last4,omitempty"`
+}
+
+// scheduleAutoPaymentRequest represents a request to change the auto-payment of a schedule
+type scheduleAutoPaymentRequest struct {
+	AltID       string              `json:"altId"`
+	AltType     string              `json:"altType"`
+	ID          string              `json:"id"`
+	AutoPayment *InvoiceAutoPayment `json:"autoPayment"`
+}
+
+// SetScheduleAutoPayment enables or disables charging a saved payment method for every invoice
+// of a recurring schedule, so billing runs unattended once the customer's card is on file
+// Required scope: invoices/schedule.write
+func (s *InvoicesService) SetScheduleAutoPayment(locationID, scheduleID string, autoPayment *InvoiceAutoPayment) (*InvoiceSchedule, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if scheduleID == "" {
+		return nil, fmt.Errorf("scheduleId is required")
+	}
+	if autoPayment == nil {
+		return nil, fmt.Errorf("autoPayment is required")
+	}
+	if autoPayment.Enable && autoPayment.PaymentMethodID == "" {
+		return nil, fmt.Errorf("paymentMethodId is required to enable auto-payment")
+	}
+
+	req := &scheduleAutoPaymentRequest{AltID: locationID, AltType: "location", ID: scheduleID, AutoPayment: autoPayment}
+
+	var result InvoiceSchedule
+	err := s.client.doRequest("POST", fmt.Sprintf("/invoices/schedule/%s/auto-payment", scheduleID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,682 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// InvoicesService handles operations related to invoices
+type InvoicesService struct {
+	client *Client
+}
+
+// Invoice represents a GoHighLevel invoice
+type Invoice struct {
+	ID              string                  `json:"_id,omitempty"`
+	AltID           string                  `json:"altId,omitempty"`
+	AltType         string                  `json:"altType,omitempty"`
+	Name            string                  `json:"name,omitempty"`
+	Title           string                  `json:"title,omitempty"`
+	Status          string                  `json:"status,omitempty"`
+	LiveMode        bool                    `json:"liveMode,omitempty"`
+	InvoiceNumber   string                  `json:"invoiceNumber,omitempty"`
+	Currency        string                  `json:"currency,omitempty"`
+	Items           []InvoiceItem           `json:"invoiceItems,omitempty"`
+	ContactDetails  *InvoiceContactDetails  `json:"contactDetails,omitempty"`
+	BusinessDetails *InvoiceBusinessDetails `json:"businessDetails,omitempty"`
+	Discount        *InvoiceDiscount        `json:"discount,omitempty"`
+	TermsNotes      string                  `json:"termsNotes,omitempty"`
+	SentTo          *InvoiceSentTo          `json:"sentTo,omitempty"`
+	IssueDate       Date                    `json:"issueDate,omitempty"`
+	DueDate         Date                    `json:"dueDate,omitempty"`
+	Total           float64                 `json:"total,omitempty"`
+	AmountPaid      float64                 `json:"amountPaid,omitempty"`
+	AmountDue       float64                 `json:"amountDue,omitempty"`
+	CreatedAt       Time                    `json:"createdAt,omitempty"`
+	UpdatedAt       Time                    `json:"updatedAt,omitempty"`
+	// Extra holds fields returned by the API that this struct does not declare yet,
+	// so they survive a decode/encode round-trip
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InvoiceItem represents a line item on an invoice
+type InvoiceItem struct {
+	ID          string       `json:"_id,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	ProductID   string       `json:"productId,omitempty"`
+	PriceID     string       `json:"priceId,omitempty"`
+	Currency    string       `json:"currency"`
+	Amount      float64      `json:"amount"`
+	Qty         float64      `json:"qty"`
+	Type        string       `json:"type,omitempty"` // "one_time" or "recurring"
+	Taxes       []InvoiceTax `json:"taxes,omitempty"`
+}
+
+// InvoiceTax represents a tax applied to an invoice line item
+type InvoiceTax struct {
+	ID          string  `json:"_id"`
+	Name        string  `json:"name"`
+	Rate        float64 `json:"rate"`
+	Calculation string  `json:"calculation,omitempty"` // "exclusive"
+	Description string  `json:"description,omitempty"`
+	TaxID       string  `json:"taxId,omitempty"`
+}
+
+// InvoiceDiscount represents a discount applied to an invoice
+type InvoiceDiscount struct {
+	Type              string   `json:"type"` // "percentage" or "fixed"
+	Value             float64  `json:"value"`
+	ValidOnProductIDs []string `json:"validOnProductIds,omitempty"`
+}
+
+// InvoiceAddress represents a postal address on an invoice
+type InvoiceAddress struct {
+	AddressLine1 string `json:"addressLine1,omitempty"`
+	AddressLine2 string `json:"addressLine2,omitempty"`
+	City         string `json:"city,omitempty"`
+	State        string `json:"state,omitempty"`
+	CountryCode  string `json:"countryCode,omitempty"`
+	PostalCode   string `json:"postalCode,omitempty"`
+}
+
+// InvoiceBusinessDetails represents the issuing business shown on an invoice
+type InvoiceBusinessDetails struct {
+	Name         string          `json:"name,omitempty"`
+	LogoURL      string          `json:"logoUrl,omitempty"`
+	PhoneNo      string          `json:"phoneNo,omitempty"`
+	Website      string          `json:"website,omitempty"`
+	Address      *InvoiceAddress `json:"address,omitempty"`
+	CustomValues []string        `json:"customValues,omitempty"`
+}
+
+// InvoiceContactDetails represents the contact an invoice is addressed to
+type InvoiceContactDetails struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	PhoneNo     string          `json:"phoneNo,omitempty"`
+	Email       string          `json:"email,omitempty"`
+	CompanyName string          `json:"companyName,omitempty"`
+	Address     *InvoiceAddress `json:"address,omitempty"`
+}
+
+// InvoiceSentTo represents the recipients of an invoice
+type InvoiceSentTo struct {
+	Email   []string `json:"email,omitempty"`
+	PhoneNo []string `json:"phoneNo,omitempty"`
+}
+
+// CreateInvoiceRequest represents a request to create an invoice
+type CreateInvoiceRequest struct {
+	LocationID      string                  `json:"altId"`
+	AltType         string                  `json:"altType"` // Defaults to "location"
+	Name            string                  `json:"name"`
+	Title           string                  `json:"title,omitempty"`
+	Currency        string                  `json:"currency"`
+	Items           []InvoiceItem           `json:"items"`
+	Discount        *InvoiceDiscount        `json:"discount,omitempty"`
+	BusinessDetails *InvoiceBusinessDetails `json:"businessDetails,omitempty"`
+	ContactDetails  *InvoiceContactDetails  `json:"contactDetails"`
+	InvoiceNumber   string                  `json:"invoiceNumber,omitempty"`
+	IssueDate       string                  `json:"issueDate"` // YYYY-MM-DD
+	DueDate         string                  `json:"dueDate,omitempty"`
+	TermsNotes      string                  `json:"termsNotes,omitempty"`
+	SentTo          *InvoiceSentTo          `json:"sentTo,omitempty"`
+	LiveMode        bool                    `json:"liveMode"`
+}
+
+// UpdateInvoiceRequest represents a request to update a draft invoice
+type UpdateInvoiceRequest struct {
+	LocationID      string                  `json:"altId"`
+	AltType         string                  `json:"altType"` // Defaults to "location"
+	Name            string                  `json:"name"`
+	Title           string                  `json:"title,omitempty"`
+	Currency        string                  `json:"currency"`
+	Items           []InvoiceItem           `json:"items"`
+	Discount        *InvoiceDiscount        `json:"discount,omitempty"`
+	BusinessDetails *InvoiceBusinessDetails `json:"businessDetails,omitempty"`
+	ContactDetails  *InvoiceContactDetails  `json:"contactDetails,omitempty"`
+	InvoiceNumber   string                  `json:"invoiceNumber,omitempty"`
+	IssueDate       string                  `json:"issueDate,omitempty"`
+	DueDate         string                  `json:"dueDate,omitempty"`
+	TermsNotes      string                  `json:"termsNotes,omitempty"`
+	LiveMode        bool                    `json:"liveMode"`
+}
+
+// ListInvoicesOptions represents query options for listing invoices
+type ListInvoicesOptions struct {
+	LocationID  string
+	Status      string // "draft", "sent", "payment_processing", "paid", "void" or "partially_paid"
+	ContactID   string
+	Search      string
+	PaymentMode string // "live" or "test"
+	StartAt     string // YYYY-MM-DD
+	EndAt       string // YYYY-MM-DD
+	SortField   string
+	SortOrder   string // "ascend" or "descend"
+	Limit       int
+	Offset      int
+}
+
+// InvoicesResponse represents a list of invoices API response
+type InvoicesResponse struct {
+	Invoices []Invoice `json:"invoices,omitempty"`
+	Total    int       `json:"total,omitempty"`
+}
+
+// SendInvoiceRequest represents a request to send an invoice to its contact
+type SendInvoiceRequest struct {
+	LocationID string `json:"altId"`
+	AltType    string `json:"altType"` // Defaults to "location"
+	UserID     string `json:"userId"`
+	Action     string `json:"action"` // "email", "sms", "sms_and_email" or "send_manually"
+	LiveMode   bool   `json:"liveMode"`
+}
+
+// SendInvoiceResponse represents the response to sending an invoice
+type SendInvoiceResponse struct {
+	Invoice   *Invoice               `json:"invoice,omitempty"`
+	SMSData   map[string]interface{} `json:"smsData,omitempty"`
+	EmailData map[string]interface{} `json:"emailData,omitempty"`
+}
+
+// RecordPaymentRequest represents a request to record a manual (offline) payment against an invoice
+type RecordPaymentRequest struct {
+	LocationID string                 `json:"altId"`
+	AltType    string                 `json:"altType"` // Defaults to "location"
+	Mode       string                 `json:"mode"`    // "cash", "card", "cheque", "bank_transfer" or "other"
+	Amount     float64                `json:"amount,omitempty"`
+	Notes      string                 `json:"notes,omitempty"`
+	Card       *RecordPaymentCard     `json:"card,omitempty"`
+	Cheque     *RecordPaymentCheque   `json:"cheque,omitempty"`
+	Meta       map[string]interface{} `json:"meta,omitempty"`
+}
+
+// RecordPaymentCard holds card details for a manually recorded card payment
+type RecordPaymentCard struct {
+	Brand string `json:"brand"`
+	Last4 string `json:"last4"`
+}
+
+// RecordPaymentCheque holds cheque details for a manually recorded cheque payment
+type RecordPaymentCheque struct {
+	Number string `json:"number"`
+}
+
+// RecordPaymentResponse represents the response to recording a payment
+type RecordPaymentResponse struct {
+	Success bool     `json:"success,omitempty"`
+	Invoice *Invoice `json:"invoice,omitempty"`
+}
+
+// invoiceActionRequest identifies the location an invoice action is performed for
+type invoiceActionRequest struct {
+	AltID   string `json:"altId"`
+	AltType string `json:"altType"`
+}
+
+// createInvoiceFromEstimateRequest represents a request to convert an estimate into an invoice
+type createInvoiceFromEstimateRequest struct {
+	AltID          string `json:"altId"`
+	AltType        string `json:"altType"`
+	MarkAsInvoiced bool   `json:"markAsInvoiced"`
+}
+
+// createInvoiceFromEstimateResponse represents the response to converting an estimate
+type createInvoiceFromEstimateResponse struct {
+	Invoice *Invoice `json:"invoice,omitempty"`
+}
+
+// InvoiceSettings represents the invoicing defaults of a location
+type InvoiceSettings struct {
+	InvoiceNumberPrefix string                  `json:"invoiceNumberPrefix,omitempty"`
+	NextInvoiceNumber   int                     `json:"nextInvoiceNumber,omitempty"`
+	Title               string                  `json:"title,omitempty"`
+	TermsNotes          string                  `json:"termsNotes,omitempty"`
+	DueAfterDays        int                     `json:"dueAfterDays,omitempty"`
+	Currency            string                  `json:"currency,omitempty"`
+	BusinessDetails     *InvoiceBusinessDetails `json:"businessDetails,omitempty"`
+}
+
+// updateInvoiceSettingsRequest represents a request to update the invoicing defaults of a location
+type updateInvoiceSettingsRequest struct {
+	AltID   string `json:"altId"`
+	AltType string `json:"altType"`
+	*InvoiceSettings
+}
+
+// invoiceNumberResponse represents the response of the invoice number generator
+type invoiceNumberResponse struct {
+	InvoiceNumber interface{} `json:"invoiceNumber,omitempty"`
+}
+
+// Text2PayRequest represents a request to create (or update) an invoice and generate its payment link
+type Text2PayRequest struct {
+	LocationID     string                 `json:"altId"`
+	AltType        string                 `json:"altType"`      // Defaults to "location"
+	InvoiceID      string                 `json:"id,omitempty"` // Set to generate a link for an existing invoice
+	Name           string                 `json:"name"`
+	Title          string                 `json:"title,omitempty"`
+	Currency       string                 `json:"currency"`
+	Items          []InvoiceItem          `json:"items"`
+	ContactDetails *InvoiceContactDetails `json:"contactDetails"`
+	InvoiceNumber  string                 `json:"invoiceNumber,omitempty"`
+	IssueDate      string                 `json:"issueDate"` // YYYY-MM-DD, defaults to today
+	DueDate        string                 `json:"dueDate,omitempty"`
+	TermsNotes     string                 `json:"termsNotes,omitempty"`
+	SentTo         *InvoiceSentTo         `json:"sentTo,omitempty"`
+	LiveMode       bool                   `json:"liveMode"`
+	Action         string                 `json:"action"` // "draft" to only generate the link, "send" to also send it; defaults to "draft"
+	UserID         string                 `json:"userId,omitempty"`
+}
+
+// Text2PayResponse represents the invoice and payment link returned by Text2Pay
+type Text2PayResponse struct {
+	Invoice    *Invoice `json:"invoice,omitempty"`
+	InvoiceURL string   `json:"invoiceUrl,omitempty"`
+}
+
+// Text2Pay creates or updates an invoice and returns the URL the contact can use to pay it
+// Required scope: invoices.write
+func (s *InvoicesService) Text2Pay(req *Text2PayRequest) (*Text2PayResponse, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.ContactDetails == nil || req.ContactDetails.ID == "" {
+		return nil, fmt.Errorf("contactDetails with a contact id is required")
+	}
+	if req.InvoiceID == "" && len(req.Items) == 0 {
+		return nil, fmt.Errorf("invoiceId or at least one item is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+	if req.Action == "" {
+		req.Action = "draft"
+	}
+	if req.IssueDate == "" {
+		req.IssueDate = time.Now().Format("2006-01-02")
+	}
+	if req.Currency == "" && len(req.Items) > 0 {
+		req.Currency = req.Items[0].Currency
+	}
+
+	var result Text2PayResponse
+	err := s.client.doRequest("POST", "/invoices/text2pay", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// List retrieves invoices for a location with optional filters
+// Required scope: invoices.readonly
+func (s *InvoicesService) List(opts *ListInvoicesOptions) (*InvoicesResponse, error) {
+	if opts == nil {
+		opts = &ListInvoicesOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := altLocationQuery(opts.LocationID)
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if opts.ContactID != "" {
+		query.Set("contactId", opts.ContactID)
+	}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if opts.PaymentMode != "" {
+		query.Set("paymentMode", opts.PaymentMode)
+	}
+	if opts.StartAt != "" {
+		query.Set("startAt", opts.StartAt)
+	}
+	if opts.EndAt != "" {
+		query.Set("endAt", opts.EndAt)
+	}
+	if opts.SortField != "" {
+		query.Set("sortField", opts.SortField)
+	}
+	if opts.SortOrder != "" {
+		query.Set("sortOrder", opts.SortOrder)
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	query.Set("limit", fmt.Sprintf("%d", limit))
+	query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+
+	var result InvoicesResponse
+	err := s.client.doRequest("GET", "/invoices/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves an invoice by ID
+// Required scope: invoices.readonly
+func (s *InvoicesService) Get(locationID, invoiceID string) (*Invoice, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if invoiceID == "" {
+		return nil, fmt.Errorf("invoiceId is required")
+	}
+
+	var result Invoice
+	err := s.client.doRequest("GET", fmt.Sprintf("/invoices/%s?%s", invoiceID, altLocationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates a new draft invoice
+// Required scope: invoices.write
+func (s *InvoicesService) Create(req *CreateInvoiceRequest) (*Invoice, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.ContactDetails == nil || req.ContactDetails.ID == "" {
+		return nil, fmt.Errorf("contactDetails with a contact id is required")
+	}
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("at least one item is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+	if req.IssueDate == "" {
+		req.IssueDate = time.Now().Format("2006-01-02")
+	}
+	if req.Currency == "" {
+		req.Currency = req.Items[0].Currency
+	}
+
+	var result Invoice
+	err := s.client.doRequest("POST", "/invoices/", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update updates a draft invoice
+// Required scope: invoices.write
+func (s *InvoicesService) Update(invoiceID string, req *UpdateInvoiceRequest) (*Invoice, error) {
+	if invoiceID == "" {
+		return nil, fmt.Errorf("invoiceId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+
+	var result Invoice
+	err := s.client.doRequest("PUT", fmt.Sprintf("/invoices/%s", invoiceID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes an invoice
+// Required scope: invoices.write
+func (s *InvoicesService) Delete(locationID, invoiceID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if invoiceID == "" {
+		return fmt.Errorf("invoiceId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/invoices/%s?%s", invoiceID, altLocationQuery(locationID).Encode()), nil, nil)
+}
+
+// Send sends an invoice to its contact by email and/or SMS
+// Required scope: invoices.write
+func (s *InvoicesService) Send(invoiceID string, req *SendInvoiceRequest) (*SendInvoiceResponse, error) {
+	if invoiceID == "" {
+		return nil, fmt.Errorf("invoiceId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.UserID == "" {
+		return nil, fmt.Errorf("userId is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+	if req.Action == "" {
+		req.Action = "sms_and_email"
+	}
+
+	var result SendInvoiceResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/invoices/%s/send", invoiceID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Void voids an invoice so it can no longer be paid
+// Required scope: invoices.write
+func (s *InvoicesService) Void(locationID, invoiceID string) (*Invoice, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if invoiceID == "" {
+		return nil, fmt.Errorf("invoiceId is required")
+	}
+
+	req := &invoiceActionRequest{AltID: locationID, AltType: "location"}
+
+	var result Invoice
+	err := s.client.doRequest("POST", fmt.Sprintf("/invoices/%s/void", invoiceID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RecordPayment records a manual payment against an invoice.
+// If Amount is zero the API records a payment for the full amount due.
+// Required scope: invoices.write
+func (s *InvoicesService) RecordPayment(invoiceID string, req *RecordPaymentRequest) (*RecordPaymentResponse, error) {
+	if invoiceID == "" {
+		return nil, fmt.Errorf("invoiceId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Mode == "" {
+		return nil, fmt.Errorf("mode is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+
+	var result RecordPaymentResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/invoices/%s/record-payment", invoiceID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MarkPaid records a manual payment for the outstanding balance of an invoice
+// Required scope: invoices.write
+func (s *InvoicesService) MarkPaid(locationID, invoiceID, mode, notes string) (*Invoice, error) {
+	invoice, err := s.Get(locationID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.AmountDue <= 0 {
+		return invoice, nil
+	}
+
+	result, err := s.RecordPayment(invoiceID, &RecordPaymentRequest{
+		LocationID: locationID,
+		Mode:       mode,
+		Amount:     invoice.AmountDue,
+		Notes:      notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Invoice, nil
+}
+
+// CreateFromEstimate creates an invoice from an accepted estimate and returns it.
+// With markAsInvoiced set, the estimate is marked as invoiced so it cannot be converted twice.
+// Required scope: invoices/estimate.write
+func (s *InvoicesService) CreateFromEstimate(locationID, estimateID string, markAsInvoiced bool) (*Invoice, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if estimateID == "" {
+		return nil, fmt.Errorf("estimateId is required")
+	}
+
+	req := &createInvoiceFromEstimateRequest{AltID: locationID, AltType: "location", MarkAsInvoiced: markAsInvoiced}
+
+	var result createInvoiceFromEstimateResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/invoices/estimate/%s/invoice", estimateID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Invoice, nil
+}
+
+// GetSettings retrieves the invoice numbering and default content settings of a location
+// Required scope: invoices.readonly
+func (s *InvoicesService) GetSettings(locationID string) (*InvoiceSettings, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result InvoiceSettings
+	err := s.client.doRequest("GET", "/invoices/settings?"+altLocationQuery(locationID).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateSettings updates the invoice numbering and default content settings of a location.
+// Only the non-empty fields of settings are changed.
+// Required scope: invoices.write
+func (s *InvoicesService) UpdateSettings(locationID string, settings *InvoiceSettings) (*InvoiceSettings, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if settings == nil {
+		return nil, fmt.Errorf("settings are required")
+	}
+
+	req := &updateInvoiceSettingsRequest{AltID: locationID, AltType: "location", InvoiceSettings: settings}
+
+	var result InvoiceSettings
+	err := s.client.doRequest("PUT", "/invoices/settings", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GenerateInvoiceNumber returns the next available invoice number for a location
+// Required scope: invoices.readonly
+func (s *InvoicesService) GenerateInvoiceNumber(locationID string) (string, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return "", fmt.Errorf("locationId is required")
+	}
+
+	var result invoiceNumberResponse
+	err := s.client.doRequest("GET", "/invoices/generate-invoice-number?"+altLocationQuery(locationID).Encode(), nil, &result)
+	if err != nil {
+		return "", err
+	}
+
+	// The API returns the number as a JSON number for numeric sequences
+	switch n := result.InvoiceNumber.(type) {
+	case string:
+		return n, nil
+	case float64:
+		return fmt.Sprintf("%.0f", n), nil
+	case nil:
+		return "", fmt.Errorf("invoice number missing from response")
+	default:
+		return fmt.Sprintf("%v", n), nil
+	}
+}
+
+// CreatePaymentLink generates a payment link for an ad-hoc amount owed by a contact and returns its URL
+// Required scope: invoices.write
+func (s *InvoicesService) CreatePaymentLink(locationID string, contact *InvoiceContactDetails, description string, amount float64, currency string, liveMode bool) (string, error) {
+	if amount <= 0 {
+		return "", fmt.Errorf("amount must be greater than zero")
+	}
+	if currency == "" {
+		return "", fmt.Errorf("currency is required")
+	}
+
+	result, err := s.Text2Pay(&Text2PayRequest{
+		LocationID:     locationID,
+		Name:           description,
+		Currency:       currency,
+		ContactDetails: contact,
+		LiveMode:       liveMode,
+		Items: []InvoiceItem{
+			{Name: description, Currency: currency, Amount: amount, Qty: 1},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result.InvoiceURL, nil
+}
@@ -0,0 +1,365 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Integration tests for Invoices API
+// These tests use the same environment variables as the Contacts tests.
+
+func TestInvoicesIntegration_FullWorkflow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	timestamp := time.Now().Format("20060102150405")
+
+	contact, err := client.Contacts.Create(&CreateContactRequest{
+		LocationID: locationID,
+		FirstName:  "Invoice",
+		LastName:   "Test",
+		Email:      "invoice+" + timestamp + "@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create contact: %v", err)
+	}
+
+	defer func() {
+		_ = client.Contacts.Delete(contact.ID)
+	}()
+
+	// 1. Create an invoice
+	t.Log("Step 1: Creating invoice")
+	invoice, err := client.Invoices.Create(&CreateInvoiceRequest{
+		LocationID: locationID,
+		Name:       "Test Invoice " + timestamp,
+		Currency:   "USD",
+		ContactDetails: &InvoiceContactDetails{
+			ID:    contact.ID,
+			Name:  "Invoice Test",
+			Email: contact.Email,
+		},
+		Items: []InvoiceItem{
+			{Name: "Consulting", Currency: "USD", Amount: 100, Qty: 2},
+		},
+		Discount: &InvoiceDiscount{Type: "percentage", Value: 10},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create invoice: %v", err)
+	}
+	if invoice.ID == "" {
+		t.Fatal("Created invoice has no ID")
+	}
+	t.Logf("Created invoice: %s", invoice.ID)
+
+	defer func() {
+		_ = client.Invoices.Delete(locationID, invoice.ID)
+	}()
+
+	// 2. Get the invoice
+	t.Log("Step 2: Retrieving invoice")
+	retrieved, err := client.Invoices.Get(locationID, invoice.ID)
+	if err != nil {
+		t.Fatalf("Failed to get invoice: %v", err)
+	}
+	if retrieved.ID != invoice.ID {
+		t.Errorf("Expected invoice ID %s, got %s", invoice.ID, retrieved.ID)
+	}
+
+	// 3. Update the invoice
+	t.Log("Step 3: Updating invoice")
+	_, err = client.Invoices.Update(invoice.ID, &UpdateInvoiceRequest{
+		LocationID: locationID,
+		Name:       "Updated Invoice " + timestamp,
+		Currency:   "USD",
+		Items: []InvoiceItem{
+			{Name: "Consulting", Currency: "USD", Amount: 120, Qty: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update invoice: %v", err)
+	}
+
+	// 4. List invoices for the contact
+	t.Log("Step 4: Listing invoices")
+	invoices, err := client.Invoices.List(&ListInvoicesOptions{
+		LocationID: locationID,
+		ContactID:  contact.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list invoices: %v", err)
+	}
+	if len(invoices.Invoices) == 0 {
+		t.Error("Expected at least one invoice for the contact")
+	}
+
+	// 5. Delete the invoice
+	t.Log("Step 5: Deleting invoice")
+	if err := client.Invoices.Delete(locationID, invoice.ID); err != nil {
+		t.Fatalf("Failed to delete invoice: %v", err)
+	}
+}
+
+func TestInvoicesService_Text2Pay(t *testing.T) {
+	var body Text2PayRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /invoices/text2pay": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"invoice":{"_id":"inv-1","total":49.5},"invoiceUrl":"https://pay.example.com/inv-1"}`))
+		},
+	})
+	contact := &InvoiceContactDetails{ID: "contact-1", Name: "Jane Doe"}
+
+	url, err := client.Invoices.CreatePaymentLink("loc-1", contact, "Consultation", 49.5, "USD", true)
+	if err != nil {
+		t.Fatalf("CreatePaymentLink failed: %v", err)
+	}
+	if url != "https://pay.example.com/inv-1" {
+		t.Errorf("Unexpected url: %s", url)
+	}
+	if body.LocationID != "loc-1" || body.AltType != "location" || body.Action != "draft" || body.IssueDate == "" ||
+		body.Currency != "USD" || !body.LiveMode || len(body.Items) != 1 || body.Items[0].Amount != 49.5 {
+		t.Errorf("Unexpected text2pay request: %+v", body)
+	}
+
+	req := &Text2PayRequest{LocationID: "loc-1", InvoiceID: "inv-1", ContactDetails: contact, Action: "send"}
+	if _, err := client.Invoices.Text2Pay(req); err != nil {
+		t.Fatalf("Text2Pay failed: %v", err)
+	}
+	if body.InvoiceID != "inv-1" || body.Action != "send" {
+		t.Errorf("Unexpected text2pay request: %+v", body)
+	}
+
+	if _, err := client.Invoices.Text2Pay(&Text2PayRequest{LocationID: "loc-1", Items: []InvoiceItem{{Name: "Item"}}}); err == nil {
+		t.Error("Expected error for missing contactDetails")
+	}
+	if _, err := client.Invoices.Text2Pay(&Text2PayRequest{LocationID: "loc-1", ContactDetails: contact}); err == nil {
+		t.Error("Expected error for missing invoiceId and items")
+	}
+	if _, err := client.Invoices.CreatePaymentLink("loc-1", contact, "Consultation", 0, "USD", false); err == nil {
+		t.Error("Expected error for a zero amount")
+	}
+	if _, err := client.Invoices.CreatePaymentLink("loc-1", contact, "Consultation", 10, "", false); err == nil {
+		t.Error("Expected error for missing currency")
+	}
+}
+
+func TestInvoicesService_Payments(t *testing.T) {
+	var sent SendInvoiceRequest
+	var voided invoiceActionRequest
+	var recorded []RecordPaymentRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /invoices/inv-1/send": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"invoice":{"_id":"inv-1","status":"sent"}}`))
+		},
+		"POST /invoices/inv-1/void": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&voided)
+			_, _ = w.Write([]byte(`{"_id":"inv-1","status":"void"}`))
+		},
+		"GET /invoices/{invoiceId}": func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("altId") != "loc-1" || q.Get("altType") != "location" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			amountDue := 0.0
+			if r.PathValue("invoiceId") == "inv-1" {
+				amountDue = 75
+			}
+			writeJSON(w, Invoice{ID: r.PathValue("invoiceId"), AmountDue: amountDue})
+		},
+		"POST /invoices/inv-1/record-payment": func(w http.ResponseWriter, r *http.Request) {
+			var req RecordPaymentRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			recorded = append(recorded, req)
+			_, _ = w.Write([]byte(`{"success":true,"invoice":{"_id":"inv-1","status":"paid"}}`))
+		},
+	})
+
+	result, err := client.Invoices.Send("inv-1", &SendInvoiceRequest{LocationID: "loc-1", UserID: "user-1"})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if result.Invoice == nil || result.Invoice.Status != "sent" {
+		t.Errorf("Unexpected send response: %+v", result)
+	}
+	if sent.LocationID != "loc-1" || sent.AltType != "location" || sent.Action != "sms_and_email" || sent.UserID != "user-1" {
+		t.Errorf("Unexpected send request: %+v", sent)
+	}
+	if _, err := client.Invoices.Send("inv-1", &SendInvoiceRequest{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error for missing userId")
+	}
+	if _, err := client.Invoices.Send("", &SendInvoiceRequest{LocationID: "loc-1", UserID: "user-1"}); err == nil {
+		t.Error("Expected error for missing invoiceId")
+	}
+
+	invoice, err := client.Invoices.Void("loc-1", "inv-1")
+	if err != nil {
+		t.Fatalf("Void failed: %v", err)
+	}
+	if invoice.Status != "void" || voided.AltID != "loc-1" || voided.AltType != "location" {
+		t.Errorf("Unexpected void: %+v, %+v", invoice, voided)
+	}
+	if _, err := client.Invoices.Void("loc-1", ""); err == nil {
+		t.Error("Expected error for missing invoiceId")
+	}
+
+	if _, err := client.Invoices.RecordPayment("inv-1", &RecordPaymentRequest{LocationID: "loc-1", Mode: "cash", Amount: 25}); err != nil {
+		t.Fatalf("RecordPayment failed: %v", err)
+	}
+	if _, err := client.Invoices.RecordPayment("inv-1", &RecordPaymentRequest{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error for missing mode")
+	}
+
+	invoice, err = client.Invoices.MarkPaid("loc-1", "inv-1", "cheque", "Paid at the front desk")
+	if err != nil {
+		t.Fatalf("MarkPaid failed: %v", err)
+	}
+	if invoice.Status != "paid" {
+		t.Errorf("Unexpected invoice: %+v", invoice)
+	}
+	if len(recorded) != 2 {
+		t.Fatalf("Unexpected recorded payments: %+v", recorded)
+	}
+	if recorded[0].LocationID != "loc-1" || recorded[0].AltType != "location" || recorded[0].Amount != 25 {
+		t.Errorf("Unexpected record payment request: %+v", recorded[0])
+	}
+	if recorded[1].Mode != "cheque" || recorded[1].Amount != 75 || recorded[1].Notes != "Paid at the front desk" {
+		t.Errorf("Unexpected mark paid request: %+v", recorded[1])
+	}
+
+	// An invoice without an amount due is returned as is
+	invoice, err = client.Invoices.MarkPaid("loc-1", "inv-2", "cash", "")
+	if err != nil || invoice.ID != "inv-2" || len(recorded) != 2 {
+		t.Errorf("MarkPaid = %+v, %v; recorded %d payments", invoice, err, len(recorded))
+	}
+}
+
+func TestInvoicesService_Settings(t *testing.T) {
+	var updated map[string]interface{}
+	invoiceNumber := `"INV-0042"`
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /invoices/settings": func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("altId") != "loc-1" || q.Get("altType") != "location" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"invoiceNumberPrefix":"INV-","nextInvoiceNumber":42,"dueAfterDays":14}`))
+		},
+		"PUT /invoices/settings": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"invoiceNumberPrefix":"ACME-","nextInvoiceNumber":42}`))
+		},
+		"GET /invoices/generate-invoice-number": func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("altId") != "loc-1" || q.Get("altType") != "location" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"invoiceNumber":` + invoiceNumber + `}`))
+		},
+	})
+
+	settings, err := client.Invoices.GetSettings("loc-1")
+	if err != nil {
+		t.Fatalf("GetSettings failed: %v", err)
+	}
+	if settings.InvoiceNumberPrefix != "INV-" || settings.NextInvoiceNumber != 42 || settings.DueAfterDays != 14 {
+		t.Errorf("Unexpected settings: %+v", settings)
+	}
+
+	settings, err = client.Invoices.UpdateSettings("loc-1", &InvoiceSettings{InvoiceNumberPrefix: "ACME-"})
+	if err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+	if settings.InvoiceNumberPrefix != "ACME-" {
+		t.Errorf("Unexpected settings: %+v", settings)
+	}
+	if updated["altId"] != "loc-1" || updated["altType"] != "location" || updated["invoiceNumberPrefix"] != "ACME-" {
+		t.Errorf("Unexpected update request: %v", updated)
+	}
+	if _, ok := updated["dueAfterDays"]; ok {
+		t.Errorf("Unset settings were sent: %v", updated)
+	}
+	if _, err := client.Invoices.UpdateSettings("loc-1", nil); err == nil {
+		t.Error("Expected error for missing settings")
+	}
+
+	number, err := client.Invoices.GenerateInvoiceNumber("loc-1")
+	if err != nil || number != "INV-0042" {
+		t.Errorf("GenerateInvoiceNumber = %q, %v", number, err)
+	}
+	invoiceNumber = `1043`
+	number, err = client.Invoices.GenerateInvoiceNumber("loc-1")
+	if err != nil || number != "1043" {
+		t.Errorf("GenerateInvoiceNumber = %q, %v", number, err)
+	}
+	invoiceNumber = `null`
+	if _, err := client.Invoices.GenerateInvoiceNumber("loc-1"); err == nil {
+		t.Error("Expected error for a missing invoice number")
+	}
+
+	if _, err := client.Invoices.GetSettings(""); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+	if _, err := client.Invoices.GenerateInvoiceNumber(""); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
+
+func TestInvoicesService_CreateFromEstimate(t *testing.T) {
+	client := newTestClient(t, Config{LocationID: "loc-1"}, map[string]http.HandlerFunc{
+		"POST /invoices/estimate/est-1/invoice": func(w http.ResponseWriter, r *http.Request) {
+			var body createInvoiceFromEstimateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.AltID != "loc-1" || body.AltType != "location" || !body.MarkAsInvoiced {
+				t.Errorf("unexpected body %+v", body)
+			}
+			_, _ = w.Write([]byte(`{"estimate":{"_id":"est-1","status":"invoiced"},"invoice":{"_id":"inv-1","status":"draft","total":250}}`))
+		},
+	})
+
+	invoice, err := client.Invoices.CreateFromEstimate("", "est-1", true)
+	if err != nil {
+		t.Fatalf("CreateFromEstimate failed: %v", err)
+	}
+	if invoice.ID != "inv-1" || invoice.Total != 250 {
+		t.Errorf("invoice = %+v", invoice)
+	}
+
+	if _, err := client.Invoices.CreateFromEstimate("", "", true); err == nil {
+		t.Error("expected error for missing estimateId")
+	}
+}
+
+func TestInvoicesService_SetScheduleAutoPayment(t *testing.T) {
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /invoices/schedule/sch-1/auto-payment": func(w http.ResponseWriter, r *http.Request) {
+			var body scheduleAutoPaymentRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.ID != "sch-1" || body.AltID != "loc-1" || !body.AutoPayment.Enable || body.AutoPayment.PaymentMethodID != "pm_123" {
+				t.Errorf("unexpected body %+v", body)
+			}
+			_, _ = w.Write([]byte(`{"_id":"sch-1","status":"active","autoPayment":{"enable":true,"paymentMethodId":"pm_123"}}`))
+		},
+	})
+
+	schedule, err := client.Invoices.SetScheduleAutoPayment("loc-1", "sch-1", &InvoiceAutoPayment{
+		Enable:          true,
+		Type:            "card",
+		PaymentMethodID: "pm_123",
+		CustomerID:      "cus_123",
+	})
+	if err != nil {
+		t.Fatalf("SetScheduleAutoPayment failed: %v", err)
+	}
+	if schedule.AutoPayment == nil || !schedule.AutoPayment.Enable {
+		t.Errorf("schedule = %+v", schedule)
+	}
+
+	if _, err := client.Invoices.SetScheduleAutoPayment("loc-1", "sch-1", &InvoiceAutoPayment{Enable: true}); err == nil {
+		t.Error("expected error for missing payment method")
+	}
+}
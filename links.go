@@ -0,0 +1,113 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// LinksService handles operations related to trigger links
+type LinksService struct {
+	client *Client
+}
+
+// Link represents a GoHighLevel trigger link
+type Link struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	RedirectTo string `json:"redirectTo,omitempty"`
+	FieldKey   string `json:"fieldKey,omitempty"`
+	LocationID string `json:"locationId,omitempty"`
+}
+
+// CreateLinkRequest represents a request to create a trigger link
+type CreateLinkRequest struct {
+	LocationID string `json:"locationId"`
+	Name       string `json:"name"`
+	RedirectTo string `json:"redirectTo"`
+}
+
+// UpdateLinkRequest represents a request to update a trigger link
+type UpdateLinkRequest struct {
+	Name       string `json:"name"`
+	RedirectTo string `json:"redirectTo"`
+}
+
+// LinkResponse represents a single trigger link API response
+type LinkResponse struct {
+	Link *Link `json:"link,omitempty"`
+}
+
+// LinksResponse represents a list of trigger links API response
+type LinksResponse struct {
+	Links []Link `json:"links,omitempty"`
+}
+
+// List retrieves all trigger links for a location
+// Required scope: links.readonly
+func (s *LinksService) List(locationID string) ([]Link, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := url.Values{}
+	query.Set("locationId", locationID)
+
+	var result LinksResponse
+	err := s.client.doRequest("GET", "/links/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Links, nil
+}
+
+// Create creates a new trigger link
+// Required scope: links.write
+func (s *LinksService) Create(req *CreateLinkRequest) (*Link, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.RedirectTo == "" {
+		return nil, fmt.Errorf("redirectTo is required")
+	}
+
+	var result LinkResponse
+	err := s.client.doRequest("POST", "/links/", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Link, nil
+}
+
+// Update updates an existing trigger link
+// Required scope: links.write
+func (s *LinksService) Update(linkID string, req *UpdateLinkRequest) (*Link, error) {
+	if linkID == "" {
+		return nil, fmt.Errorf("linkId is required")
+	}
+
+	var result LinkResponse
+	err := s.client.doRequest("PUT", fmt.Sprintf("/links/%s", linkID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Link, nil
+}
+
+// Delete deletes a trigger link
+// Required scope: links.write
+func (s *LinksService) Delete(linkID string) error {
+	if linkID == "" {
+		return fmt.Errorf("linkId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/links/%s", linkID), nil, nil)
+}
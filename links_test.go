@@ -0,0 +1,75 @@
+package gohighlevel
+
+import (
+	"testing"
+	"time"
+)
+
+// Integration tests for Trigger Links API
+// These tests use the same environment variables as the Contacts tests.
+
+func TestLinksIntegration_FullWorkflow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	timestamp := time.Now().Format("20060102150405")
+
+	// 1. Create a link
+	t.Log("Step 1: Creating trigger link")
+	link, err := client.Links.Create(&CreateLinkRequest{
+		LocationID: locationID,
+		Name:       "Test Link " + timestamp,
+		RedirectTo: "https://example.com/landing",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create link: %v", err)
+	}
+	if link.ID == "" {
+		t.Fatal("Created link has no ID")
+	}
+	t.Logf("Created link: %s", link.ID)
+
+	defer func() {
+		_ = client.Links.Delete(link.ID)
+	}()
+
+	// 2. List links and make sure the new one is present
+	t.Log("Step 2: Listing trigger links")
+	links, err := client.Links.List(locationID)
+	if err != nil {
+		t.Fatalf("Failed to list links: %v", err)
+	}
+	found := false
+	for _, l := range links {
+		if l.ID == link.ID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("Created link %s not found in list", link.ID)
+	}
+
+	// 3. Update the link
+	t.Log("Step 3: Updating trigger link")
+	updated, err := client.Links.Update(link.ID, &UpdateLinkRequest{
+		Name:       "Updated Link " + timestamp,
+		RedirectTo: "https://example.com/updated",
+	})
+	if err != nil {
+		t.Fatalf("Failed to update link: %v", err)
+	}
+	if updated.RedirectTo != "https://example.com/updated" {
+		t.Errorf("Expected redirectTo to be updated, got %s", updated.RedirectTo)
+	}
+
+	// 4. Delete the link
+	t.Log("Step 4: Deleting trigger link")
+	if err := client.Links.Delete(link.ID); err != nil {
+		t.Fatalf("Failed to delete link: %v", err)
+	}
+}
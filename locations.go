@@ -0,0 +1,176 @@
+package gohighlevel
+
+import "fmt"
+
+// LocationsService handles operations related to locations (sub-accounts).
+// Updating a location requires an agency access token.
+type LocationsService struct {
+	client *Client
+}
+
+// Location represents a location (sub-account)
+type Location struct {
+	ID         string            `json:"id,omitempty"`
+	CompanyID  string            `json:"companyId,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Domain     string            `json:"domain,omitempty"`
+	Address    string            `json:"address,omitempty"`
+	City       string            `json:"city,omitempty"`
+	State      string            `json:"state,omitempty"`
+	Country    string            `json:"country,omitempty"`
+	PostalCode string            `json:"postalCode,omitempty"`
+	Website    string            `json:"website,omitempty"`
+	Timezone   string            `json:"timezone,omitempty"`
+	FirstName  string            `json:"firstName,omitempty"`
+	LastName   string            `json:"lastName,omitempty"`
+	Email      string            `json:"email,omitempty"`
+	Phone      string            `json:"phone,omitempty"`
+	Business   *LocationBusiness `json:"business,omitempty"`
+	Social     *LocationSocial   `json:"social,omitempty"`
+	Settings   *LocationSettings `json:"settings,omitempty"`
+}
+
+// LocationBusiness represents the business profile of a location as shown in its settings
+type LocationBusiness struct {
+	Name       string `json:"name,omitempty"`
+	Address    string `json:"address,omitempty"`
+	City       string `json:"city,omitempty"`
+	State      string `json:"state,omitempty"`
+	Country    string `json:"country,omitempty"`
+	PostalCode string `json:"postalCode,omitempty"`
+	Website    string `json:"website,omitempty"`
+	Timezone   string `json:"timezone,omitempty"`
+	LogoURL    string `json:"logoUrl,omitempty"`
+	Email      string `json:"email,omitempty"`
+}
+
+// LocationSocial represents the social profile links of a location
+type LocationSocial struct {
+	FacebookURL    string `json:"facebookUrl,omitempty"`
+	GooglePlus     string `json:"googlePlus,omitempty"`
+	LinkedIn       string `json:"linkedIn,omitempty"`
+	Foursquare     string `json:"foursquare,omitempty"`
+	Twitter        string `json:"twitter,omitempty"`
+	Yelp           string `json:"yelp,omitempty"`
+	Instagram      string `json:"instagram,omitempty"`
+	YouTube        string `json:"youtube,omitempty"`
+	Pinterest      string `json:"pinterest,omitempty"`
+	BlogRSS        string `json:"blogRss,omitempty"`
+	GooglePlacesID string `json:"googlePlacesId,omitempty"`
+}
+
+// LocationSettings represents the general settings of a location. All fields are sent on update.
+type LocationSettings struct {
+	AllowDuplicateContact     bool `json:"allowDuplicateContact"`
+	AllowDuplicateOpportunity bool `json:"allowDuplicateOpportunity"`
+	AllowFacebookNameMerge    bool `json:"allowFacebookNameMerge"`
+	DisableContactTimezone    bool `json:"disableContactTimezone"`
+}
+
+// ProspectInfo represents the contact person of a location
+type ProspectInfo struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+}
+
+// UpdateLocationRequest represents a request to update a location. Empty fields are left unchanged.
+type UpdateLocationRequest struct {
+	CompanyID    string            `json:"companyId"`
+	Name         string            `json:"name,omitempty"`
+	Phone        string            `json:"phone,omitempty"`
+	Address      string            `json:"address,omitempty"`
+	City         string            `json:"city,omitempty"`
+	State        string            `json:"state,omitempty"`
+	Country      string            `json:"country,omitempty"` // ISO 3166-1 alpha-2, e.g. "US"
+	PostalCode   string            `json:"postalCode,omitempty"`
+	Website      string            `json:"website,omitempty"`
+	Timezone     string            `json:"timezone,omitempty"` // IANA name, e.g. "America/New_York"
+	ProspectInfo *ProspectInfo     `json:"prospectInfo,omitempty"`
+	Settings     *LocationSettings `json:"settings,omitempty"`
+	Social       *LocationSocial   `json:"social,omitempty"`
+}
+
+// BusinessInfo represents the business details of a location collected during onboarding
+type BusinessInfo struct {
+	Name       string
+	Phone      string
+	Address    string
+	City       string
+	State      string
+	Country    string
+	PostalCode string
+	Website    string
+	Timezone   string
+	Social     *LocationSocial
+}
+
+// LocationResponse represents a single location API response
+type LocationResponse struct {
+	Location *Location `json:"location,omitempty"`
+}
+
+// Get retrieves a location by ID
+// Required scope: locations.readonly
+func (s *LocationsService) Get(locationID string) (*Location, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result LocationResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/locations/%s", locationID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Location, nil
+}
+
+// Update updates a location
+// Required scope: locations.write
+func (s *LocationsService) Update(locationID string, req *UpdateLocationRequest) (*Location, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.CompanyID == "" {
+		return nil, fmt.Errorf("companyId is required")
+	}
+
+	var result LocationResponse
+	err := s.client.doRequest("PUT", fmt.Sprintf("/locations/%s", locationID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Location, nil
+}
+
+// UpdateBusinessInfo pushes business details, such as those collected by an onboarding wizard,
+// into a location. Empty fields are left unchanged.
+// Required scope: locations.write
+func (s *LocationsService) UpdateBusinessInfo(locationID, companyID string, info *BusinessInfo) (*Location, error) {
+	return s.Update(locationID, &UpdateLocationRequest{
+		CompanyID:  companyID,
+		Name:       info.Name,
+		Phone:      info.Phone,
+		Address:    info.Address,
+		City:       info.City,
+		State:      info.State,
+		Country:    info.Country,
+		PostalCode: info.PostalCode,
+		Website:    info.Website,
+		Timezone:   info.Timezone,
+		Social:     info.Social,
+	})
+}
+
+// UpdateSettings replaces the general settings of a location
+// Required scope: locations.write
+func (s *LocationsService) UpdateSettings(locationID, companyID string, settings *LocationSettings) (*Location, error) {
+	if settings == nil {
+		return nil, fmt.Errorf("settings are required")
+	}
+	return s.Update(locationID, &UpdateLocationRequest{CompanyID: companyID, Settings: settings})
+}
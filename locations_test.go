@@ -0,0 +1,70 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLocationsService_UpdateBusinessInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/locations/loc-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["companyId"] != "comp-1" || body["name"] != "Acme Dental" || body["city"] != "Austin" {
+			t.Errorf("unexpected body %v", body)
+		}
+		if _, ok := body["settings"]; ok {
+			t.Error("settings sent with business info")
+		}
+		if _, ok := body["website"]; ok {
+			t.Error("empty website sent")
+		}
+		social, _ := body["social"].(map[string]interface{})
+		if social["instagram"] != "https://instagram.com/acme" {
+			t.Errorf("social = %v", body["social"])
+		}
+		_, _ = w.Write([]byte(`{"location":{"id":"loc-1","companyId":"comp-1","name":"Acme Dental","city":"Austin"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	loc, err := client.Locations.UpdateBusinessInfo("loc-1", "comp-1", &BusinessInfo{
+		Name:   "Acme Dental",
+		City:   "Austin",
+		Social: &LocationSocial{Instagram: "https://instagram.com/acme"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBusinessInfo failed: %v", err)
+	}
+	if loc.Name != "Acme Dental" {
+		t.Errorf("location = %+v", loc)
+	}
+
+	if _, err := client.Locations.UpdateBusinessInfo("loc-1", "", &BusinessInfo{Name: "x"}); err == nil {
+		t.Error("expected error for missing companyId")
+	}
+}
+
+func TestLocationsService_UpdateSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Settings map[string]interface{} `json:"settings"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		// Disabled settings must be sent, not dropped
+		if body.Settings["allowDuplicateContact"] != true || body.Settings["disableContactTimezone"] != false {
+			t.Errorf("settings = %v", body.Settings)
+		}
+		_, _ = w.Write([]byte(`{"location":{"id":"loc-1"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc-1"})
+	if _, err := client.Locations.UpdateSettings("", "comp-1", &LocationSettings{AllowDuplicateContact: true}); err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// MarketplaceService handles operations related to the app's marketplace listing and installations
+type MarketplaceService struct {
+	client *Client
+}
+
+// InstallationDetails describes an installation of the app on a company or location
+type InstallationDetails struct {
+	Company      *InstallationCompany  `json:"company,omitempty"`
+	Location     *InstallationLocation `json:"location,omitempty"`
+	User         *InstallationUser     `json:"user,omitempty"`
+	Installation *AppInstallation      `json:"installationDetails,omitempty"`
+}
+
+// InstallationCompany describes the agency the app is installed for
+type InstallationCompany struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	Website   string `json:"website,omitempty"`
+	Domain    string `json:"domain,omitempty"`
+	PlanID    string `json:"planId,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+	CreatedAt Time   `json:"createdAt,omitempty"`
+}
+
+// InstallationLocation describes the sub-account the app is installed in
+type InstallationLocation struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	CompanyID string `json:"companyId,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+}
+
+// InstallationUser describes the user who installed the app
+type InstallationUser struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	Role  string `json:"role,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// AppInstallation holds the plan and trial state of an installation
+type AppInstallation struct {
+	AppID       string `json:"appId,omitempty"`
+	VersionID   string `json:"versionId,omitempty"`
+	PlanID      string `json:"planId,omitempty"`
+	PlanName    string `json:"planName,omitempty"`
+	InstalledAt Time   `json:"installedAt,omitempty"`
+	IsTrial     bool   `json:"isTrial,omitempty"`
+	TrialEndsAt Time   `json:"trialEndsAt,omitempty"`
+	IsBulk      bool   `json:"isBulkInstallation,omitempty"`
+}
+
+// GetInstallationDetails retrieves the installation details of the app for a company or location,
+// including the subscribed plan, install time and trial status.
+// Pass the locationID to look up a sub-account installation, or "" for the agency installation.
+// Required scope: marketplace-installer-details.readonly
+func (s *MarketplaceService) GetInstallationDetails(appID, companyID, locationID string) (*InstallationDetails, error) {
+	if appID == "" {
+		return nil, fmt.Errorf("appId is required")
+	}
+	if companyID == "" && locationID == "" {
+		return nil, fmt.Errorf("companyId or locationId is required")
+	}
+
+	query := url.Values{}
+	if companyID != "" {
+		query.Set("companyId", companyID)
+	}
+	if locationID != "" {
+		query.Set("locationId", locationID)
+	}
+
+	var result InstallationDetails
+	err := s.client.doRequest("GET", fmt.Sprintf("/marketplace/app/%s/installations?%s", appID, query.Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,179 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// WalletCharge represents a usage charge billed to a sub-account's wallet by the app
+type WalletCharge struct {
+	ID          string  `json:"_id,omitempty"`
+	AppID       string  `json:"appId,omitempty"`
+	MeterID     string  `json:"meterId,omitempty"`
+	EventID     string  `json:"eventId,omitempty"`
+	UserID      string  `json:"userId,omitempty"`
+	LocationID  string  `json:"locationId,omitempty"`
+	CompanyID   string  `json:"companyId,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Price       float64 `json:"price,omitempty"` // Price per unit
+	Units       float64 `json:"units,omitempty"`
+	Amount      float64 `json:"amount,omitempty"` // Price times units
+	EventTime   Time    `json:"eventTime,omitempty"`
+	CreatedAt   Time    `json:"createdAt,omitempty"`
+}
+
+// CreateChargeRequest represents a request to charge a sub-account's wallet for metered usage
+type CreateChargeRequest struct {
+	AppID       string  `json:"appId"`
+	MeterID     string  `json:"meterId"` // Meter of the app's usage-based pricing
+	EventID     string  `json:"eventId"` // Unique ID of the usage event, used to deduplicate charges
+	UserID      string  `json:"userId,omitempty"`
+	LocationID  string  `json:"locationId"`
+	CompanyID   string  `json:"companyId"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price,omitempty"` // Price per unit; the meter's price when zero
+	Units       float64 `json:"units"`
+	EventTime   string  `json:"eventTime,omitempty"` // ISO 8601 timestamp; now when empty
+}
+
+// ListChargesOptions represents query options for listing wallet charges
+type ListChargesOptions struct {
+	MeterID   string
+	EventID   string
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+	Skip      int
+	Limit     int // Default 20
+}
+
+// WalletChargesResponse represents a list of wallet charges API response
+type WalletChargesResponse struct {
+	Charges []WalletCharge `json:"data,omitempty"`
+	Total   int            `json:"total,omitempty"`
+}
+
+// createChargeResponse represents the response to creating a wallet charge
+type createChargeResponse struct {
+	ChargeID string `json:"chargeId,omitempty"`
+}
+
+// hasFundsResponse represents the response of the wallet funds check
+type hasFundsResponse struct {
+	HasFunds bool `json:"hasFunds"`
+}
+
+// CreateCharge charges a sub-account's wallet for metered usage of the app and returns the
+// charge ID. Charges are only allowed for locations that have the app installed; check
+// HasFunds first to avoid charging an empty wallet.
+// Required scope: charges.write
+func (s *MarketplaceService) CreateCharge(req *CreateChargeRequest) (string, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return "", fmt.Errorf("locationId is required")
+	}
+	if req.AppID == "" {
+		return "", fmt.Errorf("appId is required")
+	}
+	if req.MeterID == "" {
+		return "", fmt.Errorf("meterId is required")
+	}
+	if req.EventID == "" {
+		return "", fmt.Errorf("eventId is required")
+	}
+	if req.CompanyID == "" {
+		return "", fmt.Errorf("companyId is required")
+	}
+	if req.Units <= 0 {
+		return "", fmt.Errorf("units must be positive")
+	}
+
+	var result createChargeResponse
+	err := s.client.doRequest("POST", "/marketplace/billing/charges", req, &result)
+	if err != nil {
+		return "", err
+	}
+
+	return result.ChargeID, nil
+}
+
+// ListCharges retrieves the wallet charges made by the app
+// Required scope: charges.readonly
+func (s *MarketplaceService) ListCharges(opts *ListChargesOptions) (*WalletChargesResponse, error) {
+	if opts == nil {
+		opts = &ListChargesOptions{}
+	}
+
+	query := url.Values{}
+	if opts.MeterID != "" {
+		query.Set("meterId", opts.MeterID)
+	}
+	if opts.EventID != "" {
+		query.Set("eventId", opts.EventID)
+	}
+	if opts.UserID != "" {
+		query.Set("userId", opts.UserID)
+	}
+	if !opts.StartDate.IsZero() {
+		query.Set("startDate", opts.StartDate.UTC().Format(time.RFC3339))
+	}
+	if !opts.EndDate.IsZero() {
+		query.Set("endDate", opts.EndDate.UTC().Format(time.RFC3339))
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("skip", strconv.Itoa(opts.Skip))
+
+	var result WalletChargesResponse
+	err := s.client.doRequest("GET", "/marketplace/billing/charges?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCharge retrieves a wallet charge by ID
+// Required scope: charges.readonly
+func (s *MarketplaceService) GetCharge(chargeID string) (*WalletCharge, error) {
+	if chargeID == "" {
+		return nil, fmt.Errorf("chargeId is required")
+	}
+
+	var result WalletCharge
+	err := s.client.doRequest("GET", fmt.Sprintf("/marketplace/billing/charges/%s", chargeID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteCharge deletes a wallet charge, e.g. one raised by mistake
+// Required scope: charges.write
+func (s *MarketplaceService) DeleteCharge(chargeID string) error {
+	if chargeID == "" {
+		return fmt.Errorf("chargeId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/marketplace/billing/charges/%s", chargeID), nil, nil)
+}
+
+// HasFunds reports whether the wallet of the sub-account the client's token belongs to has
+// enough funds to be charged
+// Required scope: charges.readonly
+func (s *MarketplaceService) HasFunds() (bool, error) {
+	var result hasFundsResponse
+	err := s.client.doRequest("GET", "/marketplace/billing/charges/has-funds", nil, &result)
+	if err != nil {
+		return false, err
+	}
+
+	return result.HasFunds, nil
+}
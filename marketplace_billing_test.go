@@ -0,0 +1,79 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMarketplace_CreateCharge(t *testing.T) {
+	var body CreateChargeRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/marketplace/billing/charges" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"chargeId":"charge-1"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc-1"})
+	chargeID, err := client.Marketplace.CreateCharge(&CreateChargeRequest{
+		AppID:       "app-1",
+		MeterID:     "meter-1",
+		EventID:     "evt-1",
+		CompanyID:   "company-1",
+		Description: "100 AI credits",
+		Units:       100,
+	})
+	if err != nil {
+		t.Fatalf("CreateCharge failed: %v", err)
+	}
+	if chargeID != "charge-1" {
+		t.Errorf("Expected charge-1, got %s", chargeID)
+	}
+	if body.LocationID != "loc-1" || body.Units != 100 || body.MeterID != "meter-1" {
+		t.Errorf("Unexpected request body: %+v", body)
+	}
+
+	if _, err := client.Marketplace.CreateCharge(&CreateChargeRequest{AppID: "app-1", MeterID: "meter-1", EventID: "evt-2", CompanyID: "company-1"}); err == nil {
+		t.Error("Expected error for zero units")
+	}
+}
+
+func TestMarketplace_ListChargesAndHasFunds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/marketplace/billing/charges":
+			query := r.URL.Query()
+			if query.Get("meterId") != "meter-1" || query.Get("startDate") != "2026-10-01T00:00:00Z" || query.Get("limit") != "20" {
+				t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"data":[{"_id":"charge-1","units":100,"amount":1.5}],"total":1}`))
+		case "/marketplace/billing/charges/has-funds":
+			w.Write([]byte(`{"hasFunds":true}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	charges, err := client.Marketplace.ListCharges(&ListChargesOptions{
+		MeterID:   "meter-1",
+		StartDate: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("ListCharges failed: %v", err)
+	}
+	if len(charges.Charges) != 1 || charges.Charges[0].Amount != 1.5 {
+		t.Errorf("Unexpected charges: %+v", charges)
+	}
+
+	hasFunds, err := client.Marketplace.HasFunds()
+	if err != nil || !hasFunds {
+		t.Errorf("Expected funds, got %v, %v", hasFunds, err)
+	}
+}
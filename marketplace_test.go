@@ -0,0 +1,45 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestMarketplaceService_GetInstallationDetails(t *testing.T) {
+	var queries []string
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /marketplace/app/app-1/installations": func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			_, _ = w.Write([]byte(`{
+				"company": {"id": "comp-1", "name": "Acme Agency"},
+				"location": {"id": "loc-1", "companyId": "comp-1"},
+				"installationDetails": {"appId": "app-1", "planId": "plan-1", "isTrial": true, "trialEndsAt": "2025-02-01T00:00:00Z"}
+			}`))
+		},
+	})
+
+	details, err := client.Marketplace.GetInstallationDetails("app-1", "comp-1", "loc-1")
+	if err != nil {
+		t.Fatalf("GetInstallationDetails failed: %v", err)
+	}
+	if details.Company == nil || details.Company.Name != "Acme Agency" || details.Location == nil {
+		t.Errorf("Unexpected details: %+v", details)
+	}
+	if i := details.Installation; i == nil || !i.IsTrial || i.PlanID != "plan-1" || i.TrialEndsAt.IsZero() {
+		t.Errorf("Unexpected installation: %+v", details.Installation)
+	}
+
+	if _, err := client.Marketplace.GetInstallationDetails("app-1", "comp-1", ""); err != nil {
+		t.Fatalf("GetInstallationDetails failed: %v", err)
+	}
+	if len(queries) != 2 || queries[0] != "companyId=comp-1&locationId=loc-1" || queries[1] != "companyId=comp-1" {
+		t.Errorf("Unexpected queries: %v", queries)
+	}
+
+	if _, err := client.Marketplace.GetInstallationDetails("", "comp-1", ""); err == nil {
+		t.Error("Expected error for missing appId")
+	}
+	if _, err := client.Marketplace.GetInstallationDetails("app-1", "", ""); err == nil {
+		t.Error("Expected error for missing companyId and locationId")
+	}
+}
@@ -0,0 +1,73 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// MediaService handles operations related to the media library
+type MediaService struct {
+	client *Client
+}
+
+// UploadMediaRequest represents a file to upload to the media library
+type UploadMediaRequest struct {
+	// File is the content to upload. A file that implements io.Seeker, such as an *os.File, is
+	// streamed from its current offset without being read into memory; any other reader is
+	// read in full before the upload starts.
+	File     io.Reader
+	FileName string
+	Name     string // Display name in the media library (default: FileName)
+	ParentID string // Folder to upload into (default: the root folder)
+
+	// OnProgress, if set, is called as the file is sent. If the request is sent again, e.g.
+	// after a token refresh, progress starts over from 0.
+	OnProgress ProgressFunc
+}
+
+// UploadedMedia represents a file uploaded to the media library
+type UploadedMedia struct {
+	FileID string `json:"fileId,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// Upload uploads a file to the media library of the location the access token belongs to.
+// Cancelling ctx aborts the upload.
+// Required scope: medias.write
+func (s *MediaService) Upload(ctx context.Context, req *UploadMediaRequest) (*UploadedMedia, error) {
+	if req.File == nil {
+		return nil, fmt.Errorf("file is required")
+	}
+	if req.FileName == "" {
+		return nil, fmt.Errorf("fileName is required")
+	}
+
+	fields := map[string]string{}
+	if req.Name != "" {
+		fields["name"] = req.Name
+	}
+	if req.ParentID != "" {
+		fields["parentId"] = req.ParentID
+	}
+	var body *multipartBody
+	var err error
+	if file, ok := req.File.(io.ReadSeeker); ok {
+		body, err = newStreamingMultipartBody("file", req.FileName, file, fields)
+	} else {
+		body, err = newMultipartBody("file", req.FileName, req.File, fields)
+	}
+	if err != nil {
+		return nil, err
+	}
+	body.ctx = ctx
+	body.onProgress = req.OnProgress
+
+	var result UploadedMedia
+	err = s.client.doRequest("POST", "/medias/upload-file", body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,160 @@
+package gohighlevel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMediaService_UploadProgress(t *testing.T) {
+	content := bytes.Repeat([]byte("x"), 256*1024)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/medias/upload-file" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile failed: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "video.mp4" || len(data) != len(content) || r.FormValue("parentId") != "folder-1" {
+			t.Errorf("got %s (%d bytes), parentId %q", header.Filename, len(data), r.FormValue("parentId"))
+		}
+		_, _ = w.Write([]byte(`{"fileId":"file-1","url":"https://cdn.example.com/video.mp4"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	var calls int
+	var lastSent, lastTotal int64
+	media, err := client.Media.Upload(context.Background(), &UploadMediaRequest{
+		File:     bytes.NewReader(content),
+		FileName: "video.mp4",
+		ParentID: "folder-1",
+		OnProgress: func(sent, total int64) {
+			if sent < lastSent {
+				t.Errorf("progress went backwards: %d after %d", sent, lastSent)
+			}
+			calls++
+			lastSent, lastTotal = sent, total
+		},
+	})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if media.FileID != "file-1" {
+		t.Errorf("media = %+v", media)
+	}
+	if calls == 0 || lastSent != lastTotal || lastTotal <= int64(len(content)) {
+		t.Errorf("progress: %d calls, last %d of %d", calls, lastSent, lastTotal)
+	}
+}
+
+func TestMediaService_UploadCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_, _ = w.Write([]byte(`{"fileId":"file-1"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := client.Media.Upload(ctx, &UploadMediaRequest{
+		File:     bytes.NewReader(bytes.Repeat([]byte("x"), 1024*1024)),
+		FileName: "big.bin",
+		OnProgress: func(sent, total int64) {
+			cancel()
+		},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+}
+
+func TestProgressReader(t *testing.T) {
+	var reported []int64
+	r := NewProgressReader(context.Background(), strings.NewReader("hello world"), 11, func(sent, total int64) {
+		reported = append(reported, sent)
+	})
+	buf := make([]byte, 4)
+	for {
+		if _, err := r.Read(buf); err != nil {
+			break
+		}
+	}
+	if r.Sent() != 11 || reported[len(reported)-1] != 11 {
+		t.Errorf("sent = %d, reported = %v", r.Sent(), reported)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewProgressReader(ctx, strings.NewReader("x"), 1, nil).Read(buf); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled read error = %v", err)
+	}
+}
+
+func TestMediaService_UploadStreamsSeekableFile(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	content := bytes.Repeat([]byte("0123456789"), 100000)
+	_, _ = f.Write(content)
+	_, _ = f.Seek(0, io.SeekStart)
+
+	var uploads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_, _ = w.Write([]byte(`{"access_token":"fresh","refresh_token":"r2","expires_in":3600}`))
+			return
+		}
+		uploads++
+		if r.ContentLength <= int64(len(content)) {
+			t.Errorf("Content-Length = %d", r.ContentLength)
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile failed: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if !bytes.Equal(data, content) {
+			t.Errorf("attempt %d received %d bytes, want %d", uploads, len(data), len(content))
+		}
+		// The first attempt is rejected, so the file must be sent again from the start
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"fileId":"file-1"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{
+		ClientID:         "id",
+		ClientSecret:     "secret",
+		AccessToken:      "expired",
+		RefreshToken:     "r",
+		Environment:      MockEnvironment(server.URL),
+		AutoRefreshOn401: true,
+	})
+	body, err := newStreamingMultipartBody("file", "data.bin", f, nil)
+	if err != nil || body.data != nil || body.fileSize != int64(len(content)) {
+		t.Fatalf("streaming body = %d bytes buffered, file size %d, %v", len(body.data), body.fileSize, err)
+	}
+	_, _ = f.Seek(0, io.SeekStart)
+
+	media, err := client.Media.Upload(context.Background(), &UploadMediaRequest{File: f, FileName: "data.bin"})
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if media.FileID != "file-1" || uploads != 2 {
+		t.Errorf("media = %+v after %d uploads", media, uploads)
+	}
+}
@@ -0,0 +1,66 @@
+package gohighlevel
+
+import "context"
+
+// LinkedMembershipOffer is a membership (course) offer with the products and prices that grant it
+type LinkedMembershipOffer struct {
+	Offer    MembershipOffer
+	Products []Product // Products with at least one price granting the offer
+	Prices   []Price   // Prices granting the offer; Price.Product is the product ID
+}
+
+// membershipOffersPageSize is the number of products fetched per request when collecting offers
+const membershipOffersPageSize = 100
+
+// ListMembershipOffers lists the membership offers granted by the products of a location,
+// with the products and prices linked to each, in the order they are first found.
+// The API has no offers endpoint, so this reads every product and its prices; offers not
+// attached to any price are not listed.
+// Required scopes: products.readonly, products/prices.readonly
+func (s *ProductsService) ListMembershipOffers(ctx context.Context, locationID string) ([]LinkedMembershipOffer, error) {
+	var offers []LinkedMembershipOffer
+	index := map[string]int{}
+
+	for offset := 0; ; offset += membershipOffersPageSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := s.List(&ListProductsOptions{LocationID: locationID, Limit: membershipOffersPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, product := range result.Products {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			prices, err := s.ListPrices(locationID, product.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, price := range prices.Prices {
+				for _, offer := range price.MembershipOffers {
+					i, ok := index[offer.ID]
+					if !ok {
+						i = len(offers)
+						index[offer.ID] = i
+						offers = append(offers, LinkedMembershipOffer{Offer: offer})
+					}
+					linked := &offers[i]
+					if n := len(linked.Products); n == 0 || linked.Products[n-1].ID != product.ID {
+						linked.Products = append(linked.Products, product)
+					}
+					if price.Product == "" {
+						price.Product = product.ID
+					}
+					linked.Prices = append(linked.Prices, price)
+				}
+			}
+		}
+
+		if len(result.Products) < membershipOffersPageSize {
+			return offers, nil
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProductsService_ListMembershipOffers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/products/":
+			_, _ = w.Write([]byte(`{"products":[{"_id":"prod-1","name":"Course"},{"_id":"prod-2","name":"Bundle"},{"_id":"prod-3","name":"Mug"}]}`))
+		case "/products/prod-1/price":
+			_, _ = w.Write([]byte(`{"prices":[
+				{"_id":"price-1","name":"Monthly","membershipOffers":[{"_id":"offer-a","label":"Academy"}]},
+				{"_id":"price-2","name":"Yearly","membershipOffers":[{"_id":"offer-a","label":"Academy"}]}]}`))
+		case "/products/prod-2/price":
+			_, _ = w.Write([]byte(`{"prices":[{"_id":"price-3","product":"prod-2","membershipOffers":[
+				{"_id":"offer-a","label":"Academy"},{"_id":"offer-b","label":"Coaching"}]}]}`))
+		case "/products/prod-3/price":
+			_, _ = w.Write([]byte(`{"prices":[{"_id":"price-4"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	offers, err := client.Products.ListMembershipOffers(context.Background(), "loc-1")
+	if err != nil {
+		t.Fatalf("ListMembershipOffers failed: %v", err)
+	}
+	if len(offers) != 2 || offers[0].Offer.ID != "offer-a" || offers[1].Offer.ID != "offer-b" {
+		t.Fatalf("unexpected offers %+v", offers)
+	}
+
+	academy := offers[0]
+	if len(academy.Products) != 2 || academy.Products[0].ID != "prod-1" || academy.Products[1].ID != "prod-2" {
+		t.Errorf("unexpected academy products %+v", academy.Products)
+	}
+	if len(academy.Prices) != 3 || academy.Prices[0].Product != "prod-1" {
+		t.Errorf("unexpected academy prices %+v", academy.Prices)
+	}
+	if len(offers[1].Prices) != 1 || offers[1].Prices[0].ID != "price-3" {
+		t.Errorf("unexpected coaching prices %+v", offers[1].Prices)
+	}
+}
@@ -0,0 +1,100 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// mergeFieldPattern matches a merge field such as {{contact.first_name}} or {{ custom_values.promo }}
+var mergeFieldPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_.]+)\s*\}\}`)
+
+// RenderMergeFields renders the GoHighLevel merge fields in an SMS or email template client-side,
+// for previews before sending.
+//
+// Supported fields are the standard contact fields ({{contact.first_name}}, {{contact.email}},
+// {{contact.phone}}, ...), contact custom fields by key ({{contact.favorite_color}}) and location
+// custom values ({{custom_values.promo_code}}), looked up in customValues by key without the
+// "custom_values." prefix. Like GoHighLevel, fields without a value render as an empty string;
+// their names are returned so previews can flag them.
+func RenderMergeFields(template string, contact *Contact, customValues map[string]string) (string, []string) {
+	var missing []string
+	seen := make(map[string]bool)
+
+	rendered := mergeFieldPattern.ReplaceAllStringFunc(template, func(match string) string {
+		name := mergeFieldPattern.FindStringSubmatch(match)[1]
+
+		value, ok := resolveMergeField(name, contact, customValues)
+		if !ok && !seen[name] {
+			seen[name] = true
+			missing = append(missing, name)
+		}
+		return value
+	})
+
+	return rendered, missing
+}
+
+// resolveMergeField returns the value of a single merge field and whether it had a value
+func resolveMergeField(name string, contact *Contact, customValues map[string]string) (string, bool) {
+	switch {
+	case strings.HasPrefix(name, "custom_values."):
+		value := customValues[strings.TrimPrefix(name, "custom_values.")]
+		return value, value != ""
+	case strings.HasPrefix(name, "contact.") && contact != nil:
+		value := contactMergeField(contact, strings.TrimPrefix(name, "contact."))
+		return value, value != ""
+	}
+	return "", false
+}
+
+// contactMergeField returns the value of a standard or custom contact field by merge field key
+func contactMergeField(contact *Contact, key string) string {
+	switch key {
+	case "id":
+		return contact.ID
+	case "first_name":
+		return contact.FirstName
+	case "last_name":
+		return contact.LastName
+	case "name", "full_name":
+		if contact.ContactName != "" {
+			return contact.ContactName
+		}
+		return strings.TrimSpace(contact.FirstName + " " + contact.LastName)
+	case "email":
+		return contact.Email
+	case "phone":
+		return contact.Phone
+	case "company_name":
+		return contact.CompanyName
+	case "address1", "full_address":
+		return contact.Address1
+	case "city":
+		return contact.City
+	case "state":
+		return contact.State
+	case "country":
+		return contact.Country
+	case "postal_code":
+		return contact.PostalCode
+	case "website":
+		return contact.Website
+	case "source":
+		return contact.Source
+	case "date_of_birth":
+		return contact.DateOfBirth.String()
+	case "timezone":
+		return contact.Timezone
+	}
+
+	for _, field := range contact.CustomFields {
+		if field.Value == nil {
+			continue
+		}
+		if field.Key == key || field.Key == "contact."+key || field.ID == key {
+			return fmt.Sprint(field.Value)
+		}
+	}
+	return ""
+}
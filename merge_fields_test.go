@@ -0,0 +1,65 @@
+package gohighlevel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderMergeFields(t *testing.T) {
+	contact := &Contact{
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		Email:     "ada@example.com",
+		CustomFields: []CustomField{
+			{Key: "contact.favorite_color", Value: "green"},
+			{Key: "visits", Value: 3},
+		},
+	}
+	customValues := map[string]string{"promo_code": "SPRING25"}
+
+	tests := []struct {
+		name        string
+		template    string
+		want        string
+		wantMissing []string
+	}{
+		{
+			name:     "standard fields",
+			template: "Hi {{contact.first_name}}, we'll email {{ contact.email }}.",
+			want:     "Hi Ada, we'll email ada@example.com.",
+		},
+		{
+			name:     "derived full name",
+			template: "{{contact.name}}",
+			want:     "Ada Lovelace",
+		},
+		{
+			name:     "custom fields and values",
+			template: "{{contact.favorite_color}} x{{contact.visits}}: use {{custom_values.promo_code}}",
+			want:     "green x3: use SPRING25",
+		},
+		{
+			name:        "missing fields render empty",
+			template:    "{{contact.phone}}|{{custom_values.unknown}}|{{contact.phone}}|{{user.name}}",
+			want:        "|||",
+			wantMissing: []string{"contact.phone", "custom_values.unknown", "user.name"},
+		},
+		{
+			name:     "no merge fields",
+			template: "Plain {text}",
+			want:     "Plain {text}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, missing := RenderMergeFields(tt.template, contact, customValues)
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+			if !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("Expected missing %v, got %v", tt.wantMissing, missing)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Message represents a message of a conversation
+type Message struct {
+	ID             string   `json:"id,omitempty"`
+	Type           int      `json:"type,omitempty"`
+	MessageType    string   `json:"messageType,omitempty"` // e.g. "TYPE_SMS"
+	LocationID     string   `json:"locationId,omitempty"`
+	ContactID      string   `json:"contactId,omitempty"`
+	ConversationID string   `json:"conversationId,omitempty"`
+	Body           string   `json:"body,omitempty"`
+	Direction      string   `json:"direction,omitempty"` // See MessageDirection* constants
+	Status         string   `json:"status,omitempty"`    // See MessageStatus* constants
+	ContentType    string   `json:"contentType,omitempty"`
+	Attachments    []string `json:"attachments,omitempty"`
+	Error          string   `json:"error,omitempty"` // Reason reported by the provider when sending failed
+	DateAdded      Time     `json:"dateAdded,omitempty"`
+}
+
+// messageResponse represents a single message API response
+type messageResponse struct {
+	Message *Message `json:"message,omitempty"`
+}
+
+// Get retrieves a message by ID
+// Required scope: conversations/message.readonly
+func (s *MessagesService) Get(messageID string) (*Message, error) {
+	if messageID == "" {
+		return nil, fmt.Errorf("messageId is required")
+	}
+
+	var result messageResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/conversations/messages/%s", messageID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+// Delivery outcomes reported by WaitForDelivery
+const (
+	DeliveryDelivered = "delivered" // The message reached the recipient (or was read)
+	DeliveryFailed    = "failed"    // The message failed or was undelivered
+	DeliveryTimedOut  = "timed_out" // No final status was reported before the timeout
+)
+
+// WaitForDeliveryOptions configures WaitForDelivery
+type WaitForDeliveryOptions struct {
+	Timeout     time.Duration // How long to wait for a final status (default 2m)
+	MinInterval time.Duration // Delay before the first poll, doubled after each one (default 2s)
+	MaxInterval time.Duration // Longest delay between polls (default 30s)
+}
+
+// DeliveryOutcome is the result of WaitForDelivery
+type DeliveryOutcome struct {
+	Outcome string   // See Delivery* constants
+	Message *Message // The message as last polled, nil if it was never retrieved
+	Polls   int
+	Elapsed time.Duration
+}
+
+// WaitForDelivery polls a sent message with backoff until it is delivered or fails, or until
+// the timeout, e.g. to confirm a one-time code reached the contact. A timeout is reported as
+// DeliveryTimedOut rather than an error; an error is returned when ctx is done or a poll fails.
+// Required scope: conversations/message.readonly
+func (s *MessagesService) WaitForDelivery(ctx context.Context, messageID string, opts *WaitForDeliveryOptions) (*DeliveryOutcome, error) {
+	if messageID == "" {
+		return nil, fmt.Errorf("messageId is required")
+	}
+	o := WaitForDeliveryOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 2 * time.Minute
+	}
+	if o.MinInterval <= 0 {
+		o.MinInterval = 2 * time.Second
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = 30 * time.Second
+	}
+	o.MaxInterval = max(o.MaxInterval, o.MinInterval)
+
+	start := time.Now()
+	deadline := start.Add(o.Timeout)
+	outcome := &DeliveryOutcome{Outcome: DeliveryTimedOut}
+	interval := o.MinInterval
+
+	for {
+		wait := min(interval, time.Until(deadline))
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		message, err := s.Get(messageID)
+		if err != nil {
+			return nil, err
+		}
+		outcome.Message = message
+		outcome.Polls++
+		outcome.Elapsed = time.Since(start)
+
+		if message != nil {
+			switch message.Status {
+			case MessageStatusDelivered, MessageStatusRead:
+				outcome.Outcome = DeliveryDelivered
+				return outcome, nil
+			case MessageStatusFailed, MessageStatusUndelivered:
+				outcome.Outcome = DeliveryFailed
+				return outcome, nil
+			}
+		}
+		if !time.Now().Before(deadline) {
+			return outcome, nil
+		}
+		interval = min(interval*2, o.MaxInterval)
+	}
+}
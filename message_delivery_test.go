@@ -0,0 +1,64 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newMessageStatusClient serves message msg-1 with the given statuses, one per poll, repeating
+// the last
+func newMessageStatusClient(t *testing.T, statuses ...string) (*Client, *int32) {
+	var polls int32
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /conversations/messages/msg-1": func(w http.ResponseWriter, r *http.Request) {
+			n := int(atomic.AddInt32(&polls, 1)) - 1
+			status := statuses[min(n, len(statuses)-1)]
+			_, _ = w.Write([]byte(`{"message":{"id":"msg-1","status":"` + status + `","dateAdded":"2026-10-18T09:00:00.000Z"}}`))
+		},
+	})
+	return client, &polls
+}
+
+func TestMessagesService_WaitForDelivery(t *testing.T) {
+	client, polls := newMessageStatusClient(t, MessageStatusPending, MessageStatusSent, MessageStatusDelivered)
+	outcome, err := client.Messages.WaitForDelivery(context.Background(), "msg-1", &WaitForDeliveryOptions{
+		Timeout:     time.Second,
+		MinInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("WaitForDelivery failed: %v", err)
+	}
+	if outcome.Outcome != DeliveryDelivered || outcome.Polls != 3 || atomic.LoadInt32(polls) != 3 {
+		t.Errorf("outcome = %+v, polls = %d", outcome, atomic.LoadInt32(polls))
+	}
+	if outcome.Message.Status != MessageStatusDelivered {
+		t.Errorf("message = %+v", outcome.Message)
+	}
+}
+
+func TestMessagesService_WaitForDeliveryFailedAndTimeout(t *testing.T) {
+	client, _ := newMessageStatusClient(t, MessageStatusUndelivered)
+	outcome, err := client.Messages.WaitForDelivery(context.Background(), "msg-1", &WaitForDeliveryOptions{MinInterval: time.Millisecond})
+	if err != nil || outcome.Outcome != DeliveryFailed {
+		t.Errorf("outcome = %+v, err = %v", outcome, err)
+	}
+
+	client, _ = newMessageStatusClient(t, MessageStatusSent)
+	outcome, err = client.Messages.WaitForDelivery(context.Background(), "msg-1", &WaitForDeliveryOptions{
+		Timeout:     20 * time.Millisecond,
+		MinInterval: time.Millisecond,
+		MaxInterval: 5 * time.Millisecond,
+	})
+	if err != nil || outcome.Outcome != DeliveryTimedOut || outcome.Message.Status != MessageStatusSent {
+		t.Errorf("outcome = %+v, err = %v", outcome, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Messages.WaitForDelivery(ctx, "msg-1", nil); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"io"
+)
+
+// MessagesService handles operations related to conversation messages
+type MessagesService struct {
+	client *Client
+}
+
+// SendMessageRequest represents a request to send a message to a contact
+type SendMessageRequest struct {
+	Type               string   `json:"type"` // MessageType* constant, e.g. "SMS" or "Email"
+	ContactID          string   `json:"contactId"`
+	Message            string   `json:"message,omitempty"`
+	Subject            string   `json:"subject,omitempty"` // Email only
+	HTML               string   `json:"html,omitempty"`    // Email only
+	EmailFrom          string   `json:"emailFrom,omitempty"`
+	EmailTo            string   `json:"emailTo,omitempty"`
+	EmailCc            []string `json:"emailCc,omitempty"`
+	EmailBcc           []string `json:"emailBcc,omitempty"`
+	ReplyMessageID     string   `json:"replyMessageId,omitempty"`
+	FromNumber         string   `json:"fromNumber,omitempty"`
+	ToNumber           string   `json:"toNumber,omitempty"`
+	TemplateID         string   `json:"templateId,omitempty"`
+	Attachments        []string `json:"attachments,omitempty"`        // URLs
+	ScheduledTimestamp int64    `json:"scheduledTimestamp,omitempty"` // Unix seconds
+
+	// CheckDND fetches the contact before sending and fails with a *DNDError (matching
+	// ErrDNDActive) instead of sending if they have do not disturb enabled for the channel
+	CheckDND bool `json:"-"`
+}
+
+// SendMessageResponse represents the response to sending a message
+type SendMessageResponse struct {
+	ConversationID string `json:"conversationId,omitempty"`
+	MessageID      string `json:"messageId,omitempty"`
+	EmailMessageID string `json:"emailMessageId,omitempty"`
+	Msg            string `json:"msg,omitempty"`
+}
+
+// Send sends a message to a contact over the channel given by req.Type
+// Required scope: conversations/message.write
+func (s *MessagesService) Send(req *SendMessageRequest) (*SendMessageResponse, error) {
+	if req.Type == "" {
+		return nil, fmt.Errorf("type is required")
+	}
+	if req.ContactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+
+	if req.CheckDND {
+		contact, err := s.client.Contacts.Get(req.ContactID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check do not disturb settings: %w", err)
+		}
+		if err := contact.CheckDND(req.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	var result SendMessageResponse
+	err := s.client.doRequest("POST", "/conversations/messages", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SendWhatsAppTemplate sends an approved WhatsApp template to a contact, which is allowed outside
+// the 24-hour session window where free-form WhatsApp messages are rejected. The template's
+// variables are filled from the contact's fields as mapped in the template, since the API takes
+// only the template ID. Set TemplateID on a WhatsApp SendMessageRequest to send templates with
+// other options, e.g. in a Broadcast.
+// Required scope: conversations/message.write
+func (s *MessagesService) SendWhatsAppTemplate(contactID, templateID string) (*SendMessageResponse, error) {
+	if templateID == "" {
+		return nil, fmt.Errorf("templateId is required")
+	}
+	return s.Send(&SendMessageRequest{
+		Type:       MessageTypeWhatsApp,
+		ContactID:  contactID,
+		TemplateID: templateID,
+	})
+}
+
+// Recording is the audio of a call recording. Body streams straight from the API and must be closed.
+type Recording struct {
+	Body          io.ReadCloser
+	ContentType   string // e.g. "audio/x-wav"
+	ContentLength int64  // -1 when unknown
+}
+
+// GetRecording streams the recording of a call message, e.g. one referenced by an
+// OutboundMessage or InboundMessage webhook of type TYPE_CALL
+// Required scope: conversations/message.readonly
+func (s *MessagesService) GetRecording(locationID, messageID string) (*Recording, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if messageID == "" {
+		return nil, fmt.Errorf("messageId is required")
+	}
+
+	var result streamResult
+	err := s.client.doRequest("GET", fmt.Sprintf("/conversations/messages/%s/locations/%s/recording", messageID, locationID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recording{Body: result.body, ContentType: result.contentType, ContentLength: result.contentLength}, nil
+}
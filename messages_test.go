@@ -0,0 +1,143 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMessagesService_GetRecording(t *testing.T) {
+	audio := []byte("RIFF....WAVEfmt ")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations/messages/msg-1/locations/loc-1/recording" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "audio/x-wav")
+		_, _ = w.Write(audio)
+	}))
+	defer server.Close()
+
+	// Hedged GETs read responses in full, so recordings must bypass hedging
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, Hedging: &HedgePolicy{Delay: time.Second}})
+	rec, err := client.Messages.GetRecording("loc-1", "msg-1")
+	if err != nil {
+		t.Fatalf("GetRecording failed: %v", err)
+	}
+	defer rec.Body.Close()
+
+	data, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatalf("failed to read recording: %v", err)
+	}
+	if string(data) != string(audio) || rec.ContentType != "audio/x-wav" || rec.ContentLength != int64(len(audio)) {
+		t.Errorf("recording = %q (%s, %d bytes)", data, rec.ContentType, rec.ContentLength)
+	}
+
+	if _, err := client.Messages.GetRecording("loc-1", ""); err == nil {
+		t.Error("expected error for missing messageId")
+	}
+}
+
+func TestMessagesService_GetRecordingNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Recording not found"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	if _, err := client.Messages.GetRecording("loc-1", "msg-1"); err == nil {
+		t.Fatal("expected error for missing recording")
+	}
+}
+
+func TestMessagesService_SendCheckDND(t *testing.T) {
+	sent := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/contacts/c1":
+			_, _ = w.Write([]byte(`{"contact":{"id":"c1","dndSettings":{"SMS":{"status":"permanent"},"Email":{"status":"inactive"}}}}`))
+		case "/conversations/messages":
+			sent++
+			var req SendMessageRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.ContactID != "c1" {
+				t.Errorf("contactId = %q", req.ContactID)
+			}
+			_, _ = w.Write([]byte(`{"conversationId":"conv-1","messageId":"msg-1"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+
+	_, err := client.Messages.Send(&SendMessageRequest{Type: MessageTypeSMS, ContactID: "c1", Message: "hi", CheckDND: true})
+	var dndErr *DNDError
+	if !errors.Is(err, ErrDNDActive) || !errors.As(err, &dndErr) || dndErr.Status != DNDStatusPermanent {
+		t.Fatalf("SMS error = %v", err)
+	}
+	if sent != 0 {
+		t.Fatal("message sent despite DND")
+	}
+
+	resp, err := client.Messages.Send(&SendMessageRequest{Type: MessageTypeEmail, ContactID: "c1", Subject: "hi", HTML: "<p>hi</p>", CheckDND: true})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if resp.MessageID != "msg-1" || sent != 1 {
+		t.Errorf("response = %+v, sent = %d", resp, sent)
+	}
+}
+
+func TestMessagesService_SendWhatsAppTemplate(t *testing.T) {
+	var req SendMessageRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/conversations/messages" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_, _ = w.Write([]byte(`{"conversationId":"conv-1","messageId":"msg-1"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	if _, err := client.Messages.SendWhatsAppTemplate("c1", "tmpl-1"); err != nil {
+		t.Fatalf("SendWhatsAppTemplate failed: %v", err)
+	}
+	if req.Type != MessageTypeWhatsApp || req.ContactID != "c1" || req.TemplateID != "tmpl-1" || req.Message != "" {
+		t.Errorf("request = %+v", req)
+	}
+
+	if _, err := client.Messages.SendWhatsAppTemplate("c1", ""); err == nil {
+		t.Error("expected error for missing template")
+	}
+}
+
+func TestContact_CheckDND(t *testing.T) {
+	tests := []struct {
+		name    string
+		contact Contact
+		msgType string
+		blocked bool
+	}{
+		{"no settings", Contact{ID: "c1"}, MessageTypeSMS, false},
+		{"global", Contact{ID: "c1", DND: true}, MessageTypeLiveChat, true},
+		{"channel active", Contact{ID: "c1", DNDSettings: &DNDSettings{WhatsApp: &DNDSetting{Status: DNDStatusActive}}}, MessageTypeWhatsApp, true},
+		{"other channel", Contact{ID: "c1", DNDSettings: &DNDSettings{WhatsApp: &DNDSetting{Status: DNDStatusActive}}}, MessageTypeSMS, false},
+		{"inbound only", Contact{ID: "c1", InboundDNDSettings: &DNDSettings{SMS: &DNDSetting{Status: DNDStatusActive}}}, MessageTypeSMS, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.contact.CheckDND(tt.msgType)
+			if blocked := errors.Is(err, ErrDNDActive); blocked != tt.blocked {
+				t.Errorf("CheckDND(%s) = %v, want blocked %v", tt.msgType, err, tt.blocked)
+			}
+		})
+	}
+}
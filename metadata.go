@@ -0,0 +1,55 @@
+package gohighlevel
+
+import "context"
+
+// Metadata is caller-defined information attached to API requests, such as a tenant or
+// correlation ID. It is never sent to GoHighLevel; it is surfaced to middleware (through the
+// request context), request hooks and the token refresh callback so every call can be attributed.
+type Metadata map[string]string
+
+// merge returns a copy of m with the entries of other added, other taking precedence
+func (m Metadata) merge(other Metadata) Metadata {
+	if len(m) == 0 && len(other) == 0 {
+		return nil
+	}
+	merged := make(Metadata, len(m)+len(other))
+	for k, v := range m {
+		merged[k] = v
+	}
+	for k, v := range other {
+		merged[k] = v
+	}
+	return merged
+}
+
+type metadataContextKey struct{}
+
+// ContextWithMetadata returns a context carrying md, merged over any metadata already in ctx
+func ContextWithMetadata(ctx context.Context, md Metadata) context.Context {
+	if len(md) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, metadataContextKey{}, MetadataFromContext(ctx).merge(md))
+}
+
+// MetadataFromContext returns the metadata attached to ctx, or nil. Middleware can call it on
+// the request context to read the metadata of the API call being made.
+func MetadataFromContext(ctx context.Context) Metadata {
+	md, _ := ctx.Value(metadataContextKey{}).(Metadata)
+	return md
+}
+
+// WithMetadata returns a client that attaches md to every request it sends, in addition to
+// the client's own metadata. The returned client shares tokens, transport and configuration
+// with c, so it is cheap to create one per incoming request or per tenant:
+//
+//	tenantClient := client.WithMetadata(ghl.Metadata{"tenant": tenantID})
+//	contact, err := tenantClient.Contacts.Get(contactID)
+func (c *Client) WithMetadata(md Metadata) *Client {
+	return c.derive(func(d *Client) { d.metadata = c.metadata.merge(md) })
+}
+
+// Metadata returns the metadata the client attaches to its requests
+func (c *Client) Metadata() Metadata {
+	return c.metadata.merge(nil)
+}
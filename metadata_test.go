@@ -0,0 +1,72 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContextWithMetadata(t *testing.T) {
+	ctx := ContextWithMetadata(context.Background(), Metadata{"tenant": "a", "correlation": "1"})
+	ctx = ContextWithMetadata(ctx, Metadata{"correlation": "2"})
+
+	md := MetadataFromContext(ctx)
+	if md["tenant"] != "a" || md["correlation"] != "2" {
+		t.Errorf("MetadataFromContext = %v, want tenant=a correlation=2", md)
+	}
+	if MetadataFromContext(context.Background()) != nil {
+		t.Error("expected nil metadata on empty context")
+	}
+}
+
+func TestClient_WithMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	var middlewareMD Metadata
+	middleware := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			middlewareMD = MetadataFromContext(req.Context())
+			return next.RoundTrip(req)
+		})
+	}
+	var hookInfo RequestInfo
+
+	client, err := NewClientWithOptions(
+		WithAccessToken("token"),
+		WithBaseURL(server.URL),
+		WithDefaultMetadata(Metadata{"service": "sync"}),
+		WithMiddleware(middleware),
+		WithRequestHook(func(info RequestInfo) { hookInfo = info }),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+
+	tenantClient := client.WithMetadata(Metadata{"tenant": "acme"})
+	if _, err := tenantClient.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	if middlewareMD["service"] != "sync" || middlewareMD["tenant"] != "acme" {
+		t.Errorf("middleware metadata = %v", middlewareMD)
+	}
+	if hookInfo.Metadata["tenant"] != "acme" || hookInfo.StatusCode != http.StatusOK || hookInfo.Attempt != 1 {
+		t.Errorf("hook info = %+v", hookInfo)
+	}
+	if hookInfo.Method != "GET" || hookInfo.Path != "/contacts/c1" {
+		t.Errorf("hook request = %s %s", hookInfo.Method, hookInfo.Path)
+	}
+
+	// The parent client is unaffected, but tokens are shared
+	if _, ok := client.Metadata()["tenant"]; ok {
+		t.Error("WithMetadata modified the parent client")
+	}
+	client.SetAccessToken("new-token")
+	if tenantClient.GetAccessToken() != "new-token" {
+		t.Error("derived client does not share tokens with its parent")
+	}
+}
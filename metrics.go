@@ -0,0 +1,15 @@
+package gohighlevel
+
+// MetricsHook receives metrics about the requests a client sends. Implementations must be safe
+// for concurrent use. The ghlprom package provides an implementation exporting Prometheus
+// collectors.
+type MetricsHook interface {
+	// ObserveRequest is called after every attempt of an API request
+	ObserveRequest(info RequestInfo)
+	// ObserveRetry is called before a failed attempt is retried
+	ObserveRetry(info RequestInfo)
+	// ObserveTokenRefresh is called after an automatic token refresh, with its error if it failed
+	ObserveTokenRefresh(err error)
+	// ObserveRateLimit is called when a response reports the remaining rate limit of a location
+	ObserveRateLimit(locationID string, remaining, dailyRemaining int)
+}
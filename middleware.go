@@ -0,0 +1,51 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"time"
+)
+
+// Middleware wraps the HTTP transport used for API requests. Middleware can inspect and modify
+// requests and responses; MetadataFromContext(req.Context()) returns the request's metadata.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to an http.RoundTripper, for writing middleware
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// RequestInfo describes one completed attempt of an API request
+type RequestInfo struct {
+	Method     string
+	Path       string
+	StatusCode int // 0 if no response was received
+	Attempt    int // 1 for the first attempt, incremented on each retry
+	Duration   time.Duration
+	Err        error
+	Metadata   Metadata
+}
+
+// RequestHook is called after every attempt of an API request, e.g. for logging
+type RequestHook func(info RequestInfo)
+
+// withMiddleware returns a copy of httpClient whose transport is wrapped by middleware.
+// The first middleware is the outermost, so it sees requests first and responses last.
+func withMiddleware(httpClient *http.Client, middleware []Middleware) *http.Client {
+	if len(middleware) == 0 {
+		return httpClient
+	}
+
+	wrapped := *httpClient
+	transport := wrapped.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+	wrapped.Transport = transport
+	return &wrapped
+}
@@ -0,0 +1,72 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrTokensRevoked is returned, wrapping ErrAuthorizationFailed, for requests made after
+// RevokeTokens. Setting new tokens clears it.
+var ErrTokensRevoked = errors.New("tokens revoked")
+
+// UninstallRequest represents a request to uninstall the app from a company or location
+type UninstallRequest struct {
+	CompanyID  string `json:"companyId,omitempty"`
+	LocationID string `json:"locationId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// Uninstall uninstalls the app from a location, or from a company and all its locations when
+// only CompanyID is set. The installation's tokens stop working once it is removed.
+// Required scope: oauth.write
+func (s *MarketplaceService) Uninstall(appID string, req *UninstallRequest) error {
+	if appID == "" {
+		return fmt.Errorf("appId is required")
+	}
+	if req == nil || (req.CompanyID == "" && req.LocationID == "") {
+		return fmt.Errorf("companyId or locationId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/marketplace/app/%s/installations", appID), req, nil)
+}
+
+// Disconnect offboards an installation: it uninstalls the app, forgets the client's tokens with
+// RevokeTokens, and deletes the tokens stored under key when store is set. When the uninstall
+// fails the uninstall error is returned and the tokens are kept, so offboarding can be retried;
+// an installation that is already gone (404) is offboarded as usual.
+// Required scope: oauth.write
+func (s *MarketplaceService) Disconnect(ctx context.Context, appID string, req *UninstallRequest, store TokenStore, key string) error {
+	if err := s.Uninstall(appID, req); err != nil && !hasStatus(err, http.StatusNotFound) {
+		return err
+	}
+	s.client.RevokeTokens()
+	if store != nil {
+		if err := store.Delete(ctx, key); err != nil && !errors.Is(err, ErrTokensNotFound) {
+			return fmt.Errorf("failed to delete stored tokens: %w", err)
+		}
+	}
+	return nil
+}
+
+// RevokeTokens forgets the client's access and refresh tokens, which clients derived with
+// WithBaseURL or WithMetadata share, so no further requests are made with them. Requests fail
+// with ErrTokensRevoked until new tokens are set. The API has no token revocation endpoint;
+// uninstalling the app is what invalidates the tokens on GoHighLevel's side.
+func (c *Client) RevokeTokens() {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	c.tokens.accessToken = ""
+	c.tokens.refreshToken = ""
+	c.tokens.authFailure = ErrTokensRevoked
+	c.tokens.reported = nil
+}
+
+// revokedError returns the error for a request made after RevokeTokens, or nil
+func (c *Client) revokedError() error {
+	if authErr := c.authFailure(); errors.Is(authErr, ErrTokensRevoked) {
+		return fmt.Errorf("%w: %w", ErrAuthorizationFailed, authErr)
+	}
+	return nil
+}
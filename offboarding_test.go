@@ -0,0 +1,109 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMarketplace_Disconnect(t *testing.T) {
+	var uninstall UninstallRequest
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Method != http.MethodDelete || r.URL.Path != "/marketplace/app/app-1/installations" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&uninstall)
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	store := NewEncryptedTokenStore(&MemoryBlobStore{}, func(ctx context.Context) ([]byte, error) {
+		return make([]byte, 32), nil
+	})
+	ctx := context.Background()
+	if err := store.Save(ctx, "loc-1", &StoredTokens{AccessToken: "token", RefreshToken: "refresh"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	client, _ := NewClient(Config{AccessToken: "token", RefreshToken: "refresh", BaseURL: server.URL})
+	err := client.Marketplace.Disconnect(ctx, "app-1", &UninstallRequest{LocationID: "loc-1", Reason: "offboarding"}, store, "loc-1")
+	if err != nil {
+		t.Fatalf("Disconnect failed: %v", err)
+	}
+	if uninstall.LocationID != "loc-1" || uninstall.Reason != "offboarding" {
+		t.Errorf("Unexpected uninstall body: %+v", uninstall)
+	}
+	if client.GetAccessToken() != "" || client.GetRefreshToken() != "" {
+		t.Error("Expected tokens to be forgotten")
+	}
+	if _, err := store.Load(ctx, "loc-1"); !errors.Is(err, ErrTokensNotFound) {
+		t.Errorf("Expected stored tokens to be deleted, got %v", err)
+	}
+
+	_, err = client.Contacts.Get("contact-1")
+	if !errors.Is(err, ErrTokensRevoked) || !errors.Is(err, ErrAuthorizationFailed) {
+		t.Errorf("Expected ErrTokensRevoked, got %v", err)
+	}
+	if atomic.LoadInt32(&requests) != 1 {
+		t.Errorf("Expected no requests after revocation, got %d", requests)
+	}
+
+	client.SetAccessToken("new-token")
+	if err := client.revokedError(); err != nil {
+		t.Errorf("Expected new tokens to clear revocation, got %v", err)
+	}
+}
+
+func TestMarketplace_Disconnect_UninstallFails(t *testing.T) {
+	for _, tc := range []struct {
+		status     int
+		wantErr    bool
+		wantTokens bool
+	}{
+		{status: http.StatusInternalServerError, wantErr: true, wantTokens: true},
+		{status: http.StatusNotFound, wantErr: false, wantTokens: false},
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			w.Write([]byte(`{"message":"uninstall failed"}`))
+		}))
+
+		store := NewEncryptedTokenStore(&MemoryBlobStore{}, func(ctx context.Context) ([]byte, error) {
+			return make([]byte, 32), nil
+		})
+		ctx := context.Background()
+		if err := store.Save(ctx, "loc-1", &StoredTokens{AccessToken: "token", RefreshToken: "refresh"}); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		client, _ := NewClient(Config{AccessToken: "token", RefreshToken: "refresh", BaseURL: server.URL})
+		err := client.Marketplace.Disconnect(ctx, "app-1", &UninstallRequest{LocationID: "loc-1"}, store, "loc-1")
+		server.Close()
+		if (err != nil) != tc.wantErr {
+			t.Errorf("status %d: Disconnect error = %v, want error %v", tc.status, err, tc.wantErr)
+		}
+		if kept := client.GetAccessToken() == "token" && client.GetRefreshToken() == "refresh"; kept != tc.wantTokens {
+			t.Errorf("status %d: client tokens kept = %v, want %v", tc.status, kept, tc.wantTokens)
+		}
+		_, loadErr := store.Load(ctx, "loc-1")
+		if stored := loadErr == nil; stored != tc.wantTokens {
+			t.Errorf("status %d: stored tokens kept = %v (%v), want %v", tc.status, stored, loadErr, tc.wantTokens)
+		}
+	}
+}
+
+func TestMarketplace_Uninstall_Validation(t *testing.T) {
+	client, _ := NewClient(Config{AccessToken: "token"})
+	if err := client.Marketplace.Uninstall("app-1", &UninstallRequest{}); err == nil {
+		t.Error("Expected error without a company or location")
+	}
+	if err := client.Marketplace.Uninstall("", &UninstallRequest{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error without an app ID")
+	}
+}
@@ -0,0 +1,218 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OpportunitiesService handles operations related to opportunities and pipelines
+type OpportunitiesService struct {
+	client *Client
+}
+
+// Pipeline represents an opportunity pipeline
+type Pipeline struct {
+	ID             string          `json:"id,omitempty"`
+	Name           string          `json:"name,omitempty"`
+	LocationID     string          `json:"locationId,omitempty"`
+	Stages         []PipelineStage `json:"stages,omitempty"`
+	ShowInFunnel   bool            `json:"showInFunnel,omitempty"`
+	ShowInPieChart bool            `json:"showInPieChart,omitempty"`
+}
+
+// PipelineStage represents a stage of a pipeline
+type PipelineStage struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Position int    `json:"position,omitempty"`
+}
+
+// PipelinesResponse represents a list of pipelines API response
+type PipelinesResponse struct {
+	Pipelines []Pipeline `json:"pipelines,omitempty"`
+}
+
+// ListPipelines retrieves the pipelines of a location, including their stages
+// Required scope: opportunities.readonly
+func (s *OpportunitiesService) ListPipelines(locationID string) ([]Pipeline, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result PipelinesResponse
+	err := s.client.doRequest("GET", "/opportunities/pipelines?"+locationQuery(locationID).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Pipelines, nil
+}
+
+// Opportunity represents an opportunity (deal) in a pipeline
+type Opportunity struct {
+	ID                 string  `json:"id,omitempty"`
+	Name               string  `json:"name,omitempty"`
+	LocationID         string  `json:"locationId,omitempty"`
+	ContactID          string  `json:"contactId,omitempty"`
+	PipelineID         string  `json:"pipelineId,omitempty"`
+	PipelineStageID    string  `json:"pipelineStageId,omitempty"`
+	MonetaryValue      float64 `json:"monetaryValue,omitempty"`
+	AssignedTo         string  `json:"assignedTo,omitempty"` // User ID
+	Status             string  `json:"status,omitempty"`     // "open", "won", "lost" or "abandoned"
+	Source             string  `json:"source,omitempty"`
+	LastStatusChangeAt Time    `json:"lastStatusChangeAt,omitempty"`
+	LastStageChangeAt  Time    `json:"lastStageChangeAt,omitempty"`
+	CreatedAt          Time    `json:"createdAt,omitempty"`
+	UpdatedAt          Time    `json:"updatedAt,omitempty"`
+}
+
+// SearchOpportunitiesOptions represents query options for searching opportunities
+type SearchOpportunitiesOptions struct {
+	LocationID string
+	Query      string
+	PipelineID string
+	StageID    string
+	ContactID  string
+	Status     string    // "open", "won", "lost", "abandoned" or "all"
+	AssignedTo string    // User ID
+	StartDate  time.Time // Only opportunities created on or after this day
+	EndDate    time.Time // Only opportunities created on or before this day
+	Limit      int       // Page size, at most 100 (default 20)
+
+	// Cursor of the page to fetch, from the meta of the previous page
+	StartAfter   int64
+	StartAfterID string
+	// PageToken resumes a search from Page.NextPageToken, overriding StartAfter and StartAfterID
+	PageToken string
+}
+
+// OpportunitiesResponse represents a list of opportunities API response
+type OpportunitiesResponse struct {
+	Opportunities []Opportunity      `json:"opportunities,omitempty"`
+	Meta          *OpportunitiesMeta `json:"meta,omitempty"`
+}
+
+// OpportunitiesMeta represents the pagination metadata of an opportunity search
+type OpportunitiesMeta struct {
+	Total        int    `json:"total,omitempty"`
+	StartAfter   int64  `json:"startAfter,omitempty"`
+	StartAfterID string `json:"startAfterId,omitempty"`
+	NextPageURL  string `json:"nextPageUrl,omitempty"`
+}
+
+// Search retrieves a page of the opportunities of a location matching opts
+// Required scope: opportunities.readonly
+func (s *OpportunitiesService) Search(opts *SearchOpportunitiesOptions) (*OpportunitiesResponse, error) {
+	if opts == nil {
+		opts = &SearchOpportunitiesOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if opts.PageToken != "" {
+		startAfter, id, err := parseOpportunityPageToken(opts.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		opts.StartAfter, opts.StartAfterID = startAfter, id
+	}
+
+	query := url.Values{}
+	query.Set("location_id", opts.LocationID)
+	if opts.Query != "" {
+		query.Set("q", opts.Query)
+	}
+	if opts.PipelineID != "" {
+		query.Set("pipeline_id", opts.PipelineID)
+	}
+	if opts.StageID != "" {
+		query.Set("pipeline_stage_id", opts.StageID)
+	}
+	if opts.ContactID != "" {
+		query.Set("contact_id", opts.ContactID)
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if opts.AssignedTo != "" {
+		query.Set("assigned_to", opts.AssignedTo)
+	}
+	if !opts.StartDate.IsZero() {
+		query.Set("date", opts.StartDate.Format("01-02-2006"))
+	}
+	if !opts.EndDate.IsZero() {
+		query.Set("endDate", opts.EndDate.Format("01-02-2006"))
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.StartAfterID != "" {
+		query.Set("startAfter", strconv.FormatInt(opts.StartAfter, 10))
+		query.Set("startAfterId", opts.StartAfterID)
+	}
+
+	var result OpportunitiesResponse
+	err := s.client.doRequest("GET", "/opportunities/search?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchPage retrieves a page of opportunities like Search, with HasMore and NextPage for
+// walking every match one page at a time
+// Required scope: opportunities.readonly
+func (s *OpportunitiesService) SearchPage(ctx context.Context, opts *SearchOpportunitiesOptions) (*Page[Opportunity], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &SearchOpportunitiesOptions{}
+	}
+	current := *opts
+
+	result, err := s.Search(&current)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[Opportunity]{Items: result.Opportunities}
+	meta := result.Meta
+	if meta != nil {
+		page.Total = meta.Total
+		page.HasMore = len(result.Opportunities) > 0 && meta.StartAfterID != "" && meta.NextPageURL != ""
+	}
+	if page.HasMore {
+		page.NextPageToken = opportunityPageToken(meta.StartAfter, meta.StartAfterID)
+		nextOpts := current
+		nextOpts.PageToken = page.NextPageToken
+		page.next = func(ctx context.Context) (*Page[Opportunity], error) {
+			return s.SearchPage(ctx, &nextOpts)
+		}
+	}
+	return page, nil
+}
+
+// opportunityPageToken encodes the cursor of an opportunity search page
+func opportunityPageToken(startAfter int64, startAfterID string) string {
+	return cursorTokenPrefix + strconv.FormatInt(startAfter, 10) + ":" + startAfterID
+}
+
+// parseOpportunityPageToken returns the cursor encoded in an opportunity page token
+func parseOpportunityPageToken(token string) (int64, string, error) {
+	if cursor, ok := strings.CutPrefix(token, cursorTokenPrefix); ok {
+		startAfter, id, found := strings.Cut(cursor, ":")
+		if n, err := strconv.ParseInt(startAfter, 10, 64); found && err == nil && id != "" {
+			return n, id, nil
+		}
+	}
+	return 0, "", fmt.Errorf("invalid page token %q", token)
+}
@@ -0,0 +1,86 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+)
+
+// ValueStats aggregates the monetary value of a group of opportunities
+type ValueStats struct {
+	Count int
+	Total float64
+}
+
+// Mean returns the average monetary value, 0 for an empty group
+func (v ValueStats) Mean() float64 {
+	if v.Count == 0 {
+		return 0
+	}
+	return v.Total / float64(v.Count)
+}
+
+func (v *ValueStats) add(value float64) {
+	v.Count++
+	v.Total += value
+}
+
+// OpportunityReport aggregates the opportunities matching a search. Groups are keyed by ID;
+// opportunities without an owner are grouped under "".
+type OpportunityReport struct {
+	All        ValueStats
+	ByPipeline map[string]ValueStats
+	ByStage    map[string]ValueStats
+	ByStatus   map[string]ValueStats
+	ByOwner    map[string]ValueStats
+}
+
+func newOpportunityReport() *OpportunityReport {
+	return &OpportunityReport{
+		ByPipeline: make(map[string]ValueStats),
+		ByStage:    make(map[string]ValueStats),
+		ByStatus:   make(map[string]ValueStats),
+		ByOwner:    make(map[string]ValueStats),
+	}
+}
+
+// Add counts an opportunity in the report, for aggregating opportunities from another source
+func (r *OpportunityReport) Add(o Opportunity) {
+	r.All.add(o.MonetaryValue)
+	addToGroup(r.ByPipeline, o.PipelineID, o.MonetaryValue)
+	addToGroup(r.ByStage, o.PipelineStageID, o.MonetaryValue)
+	addToGroup(r.ByStatus, o.Status, o.MonetaryValue)
+	addToGroup(r.ByOwner, o.AssignedTo, o.MonetaryValue)
+}
+
+func addToGroup(groups map[string]ValueStats, key string, value float64) {
+	stats := groups[key]
+	stats.add(value)
+	groups[key] = stats
+}
+
+// Report walks every opportunity matching opts, e.g. a pipeline over a date range set with
+// StartDate and EndDate, and aggregates count and monetary value per pipeline, stage, status
+// and owner. Pages are aggregated as they arrive, so large pipelines are never held in memory.
+// Required scope: opportunities.readonly
+func (s *OpportunitiesService) Report(ctx context.Context, opts *SearchOpportunitiesOptions) (*OpportunityReport, error) {
+	report := newOpportunityReport()
+	if opts == nil {
+		opts = &SearchOpportunitiesOptions{}
+	}
+	pageOpts := *opts
+	if pageOpts.Limit <= 0 {
+		pageOpts.Limit = 100
+	}
+
+	page, err := s.SearchPage(ctx, &pageOpts)
+	for err == nil {
+		for _, o := range page.Items {
+			report.Add(o)
+		}
+		page, err = page.NextPage(ctx)
+	}
+	if !errors.Is(err, ErrNoMorePages) {
+		return nil, err
+	}
+	return report, nil
+}
@@ -0,0 +1,71 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOpportunitiesService_Report(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("location_id") != "loc-1" || q.Get("pipeline_id") != "pl-1" || q.Get("date") != "01-01-2026" || q.Get("endDate") != "01-31-2026" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		switch q.Get("startAfterId") {
+		case "":
+			_, _ = w.Write([]byte(`{"opportunities":[
+				{"id":"o1","pipelineId":"pl-1","pipelineStageId":"st-1","status":"open","assignedTo":"u1","monetaryValue":100},
+				{"id":"o2","pipelineId":"pl-1","pipelineStageId":"st-2","status":"won","assignedTo":"u1","monetaryValue":300}
+			],"meta":{"total":3,"startAfter":1767225600000,"startAfterId":"o2","nextPageUrl":"https://example.com/next"}}`))
+		case "o2":
+			if q.Get("startAfter") != "1767225600000" {
+				t.Errorf("startAfter = %s", q.Get("startAfter"))
+			}
+			_, _ = w.Write([]byte(`{"opportunities":[
+				{"id":"o3","pipelineId":"pl-1","pipelineStageId":"st-2","status":"won","monetaryValue":500}
+			],"meta":{"total":3}}`))
+		default:
+			t.Errorf("unexpected cursor %s", q.Get("startAfterId"))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	report, err := client.Opportunities.Report(context.Background(), &SearchOpportunitiesOptions{
+		LocationID: "loc-1",
+		PipelineID: "pl-1",
+		StartDate:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Report failed: %v", err)
+	}
+
+	if report.All.Count != 3 || report.All.Total != 900 || report.All.Mean() != 300 {
+		t.Errorf("All = %+v", report.All)
+	}
+	if won := report.ByStatus["won"]; won.Count != 2 || won.Total != 800 {
+		t.Errorf("won = %+v", won)
+	}
+	if stage := report.ByStage["st-2"]; stage.Mean() != 400 {
+		t.Errorf("stage st-2 mean = %v", stage.Mean())
+	}
+	if owner, unassigned := report.ByOwner["u1"], report.ByOwner[""]; owner.Total != 400 || unassigned.Count != 1 {
+		t.Errorf("owners = %+v", report.ByOwner)
+	}
+}
+
+func TestParseOpportunityPageToken(t *testing.T) {
+	startAfter, id, err := parseOpportunityPageToken(opportunityPageToken(1767225600000, "o2"))
+	if err != nil || startAfter != 1767225600000 || id != "o2" {
+		t.Errorf("round trip = %d, %s, %v", startAfter, id, err)
+	}
+	for _, token := range []string{"o:10", "c:abc:o2", "c:123"} {
+		if _, _, err := parseOpportunityPageToken(token); err == nil {
+			t.Errorf("token %q accepted", token)
+		}
+	}
+}
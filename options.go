@@ -0,0 +1,171 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created with NewClientWithOptions
+type Option func(*Config)
+
+// NewClientWithOptions creates a new GoHighLevel API client from functional options.
+// It is equivalent to NewClient with a Config built by applying opts in order.
+//
+//	client, err := NewClientWithOptions(
+//		WithAccessToken(token),
+//		WithRetry(DefaultRetryPolicy()),
+//		WithRateLimiter(NewRateLimiter(100, 10*time.Second)),
+//	)
+func NewClientWithOptions(opts ...Option) (*Client, error) {
+	var config Config
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return NewClient(config)
+}
+
+// WithCredentials sets the OAuth client ID and secret, required for authorization and token refresh
+func WithCredentials(clientID, clientSecret string) Option {
+	return func(c *Config) {
+		c.ClientID = clientID
+		c.ClientSecret = clientSecret
+	}
+}
+
+// WithAccessToken sets the access token
+func WithAccessToken(accessToken string) Option {
+	return func(c *Config) { c.AccessToken = accessToken }
+}
+
+// WithRefreshToken sets the refresh token
+func WithRefreshToken(refreshToken string) Option {
+	return func(c *Config) { c.RefreshToken = refreshToken }
+}
+
+// WithLocationID sets the default location ID
+func WithLocationID(locationID string) Option {
+	return func(c *Config) { c.LocationID = locationID }
+}
+
+// WithBaseURL overrides the API base URL
+func WithBaseURL(baseURL string) Option {
+	return func(c *Config) { c.BaseURL = baseURL }
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Config) { c.HTTPClient = httpClient }
+}
+
+// WithTimeout sets the request timeout of the default HTTP client.
+// It has no effect when combined with WithHTTPClient.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Config) { c.Timeout = timeout }
+}
+
+// WithTransport tunes the connection pool and HTTP/2 settings of the default HTTP client.
+// It cannot be combined with WithHTTPClient.
+func WithTransport(opts *TransportOptions) Option {
+	return func(c *Config) { c.Transport = opts }
+}
+
+// WithAutoRefresh enables automatic token refresh on 401 responses and registers a callback
+// that receives the new tokens (may be nil)
+func WithAutoRefresh(onTokenRefresh TokenRefreshCallback) Option {
+	return func(c *Config) {
+		c.AutoRefreshOn401 = true
+		c.OnTokenRefresh = onTokenRefresh
+	}
+}
+
+// WithRequestValidation enables client-side validation of contact create/upsert requests
+func WithRequestValidation() Option {
+	return func(c *Config) { c.ValidateRequests = true }
+}
+
+// WithRetry enables automatic retries of failed requests
+func WithRetry(policy *RetryPolicy) Option {
+	return func(c *Config) { c.RetryPolicy = policy }
+}
+
+// WithRateLimiter paces outgoing requests through limiter
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Config) { c.RateLimiter = limiter }
+}
+
+// WithMiddleware wraps the HTTP transport with middleware, outermost first
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(c *Config) { c.Middleware = append(c.Middleware, middleware...) }
+}
+
+// WithRequestHook registers a hook called after every request attempt
+func WithRequestHook(hook RequestHook) Option {
+	return func(c *Config) { c.OnRequest = hook }
+}
+
+// WithDefaultMetadata attaches metadata to every request sent by the client
+func WithDefaultMetadata(md Metadata) Option {
+	return func(c *Config) { c.Metadata = c.Metadata.merge(md) }
+}
+
+// WithMaxResponseSize rejects response bodies larger than maxBytes with ErrResponseTooLarge
+func WithMaxResponseSize(maxBytes int64) Option {
+	return func(c *Config) { c.MaxResponseSize = maxBytes }
+}
+
+// WithRetryBudget limits retries across all requests of the client
+func WithRetryBudget(budget *RetryBudget) Option {
+	return func(c *Config) { c.RetryBudget = budget }
+}
+
+// WithAdaptiveConcurrency adaptively limits the number of requests the client has in flight
+func WithAdaptiveConcurrency(limiter *AdaptiveConcurrency) Option {
+	return func(c *Config) { c.Concurrency = limiter }
+}
+
+// WithMetrics registers a hook that receives request metrics
+func WithMetrics(metrics MetricsHook) Option {
+	return func(c *Config) { c.Metrics = metrics }
+}
+
+// WithBaseURLOverride sends requests whose path starts with pathPrefix (e.g. "/contacts") to
+// baseURL instead of the default base URL
+func WithBaseURLOverride(pathPrefix, baseURL string) Option {
+	return func(c *Config) {
+		if c.BaseURLOverrides == nil {
+			c.BaseURLOverrides = make(map[string]string)
+		}
+		c.BaseURLOverrides[pathPrefix] = baseURL
+	}
+}
+
+// WithEnvironment sets the API base URL and OAuth token URL together
+func WithEnvironment(env *Environment) Option {
+	return func(c *Config) { c.Environment = env }
+}
+
+// WithTimingHook registers a hook that receives the DNS/TLS/TTFB timing breakdown of every request
+func WithTimingHook(hook TimingHook) Option {
+	return func(c *Config) { c.OnTiming = hook }
+}
+
+// WithContactCache enables the read-through cache in front of Contacts.Get
+func WithContactCache(opts ContactCacheOptions) Option {
+	return func(c *Config) { c.ContactCache = &opts }
+}
+
+// WithHedging hedges GET requests that take longer than delay, see HedgePolicy
+func WithHedging(delay time.Duration) Option {
+	return func(c *Config) { c.Hedging = &HedgePolicy{Delay: delay} }
+}
+
+// WithAuthFailureHandler registers a callback for when the client's authorization fails
+// permanently, see AuthFailureCallback
+func WithAuthFailureHandler(cb AuthFailureCallback) Option {
+	return func(c *Config) { c.OnAuthFailure = cb }
+}
+
+// WithRateLimiterSet paces requests with one limiter per location, see RateLimiterSet
+func WithRateLimiterSet(limiters *RateLimiterSet) Option {
+	return func(c *Config) { c.RateLimiters = limiters }
+}
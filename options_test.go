@@ -0,0 +1,137 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	limiter := NewRateLimiter(10, time.Second)
+	client, err := NewClientWithOptions(
+		WithCredentials("id", "secret"),
+		WithAccessToken("token"),
+		WithLocationID("loc"),
+		WithBaseURL("https://example.com"),
+		WithTimeout(5*time.Second),
+		WithRetry(DefaultRetryPolicy()),
+		WithRateLimiter(limiter),
+		WithRequestValidation(),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+
+	if client.clientID != "id" || client.clientSecret != "secret" {
+		t.Errorf("credentials not set: %q %q", client.clientID, client.clientSecret)
+	}
+	if client.GetAccessToken() != "token" || client.locationID != "loc" || client.BaseURL != "https://example.com" {
+		t.Errorf("unexpected client values: %q %q %q", client.GetAccessToken(), client.locationID, client.BaseURL)
+	}
+	if client.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want 5s", client.HTTPClient.Timeout)
+	}
+	if client.retryPolicy == nil || client.rateLimiter != limiter || !client.validateRequests {
+		t.Error("behavior options not applied")
+	}
+}
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	p := DefaultRetryPolicy()
+	netErr := errors.New("connection reset")
+
+	tests := []struct {
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{http.MethodPost, http.StatusTooManyRequests, errors.New("rate limited"), true},
+		{http.MethodGet, http.StatusBadGateway, errors.New("bad gateway"), true},
+		{http.MethodPost, http.StatusBadGateway, errors.New("bad gateway"), false},
+		{http.MethodGet, 0, netErr, true},
+		{http.MethodPost, 0, netErr, false},
+		{http.MethodGet, http.StatusNotFound, errors.New("not found"), false},
+		{http.MethodGet, http.StatusOK, nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := p.shouldRetry(tt.method, tt.status, tt.err); got != tt.want {
+			t.Errorf("shouldRetry(%s, %d) = %v, want %v", tt.method, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}.withDefaults()
+
+	header := http.Header{}
+	header.Set("Retry-After", "2")
+	if got := p.backoff(0, header); got != time.Second {
+		t.Errorf("Retry-After backoff = %v, want capped at 1s", got)
+	}
+
+	for retry := 0; retry < 10; retry++ {
+		if got := p.backoff(retry, nil); got <= 0 || got > time.Second {
+			t.Errorf("backoff(%d) = %v, want within (0, 1s]", retry, got)
+		}
+	}
+}
+
+func TestRetryPolicy_WithDefaults(t *testing.T) {
+	if got := (RetryPolicy{}).withDefaults().MaxRetries; got != 3 {
+		t.Errorf("MaxRetries = %d, want the default of 3", got)
+	}
+	if got := (RetryPolicy{MaxRetries: NoRetries}).withDefaults().MaxRetries; got != 0 {
+		t.Errorf("MaxRetries = %d, want 0 for NoRetries", got)
+	}
+	if got := (RetryPolicy{MaxRetries: 5}).withDefaults().MaxRetries; got != 5 {
+		t.Errorf("MaxRetries = %d, want 5", got)
+	}
+}
+
+func TestTokenBucketLimiter(t *testing.T) {
+	limiter := NewRateLimiter(2, time.Hour)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("Wait %d failed: %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait on empty bucket = %v, want deadline exceeded", err)
+	}
+}
+
+func TestNewClient_TransportOptions(t *testing.T) {
+	client, err := NewClientWithOptions(WithTransport(&TransportOptions{
+		MaxConnsPerHost:   50,
+		ForceAttemptHTTP2: true,
+		Proxy:             http.ProxyFromEnvironment,
+	}))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions failed: %v", err)
+	}
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport is %T, want *http.Transport", client.HTTPClient.Transport)
+	}
+	if transport.MaxConnsPerHost != 50 || !transport.ForceAttemptHTTP2 || transport.Proxy == nil {
+		t.Errorf("transport options not applied: %+v", transport)
+	}
+	if transport.MaxIdleConnsPerHost != 10 || client.HTTPClient.Timeout != DefaultTimeout {
+		t.Error("unset options should keep their defaults")
+	}
+
+	_, err = NewClientWithOptions(WithHTTPClient(&http.Client{}), WithTransport(&TransportOptions{}))
+	if err == nil {
+		t.Error("expected error combining WithHTTPClient and WithTransport")
+	}
+}
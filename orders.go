@@ -0,0 +1,203 @@
+package gohighlevel
+
+import "fmt"
+
+// OrdersService handles operations related to payment orders
+type OrdersService struct {
+	client *Client
+}
+
+// FulfillmentTracking represents shipment tracking information for a fulfillment
+type FulfillmentTracking struct {
+	TrackingNumber  string `json:"trackingNumber,omitempty"`
+	ShippingCarrier string `json:"shippingCarrier,omitempty"`
+	TrackingURL     string `json:"trackingUrl,omitempty"`
+}
+
+// FulfillmentItem represents an order item included in a fulfillment
+type FulfillmentItem struct {
+	PriceID string `json:"priceId"`
+	Qty     int    `json:"qty"`
+}
+
+// FulfilledItem represents an order item as returned on a fulfillment
+type FulfilledItem struct {
+	ID      string                 `json:"_id,omitempty"`
+	Name    string                 `json:"name,omitempty"`
+	Product map[string]interface{} `json:"product,omitempty"`
+	Price   map[string]interface{} `json:"price,omitempty"`
+	Qty     int                    `json:"qty,omitempty"`
+}
+
+// Fulfillment represents a shipment of some or all items of an order
+type Fulfillment struct {
+	ID             string                `json:"_id,omitempty"`
+	AltID          string                `json:"altId,omitempty"`
+	AltType        string                `json:"altType,omitempty"`
+	Trackings      []FulfillmentTracking `json:"trackings,omitempty"`
+	Items          []FulfilledItem       `json:"items,omitempty"`
+	NotifyCustomer bool                  `json:"notifyCustomer,omitempty"`
+	CreatedAt      Time                  `json:"createdAt,omitempty"`
+	UpdatedAt      Time                  `json:"updatedAt,omitempty"`
+}
+
+// CreateFulfillmentRequest represents a request to create a fulfillment for an order
+type CreateFulfillmentRequest struct {
+	LocationID     string                `json:"altId"`
+	AltType        string                `json:"altType"` // Defaults to "location"
+	Trackings      []FulfillmentTracking `json:"trackings"`
+	Items          []FulfillmentItem     `json:"items"`
+	NotifyCustomer bool                  `json:"notifyCustomer"`
+}
+
+// FulfillmentResponse represents a single fulfillment API response
+type FulfillmentResponse struct {
+	Status bool         `json:"status,omitempty"`
+	Data   *Fulfillment `json:"data,omitempty"`
+}
+
+// FulfillmentsResponse represents a list of fulfillments API response
+type FulfillmentsResponse struct {
+	Status bool          `json:"status,omitempty"`
+	Data   []Fulfillment `json:"data,omitempty"`
+}
+
+// CreateFulfillment records a fulfillment (shipment) for an order
+// Required scope: payments/orders.write
+func (s *OrdersService) CreateFulfillment(orderID string, req *CreateFulfillmentRequest) (*Fulfillment, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("orderId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("at least one item is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+	if req.Trackings == nil {
+		req.Trackings = []FulfillmentTracking{}
+	}
+
+	var result FulfillmentResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/payments/orders/%s/fulfillments", orderID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// ListFulfillments retrieves all fulfillments for an order
+// Required scope: payments/orders.readonly
+func (s *OrdersService) ListFulfillments(locationID, orderID string) ([]Fulfillment, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if orderID == "" {
+		return nil, fmt.Errorf("orderId is required")
+	}
+
+	var result FulfillmentsResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/payments/orders/%s/fulfillments?%s", orderID, altLocationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// Order represents a payment order
+type Order struct {
+	ID                string                 `json:"_id,omitempty"`
+	AltID             string                 `json:"altId,omitempty"`
+	AltType           string                 `json:"altType,omitempty"`
+	ContactID         string                 `json:"contactId,omitempty"`
+	ContactName       string                 `json:"contactName,omitempty"`
+	ContactEmail      string                 `json:"contactEmail,omitempty"`
+	Currency          string                 `json:"currency,omitempty"`
+	Amount            float64                `json:"amount,omitempty"`
+	Subtotal          float64                `json:"subtotal,omitempty"`
+	Discount          float64                `json:"discount,omitempty"`
+	Status            string                 `json:"status,omitempty"`
+	FulfillmentStatus string                 `json:"fulfillmentStatus,omitempty"`
+	LiveMode          bool                   `json:"liveMode,omitempty"`
+	TotalProducts     int                    `json:"totalProducts,omitempty"`
+	SourceType        string                 `json:"sourceType,omitempty"`
+	SourceName        string                 `json:"sourceName,omitempty"`
+	SourceID          string                 `json:"sourceId,omitempty"`
+	SourceMeta        map[string]interface{} `json:"sourceMeta,omitempty"`
+	CouponCode        string                 `json:"couponCode,omitempty"`
+	CreatedAt         Time                   `json:"createdAt,omitempty"`
+	UpdatedAt         Time                   `json:"updatedAt,omitempty"`
+}
+
+// ListOrdersOptions represents query options for listing orders
+type ListOrdersOptions struct {
+	LocationID  string
+	ContactID   string
+	Status      string
+	PaymentMode string // "live" or "test"
+	Search      string
+	StartAt     string // YYYY-MM-DD
+	EndAt       string // YYYY-MM-DD
+	Limit       int
+	Offset      int
+}
+
+// OrdersResponse represents a list of orders API response
+type OrdersResponse struct {
+	Data       []Order `json:"data,omitempty"`
+	TotalCount int     `json:"totalCount,omitempty"`
+}
+
+// List retrieves orders for a location with optional filters
+// Required scope: payments/orders.readonly
+func (s *OrdersService) List(opts *ListOrdersOptions) (*OrdersResponse, error) {
+	if opts == nil {
+		opts = &ListOrdersOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := paymentsListQuery(opts.LocationID, opts.ContactID, opts.PaymentMode, opts.Search, opts.StartAt, opts.EndAt, opts.Limit, opts.Offset)
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+
+	var result OrdersResponse
+	err := s.client.doRequest("GET", "/payments/orders?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListByContact retrieves every order of a contact, across all pages
+// Required scope: payments/orders.readonly
+func (s *OrdersService) ListByContact(locationID, contactID string) ([]Order, error) {
+	if contactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+
+	var orders []Order
+	for offset := 0; ; offset += paymentsPageSize {
+		result, err := s.List(&ListOrdersOptions{LocationID: locationID, ContactID: contactID, Limit: paymentsPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, result.Data...)
+		if len(result.Data) < paymentsPageSize || len(orders) >= result.TotalCount {
+			return orders, nil
+		}
+	}
+}
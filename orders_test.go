@@ -0,0 +1,54 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestOrdersService_Fulfillments(t *testing.T) {
+	var created map[string]json.RawMessage
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /payments/orders/order-1/fulfillments": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"status":true,"data":{"_id":"ful-1","altId":"loc-1","items":[{"_id":"item-1","qty":1}]}}`))
+		},
+		"GET /payments/orders/order-1/fulfillments": func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("altId") != "loc-1" || q.Get("altType") != "location" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"data":[{"_id":"ful-1"},{"_id":"ful-2"}]}`))
+		},
+	})
+
+	fulfillment, err := client.Orders.CreateFulfillment("order-1", &CreateFulfillmentRequest{
+		LocationID: "loc-1",
+		Items:      []FulfillmentItem{{PriceID: "price-1", Qty: 1}},
+	})
+	if err != nil {
+		t.Fatalf("CreateFulfillment failed: %v", err)
+	}
+	if fulfillment.ID != "ful-1" || len(fulfillment.Items) != 1 {
+		t.Errorf("Unexpected fulfillment: %+v", fulfillment)
+	}
+	if string(created["altId"]) != `"loc-1"` || string(created["altType"]) != `"location"` || string(created["trackings"]) != `[]` {
+		t.Errorf("Unexpected create request: %v", created)
+	}
+	if _, err := client.Orders.CreateFulfillment("order-1", &CreateFulfillmentRequest{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error for a fulfillment without items")
+	}
+	if _, err := client.Orders.CreateFulfillment("", &CreateFulfillmentRequest{}); err == nil {
+		t.Error("Expected error for missing orderId")
+	}
+
+	fulfillments, err := client.Orders.ListFulfillments("loc-1", "order-1")
+	if err != nil {
+		t.Fatalf("ListFulfillments failed: %v", err)
+	}
+	if len(fulfillments) != 2 || fulfillments[1].ID != "ful-2" {
+		t.Errorf("Unexpected fulfillments: %+v", fulfillments)
+	}
+	if _, err := client.Orders.ListFulfillments("loc-1", ""); err == nil {
+		t.Error("Expected error for missing orderId")
+	}
+}
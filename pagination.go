@@ -0,0 +1,113 @@
+package gohighlevel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// ErrNoMorePages is returned by Page.NextPage after the last page
+var ErrNoMorePages = errors.New("no more pages")
+
+// Page is one page of a list call, with what is needed to fetch the next one
+type Page[T any] struct {
+	Items []T
+	Total int // Total number of matching items as reported by the API, 0 if not reported
+	// NextPageToken resumes listing after this page, e.g. from a later HTTP request of your own
+	// app; see the PageToken option of the call that returned the page. Empty on the last page.
+	NextPageToken string
+	HasMore       bool
+
+	next func(ctx context.Context) (*Page[T], error)
+}
+
+// NextPage fetches the page after this one, or returns ErrNoMorePages
+func (p *Page[T]) NextPage(ctx context.Context) (*Page[T], error) {
+	if !p.HasMore || p.next == nil {
+		return nil, ErrNoMorePages
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return p.next(ctx)
+}
+
+// All iterates over the items of this page and every page after it, fetching pages as the loop
+// reaches them. A failed fetch is yielded once as the error, ending the iteration:
+//
+//	for contact, err := range page.All(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (p *Page[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := p; ; {
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			next, err := page.NextPage(ctx)
+			if errors.Is(err, ErrNoMorePages) {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			page = next
+		}
+	}
+}
+
+// Page tokens of contact listings: a dateAdded/ID cursor for the list endpoint, or an offset
+// for listings served by the search endpoint
+const (
+	cursorTokenPrefix = "c:"
+	offsetTokenPrefix = "o:"
+)
+
+func cursorPageToken(last Contact) string {
+	return cursorTokenPrefix + strconv.FormatInt(last.DateAdded.UnixMilli(), 10) + ":" + last.ID
+}
+
+func offsetPageToken(offset int) string {
+	return offsetTokenPrefix + strconv.Itoa(offset)
+}
+
+// parseOffsetPageToken returns the offset of an offset page token
+func parseOffsetPageToken(token string) (int, error) {
+	if offset, ok := strings.CutPrefix(token, offsetTokenPrefix); ok {
+		if n, err := strconv.Atoi(offset); err == nil && n >= 0 {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid page token %q", token)
+}
+
+// applyPageToken sets the cursor or offset encoded in opts.PageToken
+func (o *GetContactsOptions) applyPageToken() error {
+	if o.PageToken == "" {
+		return nil
+	}
+	if cursor, ok := strings.CutPrefix(o.PageToken, cursorTokenPrefix); ok {
+		startAfter, id, found := strings.Cut(cursor, ":")
+		if !found || startAfter == "" || id == "" {
+			return fmt.Errorf("invalid page token %q", o.PageToken)
+		}
+		o.StartAfter, o.StartAfterID = startAfter, id
+		return nil
+	}
+	offset, err := parseOffsetPageToken(o.PageToken)
+	if err != nil {
+		return err
+	}
+	o.Skip = offset
+	return nil
+}
@@ -0,0 +1,104 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newPagedContactsClient serves n contacts through both the list and the search endpoint
+func newPagedContactsClient(t *testing.T, n int) *Client {
+	contacts := make([]Contact, n)
+	for i := range contacts {
+		contacts[i] = Contact{ID: fmt.Sprintf("c%d", i), DateAdded: Time{Time: time.UnixMilli(int64(1000 + i))}}
+	}
+	serve := func(w http.ResponseWriter, start, limit int) {
+		end := min(start+limit, n)
+		writeJSON(w, ContactsResponse{Contacts: contacts[start:end], Total: n})
+	}
+	return newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /contacts/search": func(w http.ResponseWriter, r *http.Request) {
+			var req SearchContactsRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.Page < 1 {
+				req.Page = 1
+			}
+			serve(w, (req.Page-1)*req.PageLimit, req.PageLimit)
+		},
+		"GET /contacts/{$}": func(w http.ResponseWriter, r *http.Request) {
+			var start int
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			if after := r.URL.Query().Get("startAfterId"); after != "" {
+				id, _ := strconv.Atoi(after[1:])
+				start = id + 1
+			}
+			serve(w, start, limit)
+		},
+	})
+}
+
+func collectPages(t *testing.T, page *Page[Contact], err error) []string {
+	t.Helper()
+	var ids []string
+	for {
+		if err != nil {
+			t.Fatalf("fetching page failed: %v", err)
+		}
+		for _, c := range page.Items {
+			ids = append(ids, c.ID)
+		}
+		if !page.HasMore {
+			if _, err := page.NextPage(context.Background()); !errors.Is(err, ErrNoMorePages) {
+				t.Errorf("NextPage after the last page = %v, want ErrNoMorePages", err)
+			}
+			return ids
+		}
+		page, err = page.NextPage(context.Background())
+	}
+}
+
+func TestContactsService_ListPage(t *testing.T) {
+	client := newPagedContactsClient(t, 25)
+	ctx := context.Background()
+
+	first, err := client.Contacts.ListPage(ctx, &GetContactsOptions{LocationID: "loc", Limit: 10})
+	if err != nil {
+		t.Fatalf("ListPage failed: %v", err)
+	}
+	if first.NextPageToken != "c:1009:c9" {
+		t.Errorf("NextPageToken = %q", first.NextPageToken)
+	}
+	if ids := collectPages(t, first, nil); len(ids) != 25 || ids[24] != "c24" {
+		t.Errorf("collected %v", ids)
+	}
+
+	// A token resumes the listing in a later call
+	resumed, err := client.Contacts.ListPage(ctx, &GetContactsOptions{LocationID: "loc", Limit: 10, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatalf("ListPage with token failed: %v", err)
+	}
+	if resumed.Items[0].ID != "c10" {
+		t.Errorf("resumed at %s, want c10", resumed.Items[0].ID)
+	}
+
+	if _, err := client.Contacts.ListPage(ctx, &GetContactsOptions{PageToken: "bogus"}); err == nil {
+		t.Error("invalid page token was accepted")
+	}
+}
+
+func TestContactsService_SearchPage(t *testing.T) {
+	client := newPagedContactsClient(t, 25)
+
+	page, err := client.Contacts.SearchPage(context.Background(), &SearchContactsRequest{LocationID: "loc", PageLimit: 10})
+	if page != nil && (page.Total != 25 || page.NextPageToken != "o:10") {
+		t.Errorf("Total = %d, NextPageToken = %q", page.Total, page.NextPageToken)
+	}
+	if ids := collectPages(t, page, err); len(ids) != 25 || ids[24] != "c24" {
+		t.Errorf("collected %v", ids)
+	}
+}
@@ -0,0 +1,176 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// PhoneNumbersService handles operations related to a location's phone numbers
+type PhoneNumbersService struct {
+	client *Client
+}
+
+// PhoneNumber represents a phone number owned by a location
+type PhoneNumber struct {
+	PhoneNumber                string                   `json:"phoneNumber,omitempty"` // E.164 format
+	FriendlyName               string                   `json:"friendlyName,omitempty"`
+	SID                        string                   `json:"sid,omitempty"`
+	CountryCode                string                   `json:"countryCode,omitempty"`
+	Type                       string                   `json:"type,omitempty"` // e.g. "local", "tollfree", "mobile"
+	Origin                     string                   `json:"origin,omitempty"`
+	Capabilities               *PhoneNumberCapabilities `json:"capabilities,omitempty"`
+	IsDefaultNumber            bool                     `json:"isDefaultNumber,omitempty"`
+	LinkedUser                 string                   `json:"linkedUser,omitempty"`
+	LinkedRingAllUsers         []string                 `json:"linkedRingAllUsers,omitempty"`
+	InboundCallService         map[string]interface{}   `json:"inboundCallService,omitempty"`
+	ForwardingNumber           string                   `json:"forwardingNumber,omitempty"`
+	IsGroupConversationEnabled bool                     `json:"isGroupConversationEnabled,omitempty"`
+	AddressSID                 string                   `json:"addressSid,omitempty"`
+	BundleSID                  string                   `json:"bundleSid,omitempty"`
+
+	// A2PStatus is the A2P 10DLC campaign registration state of the number
+	// (e.g. "approved", "pending", "rejected"); empty when not reported.
+	A2PStatus   string `json:"a2pStatus,omitempty"`
+	DateAdded   Time   `json:"dateAdded,omitempty"`
+	DateUpdated Time   `json:"dateUpdated,omitempty"`
+}
+
+// PhoneNumberCapabilities describes what a phone number can be used for
+type PhoneNumberCapabilities struct {
+	Voice bool `json:"voice"`
+	SMS   bool `json:"sms"`
+	MMS   bool `json:"mms"`
+}
+
+// CanSendSMS reports whether the number can send SMS. Numbers that report an A2P status
+// must be approved; numbers without one (e.g. outside the US) are only checked for capability.
+func (n *PhoneNumber) CanSendSMS() bool {
+	if n.Capabilities == nil || !n.Capabilities.SMS {
+		return false
+	}
+	return n.A2PStatus == "" || n.A2PStatus == "approved"
+}
+
+// NumberPool represents a pool of numbers used for number rotation
+type NumberPool struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	LocationID       string   `json:"locationId,omitempty"`
+	PhoneNumbers     []string `json:"numbers,omitempty"`
+	ForwardingNumber string   `json:"forwardingNumber,omitempty"`
+	DateAdded        Time     `json:"dateAdded,omitempty"`
+	DateUpdated      Time     `json:"dateUpdated,omitempty"`
+}
+
+// ListPhoneNumbersOptions represents query options for listing phone numbers
+type ListPhoneNumbersOptions struct {
+	Page         int
+	PageSize     int
+	SearchFilter string // Matches phone number or friendly name
+}
+
+// PhoneNumbersResponse represents a list of phone numbers API response
+type PhoneNumbersResponse struct {
+	Numbers    []PhoneNumber `json:"numbers,omitempty"`
+	Page       int           `json:"page,omitempty"`
+	PageSize   int           `json:"pageSize,omitempty"`
+	TotalCount int           `json:"totalCount,omitempty"`
+}
+
+// NumberPoolsResponse represents a list of number pools API response
+type NumberPoolsResponse struct {
+	Pools []NumberPool `json:"pools,omitempty"`
+}
+
+// List retrieves the phone numbers of a location
+// Required scope: phonenumbers.read
+func (s *PhoneNumbersService) List(locationID string, opts *ListPhoneNumbersOptions) (*PhoneNumbersResponse, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if opts == nil {
+		opts = &ListPhoneNumbersOptions{}
+	}
+
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	page := opts.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	query := url.Values{}
+	query.Set("pageSize", fmt.Sprintf("%d", pageSize))
+	query.Set("page", fmt.Sprintf("%d", page))
+	if opts.SearchFilter != "" {
+		query.Set("searchFilter", opts.SearchFilter)
+	}
+
+	var result PhoneNumbersResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/phone-system/numbers/location/%s?%s", locationID, query.Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListNumberPools retrieves the number pools of a location
+// Required scope: phonenumbers.read
+func (s *PhoneNumbersService) ListNumberPools(locationID string) ([]NumberPool, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result NumberPoolsResponse
+	err := s.client.doRequest("GET", "/phone-system/number-pools?"+locationQuery(locationID).Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Pools, nil
+}
+
+// SMSFromNumber picks a number the location can send SMS from, preferring the default number
+// and then a number linked to userID (pass "" to skip). It returns an error if no number can send SMS.
+// Required scope: phonenumbers.read
+func (s *PhoneNumbersService) SMSFromNumber(locationID, userID string) (*PhoneNumber, error) {
+	result, err := s.List(locationID, &ListPhoneNumbersOptions{PageSize: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	if number := pickSMSNumber(result.Numbers, userID); number != nil {
+		return number, nil
+	}
+	return nil, fmt.Errorf("no SMS-capable phone number found for location %s", locationID)
+}
+
+// pickSMSNumber chooses the best SMS-capable number: the default number, then one linked
+// to userID, then the first capable number
+func pickSMSNumber(numbers []PhoneNumber, userID string) *PhoneNumber {
+	var linked, first *PhoneNumber
+	for i := range numbers {
+		n := &numbers[i]
+		if !n.CanSendSMS() {
+			continue
+		}
+		if n.IsDefaultNumber {
+			return n
+		}
+		if linked == nil && userID != "" && n.LinkedUser == userID {
+			linked = n
+		}
+		if first == nil {
+			first = n
+		}
+	}
+	if linked != nil {
+		return linked
+	}
+	return first
+}
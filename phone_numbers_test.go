@@ -0,0 +1,43 @@
+package gohighlevel
+
+import "testing"
+
+func TestPickSMSNumber(t *testing.T) {
+	sms := &PhoneNumberCapabilities{SMS: true, Voice: true}
+	voiceOnly := &PhoneNumberCapabilities{Voice: true}
+
+	numbers := []PhoneNumber{
+		{PhoneNumber: "+15550000001", Capabilities: voiceOnly, IsDefaultNumber: true},
+		{PhoneNumber: "+15550000002", Capabilities: sms, A2PStatus: "pending"},
+		{PhoneNumber: "+15550000003", Capabilities: sms},
+		{PhoneNumber: "+15550000004", Capabilities: sms, A2PStatus: "approved", LinkedUser: "user-1"},
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   string
+	}{
+		{"first capable number", "", "+15550000003"},
+		{"number linked to user", "user-1", "+15550000004"},
+		{"unknown user falls back", "user-2", "+15550000003"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pickSMSNumber(numbers, tt.userID)
+			if got == nil || got.PhoneNumber != tt.want {
+				t.Errorf("Expected %s, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	numbers[2].IsDefaultNumber = true
+	if got := pickSMSNumber(numbers, "user-1"); got == nil || got.PhoneNumber != "+15550000003" {
+		t.Errorf("Expected default number to win, got %+v", got)
+	}
+
+	if got := pickSMSNumber(numbers[:2], ""); got != nil {
+		t.Errorf("Expected no SMS-capable number, got %+v", got)
+	}
+}
@@ -0,0 +1,70 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PingResult reports the state of a client's connection to GoHighLevel
+type PingResult struct {
+	// TokenValid is false when the API rejected the access token
+	TokenValid bool
+	// LocationReachable is true when the location could be read with the token
+	LocationReachable bool
+	// StatusCode of the API response
+	StatusCode int
+	// Scopes granted to the access token, read from the token itself; nil if the token does not
+	// carry them
+	Scopes []string
+	// Latency of the request
+	Latency time.Duration
+}
+
+// HasScope reports whether the access token was granted a scope
+func (r *PingResult) HasScope(scope string) bool {
+	for _, s := range r.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Ping checks the client's access token with a cheap authenticated call that reads a location,
+// e.g. for connection status pages or startup checks. A rejected token or an unreachable
+// location is reported in the result, not as an error; errors are for network failures and
+// unexpected responses. Ping does not refresh the token, so it reports the token as it is.
+// Required scope: locations.readonly (a token without it is reported valid, location unreachable)
+func (c *Client) Ping(locationID string) (*PingResult, error) {
+	locationID = c.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	result := &PingResult{}
+	if details, err := ParseAccessToken(c.GetAccessToken()); err == nil {
+		result.Scopes = details.Scopes
+	}
+	start := time.Now()
+	statusCode, respBody, err := c.sendRequest(context.Background(), "GET", fmt.Sprintf("/locations/%s", locationID), nil, nil)
+	result.Latency = time.Since(start)
+	result.StatusCode = statusCode
+	if err != nil {
+		return result, err
+	}
+
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		result.TokenValid = true
+		result.LocationReachable = true
+	case statusCode == http.StatusUnauthorized:
+	case statusCode == http.StatusForbidden, statusCode == http.StatusNotFound, statusCode == http.StatusBadRequest:
+		// The token was accepted but cannot read this location
+		result.TokenValid = true
+	default:
+		return result, fmt.Errorf("API request failed with status %d: %s", statusCode, string(respBody))
+	}
+	return result, nil
+}
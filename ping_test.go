@@ -0,0 +1,56 @@
+package gohighlevel
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testJWT returns an unsigned JWT with the given claims
+func testJWT(claims string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+func TestClient_Ping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("Authorization") != "Bearer "+testJWT(`{"oauthMeta":{"scopes":["contacts.readonly","locations.readonly"]}}`):
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/locations/loc":
+			_, _ = w.Write([]byte(`{"location":{"id":"loc"}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	token := testJWT(`{"oauthMeta":{"scopes":["contacts.readonly","locations.readonly"]}}`)
+	client, _ := NewClient(Config{AccessToken: token, BaseURL: server.URL, LocationID: "loc"})
+
+	result, err := client.Ping("")
+	if err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+	if !result.TokenValid || !result.LocationReachable || !result.HasScope("contacts.readonly") || result.HasScope("contacts.write") {
+		t.Errorf("Ping = %+v", result)
+	}
+
+	result, err = client.Ping("other")
+	if err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+	if !result.TokenValid || result.LocationReachable {
+		t.Errorf("Ping of a forbidden location = %+v", result)
+	}
+
+	client.SetAccessToken("revoked")
+	result, err = client.Ping("loc")
+	if err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+	if result.TokenValid || result.StatusCode != http.StatusUnauthorized || result.Scopes != nil {
+		t.Errorf("Ping with a rejected token = %+v", result)
+	}
+}
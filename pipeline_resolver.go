@@ -0,0 +1,135 @@
+package gohighlevel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrPipelineNotFound is returned when no pipeline of a location has the requested name
+var ErrPipelineNotFound = errors.New("pipeline not found")
+
+// ErrStageNotFound is returned when a pipeline has no stage with the requested name
+var ErrStageNotFound = errors.New("pipeline stage not found")
+
+// pipelineCache holds the pipelines of each location so names can be resolved to IDs without a
+// request each time. Entries are only refreshed when a name is not found.
+type pipelineCache struct {
+	mu         sync.Mutex
+	byLocation map[string][]Pipeline
+}
+
+func newPipelineCache() *pipelineCache {
+	return &pipelineCache{byLocation: make(map[string][]Pipeline)}
+}
+
+// ResolvePipeline returns the pipeline of a location with the given name, compared case-insensitively.
+// Pipelines are cached per location and fetched again when the name is not found, so pipelines
+// created after the first lookup are still resolved.
+// Required scope: opportunities.readonly
+func (s *OpportunitiesService) ResolvePipeline(locationID, pipelineName string) (*Pipeline, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if pipelineName == "" {
+		return nil, fmt.Errorf("pipelineName is required")
+	}
+
+	cache := s.client.pipelines
+	cache.mu.Lock()
+	pipelines, cached := cache.byLocation[locationID]
+	cache.mu.Unlock()
+
+	if cached {
+		if pipeline := findPipeline(pipelines, pipelineName); pipeline != nil {
+			return pipeline, nil
+		}
+	}
+
+	pipelines, err := s.refreshPipelines(locationID)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline := findPipeline(pipelines, pipelineName); pipeline != nil {
+		return pipeline, nil
+	}
+	return nil, fmt.Errorf("%w: %q in location %s", ErrPipelineNotFound, pipelineName, locationID)
+}
+
+// ResolveStage returns the IDs of a pipeline and one of its stages by name, both compared
+// case-insensitively. Stage IDs differ per location, so multi-location apps can refer to stages by
+// name instead of hardcoding IDs. Like ResolvePipeline, the cache is refreshed on a miss.
+// Required scope: opportunities.readonly
+func (s *OpportunitiesService) ResolveStage(locationID, pipelineName, stageName string) (pipelineID, stageID string, err error) {
+	if stageName == "" {
+		return "", "", fmt.Errorf("stageName is required")
+	}
+
+	pipeline, err := s.ResolvePipeline(locationID, pipelineName)
+	if err != nil {
+		return "", "", err
+	}
+	if stage := findStage(pipeline, stageName); stage != nil {
+		return pipeline.ID, stage.ID, nil
+	}
+
+	// The stage may have been added since the pipelines were cached
+	pipelines, err := s.refreshPipelines(s.client.resolveLocationID(locationID))
+	if err != nil {
+		return "", "", err
+	}
+	if pipeline = findPipeline(pipelines, pipelineName); pipeline != nil {
+		if stage := findStage(pipeline, stageName); stage != nil {
+			return pipeline.ID, stage.ID, nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: %q in pipeline %q", ErrStageNotFound, stageName, pipelineName)
+}
+
+// InvalidatePipelines drops the cached pipelines of a location, e.g. after stages were renamed
+// or deleted
+func (s *OpportunitiesService) InvalidatePipelines(locationID string) {
+	locationID = s.client.resolveLocationID(locationID)
+	cache := s.client.pipelines
+	cache.mu.Lock()
+	delete(cache.byLocation, locationID)
+	cache.mu.Unlock()
+}
+
+// refreshPipelines fetches the pipelines of a location and caches them
+func (s *OpportunitiesService) refreshPipelines(locationID string) ([]Pipeline, error) {
+	pipelines, err := s.ListPipelines(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := s.client.pipelines
+	cache.mu.Lock()
+	cache.byLocation[locationID] = pipelines
+	cache.mu.Unlock()
+	return pipelines, nil
+}
+
+// findPipeline returns a copy of the pipeline with the given name, or nil
+func findPipeline(pipelines []Pipeline, name string) *Pipeline {
+	name = strings.TrimSpace(name)
+	for _, p := range pipelines {
+		if strings.EqualFold(strings.TrimSpace(p.Name), name) {
+			return &p
+		}
+	}
+	return nil
+}
+
+// findStage returns the stage of a pipeline with the given name, or nil
+func findStage(pipeline *Pipeline, name string) *PipelineStage {
+	name = strings.TrimSpace(name)
+	for i := range pipeline.Stages {
+		if strings.EqualFold(strings.TrimSpace(pipeline.Stages[i].Name), name) {
+			return &pipeline.Stages[i]
+		}
+	}
+	return nil
+}
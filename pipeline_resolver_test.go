@@ -0,0 +1,52 @@
+package gohighlevel
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOpportunitiesService_ResolveStage(t *testing.T) {
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/opportunities/pipelines" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		stages := `[{"id":"st-1","name":"New Lead"},{"id":"st-2","name":"Qualified"}]`
+		if fetches.Add(1) > 1 {
+			// A stage added after the first fetch
+			stages = `[{"id":"st-1","name":"New Lead"},{"id":"st-2","name":"Qualified"},{"id":"st-3","name":"Won"}]`
+		}
+		_, _ = w.Write([]byte(`{"pipelines":[{"id":"pl-1","name":"Sales Pipeline","stages":` + stages + `}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+
+	pipelineID, stageID, err := client.Opportunities.ResolveStage("loc-1", "sales pipeline", " Qualified ")
+	if err != nil {
+		t.Fatalf("ResolveStage failed: %v", err)
+	}
+	if pipelineID != "pl-1" || stageID != "st-2" {
+		t.Errorf("ResolveStage = %s, %s", pipelineID, stageID)
+	}
+
+	// Served from the cache
+	if _, _, err := client.Opportunities.ResolveStage("loc-1", "Sales Pipeline", "New Lead"); err != nil || fetches.Load() != 1 {
+		t.Fatalf("cached lookup: err = %v, fetches = %d", err, fetches.Load())
+	}
+
+	// A miss refreshes the cache
+	if _, stageID, err = client.Opportunities.ResolveStage("loc-1", "Sales Pipeline", "Won"); err != nil || stageID != "st-3" {
+		t.Fatalf("refreshed lookup = %s, %v", stageID, err)
+	}
+
+	if _, _, err := client.Opportunities.ResolveStage("loc-1", "Sales Pipeline", "Lost"); !errors.Is(err, ErrStageNotFound) {
+		t.Errorf("unknown stage error = %v", err)
+	}
+	if _, _, err := client.Opportunities.ResolveStage("loc-1", "Renewals", "Won"); !errors.Is(err, ErrPipelineNotFound) {
+		t.Errorf("unknown pipeline error = %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PriceSyncResult reports the changes SyncPrices applied
+type PriceSyncResult struct {
+	Created   []Price
+	Updated   []Price
+	Deleted   []Price // The prices as they were before deletion
+	Unchanged []Price
+}
+
+// SyncPrices makes the prices of a product match desired, issuing only the calls needed: prices
+// that don't exist yet are created, prices that differ are updated, and prices not in desired
+// are deleted. A desired price is matched to an existing one by ID if set, otherwise by SKU,
+// otherwise by name (case-insensitively), so a sync job can describe prices without knowing
+// their IDs; IDs take precedence over SKUs and SKUs over names. A product whose prices are not
+// all returned in one listing is rejected before any change. On error, the result reports the
+// changes applied so far.
+// Required scopes: products/prices.readonly, products/prices.write
+func (s *ProductsService) SyncPrices(locationID, productID string, desired []Price) (*PriceSyncResult, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+
+	current, err := s.ListPrices(locationID, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prices of product %s: %w", productID, err)
+	}
+	// Deleting the prices missing from a partial list would remove prices that should be kept
+	if current.Total > len(current.Prices) {
+		return nil, fmt.Errorf("product %s has %d prices but only %d were listed", productID, current.Total, len(current.Prices))
+	}
+
+	// Match every desired price before changing anything, so an invalid set changes nothing.
+	// All IDs are matched first, then SKUs, then names, so a price referred to by ID is never
+	// claimed by an earlier desired price's SKU or name.
+	existing := current.Prices
+	matched := make([]*Price, len(desired))
+	taken := make(map[string]bool)
+	for i := range desired {
+		if desired[i].ID == "" {
+			continue
+		}
+		match := matchPrice(existing, nil, func(p *Price) bool { return p.ID == desired[i].ID })
+		if match == nil {
+			return nil, fmt.Errorf("price %s is not a price of product %s", desired[i].ID, productID)
+		}
+		if taken[match.ID] {
+			return nil, fmt.Errorf("price %s is matched by more than one desired price", match.ID)
+		}
+		taken[match.ID] = true
+		matched[i] = match
+	}
+	for _, matches := range []func(want, p *Price) bool{priceMatchesSKU, priceMatchesName} {
+		for i := range desired {
+			if desired[i].ID != "" || matched[i] != nil {
+				continue
+			}
+			match := matchPrice(existing, taken, func(p *Price) bool { return matches(&desired[i], p) })
+			if match != nil {
+				taken[match.ID] = true
+				matched[i] = match
+			}
+		}
+	}
+
+	result := &PriceSyncResult{}
+	for i := range desired {
+		req := priceRequestFrom(locationID, &desired[i])
+		match := matched[i]
+		switch {
+		case match == nil:
+			price, err := s.CreatePrice(productID, req)
+			if err != nil {
+				return result, fmt.Errorf("failed to create price %q: %w", desired[i].Name, err)
+			}
+			result.Created = append(result.Created, *price)
+		case reflect.DeepEqual(req, priceRequestFrom(locationID, match)):
+			result.Unchanged = append(result.Unchanged, *match)
+		default:
+			price, err := s.UpdatePrice(productID, match.ID, req)
+			if err != nil {
+				return result, fmt.Errorf("failed to update price %s: %w", match.ID, err)
+			}
+			result.Updated = append(result.Updated, *price)
+		}
+	}
+
+	for _, price := range existing {
+		if taken[price.ID] {
+			continue
+		}
+		if err := s.DeletePrice(locationID, productID, price.ID); err != nil {
+			return result, fmt.Errorf("failed to delete price %s: %w", price.ID, err)
+		}
+		result.Deleted = append(result.Deleted, price)
+	}
+
+	return result, nil
+}
+
+// matchPrice returns the first existing price not in taken for which match reports true, or nil
+func matchPrice(existing []Price, taken map[string]bool, match func(*Price) bool) *Price {
+	for i := range existing {
+		if !taken[existing[i].ID] && match(&existing[i]) {
+			return &existing[i]
+		}
+	}
+	return nil
+}
+
+// priceMatchesSKU reports whether p has the SKU of a desired price that has one
+func priceMatchesSKU(want, p *Price) bool {
+	return want.SKU != "" && p.SKU == want.SKU
+}
+
+// priceMatchesName reports whether p has the name of a desired price without a SKU, ignoring case
+func priceMatchesName(want, p *Price) bool {
+	return want.SKU == "" && strings.EqualFold(strings.TrimSpace(p.Name), strings.TrimSpace(want.Name))
+}
+
+// priceRequestFrom builds the create or update request describing a price. Empty lists are
+// left nil, so requests built from API responses and from callers compare equal.
+func priceRequestFrom(locationID string, p *Price) *PriceRequest {
+	req := &PriceRequest{
+		LocationID:        locationID,
+		Name:              p.Name,
+		Type:              p.Type,
+		Currency:          p.Currency,
+		Amount:            p.Amount,
+		Description:       p.Description,
+		Recurring:         p.Recurring,
+		TrialPeriod:       p.TrialPeriod,
+		TotalCycles:       p.TotalCycles,
+		SetupFee:          p.SetupFee,
+		CompareAtPrice:    p.CompareAtPrice,
+		VariantOptionIDs:  p.VariantOptionIDs,
+		MembershipOffers:  p.MembershipOffers,
+		SKU:               p.SKU,
+		TrackInventory:    p.TrackInventory,
+		AvailableQuantity: p.AvailableQuantity,
+	}
+	if len(req.VariantOptionIDs) == 0 {
+		req.VariantOptionIDs = nil
+	}
+	if len(req.MembershipOffers) == 0 {
+		req.MembershipOffers = nil
+	}
+	return req
+}
@@ -0,0 +1,133 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProductsService_SyncPrices(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "GET":
+			_, _ = w.Write([]byte(`{"prices":[
+				{"_id":"p-monthly","name":"Monthly","type":"recurring","currency":"USD","amount":29,"recurring":{"interval":"month","intervalCount":1},"variantOptionIds":[]},
+				{"_id":"p-yearly","name":"Yearly","type":"recurring","currency":"USD","amount":290,"recurring":{"interval":"year","intervalCount":1}},
+				{"_id":"p-legacy","name":"Lifetime","type":"one_time","currency":"USD","amount":999}
+			],"total":3}`))
+		case "POST", "PUT":
+			var req PriceRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_, _ = fmt.Fprintf(w, `{"_id":"p-%s","name":%q,"amount":%v}`, r.Method, req.Name, req.Amount)
+		case "DELETE":
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	result, err := client.Products.SyncPrices("loc-1", "prod-1", []Price{
+		{Name: "Monthly", Type: PriceTypeRecurring, Currency: "USD", Amount: 29, Recurring: &PriceRecurring{Interval: "month", IntervalCount: 1}},
+		{Name: "Yearly", Type: PriceTypeRecurring, Currency: "USD", Amount: 300, Recurring: &PriceRecurring{Interval: "year", IntervalCount: 1}},
+		{Name: "Weekly", Type: PriceTypeRecurring, Currency: "USD", Amount: 9, Recurring: &PriceRecurring{Interval: "week", IntervalCount: 1}},
+	})
+	if err != nil {
+		t.Fatalf("SyncPrices failed: %v", err)
+	}
+
+	if len(result.Unchanged) != 1 || result.Unchanged[0].ID != "p-monthly" {
+		t.Errorf("unchanged = %+v", result.Unchanged)
+	}
+	if len(result.Updated) != 1 || result.Updated[0].Amount != 300 {
+		t.Errorf("updated = %+v", result.Updated)
+	}
+	if len(result.Created) != 1 || result.Created[0].Name != "Weekly" {
+		t.Errorf("created = %+v", result.Created)
+	}
+	if len(result.Deleted) != 1 || result.Deleted[0].ID != "p-legacy" {
+		t.Errorf("deleted = %+v", result.Deleted)
+	}
+
+	want := []string{
+		"GET /products/prod-1/price",
+		"PUT /products/prod-1/price/p-yearly",
+		"POST /products/prod-1/price",
+		"DELETE /products/prod-1/price/p-legacy",
+	}
+	if fmt.Sprint(calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestProductsService_SyncPricesUnknownID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected %s: nothing should change", r.Method)
+		}
+		_, _ = w.Write([]byte(`{"prices":[{"_id":"p-1","name":"Monthly"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	_, err := client.Products.SyncPrices("loc-1", "prod-1", []Price{
+		{Name: "New", Type: PriceTypeOneTime, Currency: "USD", Amount: 5},
+		{ID: "p-other", Name: "Other", Type: PriceTypeOneTime, Currency: "USD", Amount: 1},
+	})
+	if err == nil {
+		t.Fatal("expected error for a price ID the product does not have")
+	}
+}
+
+func TestProductsService_SyncPricesMatchesIDsFirst(t *testing.T) {
+	var updated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			_, _ = w.Write([]byte(`{"prices":[
+				{"_id":"p-1","name":"Monthly","type":"one_time","currency":"USD","amount":10},
+				{"_id":"p-2","name":"Monthly","type":"one_time","currency":"USD","amount":20}
+			],"total":2}`))
+		case "PUT":
+			updated = append(updated, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	// The name match must not claim p-1, which a later desired price refers to by ID
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	result, err := client.Products.SyncPrices("loc-1", "prod-1", []Price{
+		{Name: "Monthly", Type: PriceTypeOneTime, Currency: "USD", Amount: 20},
+		{ID: "p-1", Name: "Monthly", Type: PriceTypeOneTime, Currency: "USD", Amount: 11},
+	})
+	if err != nil {
+		t.Fatalf("SyncPrices failed: %v", err)
+	}
+	if len(result.Unchanged) != 1 || result.Unchanged[0].ID != "p-2" {
+		t.Errorf("unchanged = %+v", result.Unchanged)
+	}
+	if fmt.Sprint(updated) != "[/products/prod-1/price/p-1]" {
+		t.Errorf("updated = %v", updated)
+	}
+}
+
+func TestProductsService_SyncPricesPartialList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected %s: nothing should change", r.Method)
+		}
+		_, _ = w.Write([]byte(`{"prices":[{"_id":"p-1","name":"Monthly"}],"total":2}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	if _, err := client.Products.SyncPrices("loc-1", "prod-1", nil); err == nil {
+		t.Fatal("expected error when not every price was listed")
+	}
+}
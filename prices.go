@@ -0,0 +1,195 @@
+package gohighlevel
+
+import "fmt"
+
+// Price represents a price of a product
+type Price struct {
+	ID                string            `json:"_id,omitempty"`
+	Product           string            `json:"product,omitempty"`
+	LocationID        string            `json:"locationId,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	Type              string            `json:"type,omitempty"` // "one_time" or "recurring"
+	Currency          string            `json:"currency,omitempty"`
+	Amount            float64           `json:"amount,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	Recurring         *PriceRecurring   `json:"recurring,omitempty"`
+	TrialPeriod       int               `json:"trialPeriod,omitempty"`
+	TotalCycles       int               `json:"totalCycles,omitempty"`
+	SetupFee          float64           `json:"setupFee,omitempty"`
+	CompareAtPrice    float64           `json:"compareAtPrice,omitempty"`
+	VariantOptionIDs  []string          `json:"variantOptionIds,omitempty"`
+	MembershipOffers  []MembershipOffer `json:"membershipOffers,omitempty"`
+	SKU               string            `json:"sku,omitempty"`
+	TrackInventory    bool              `json:"trackInventory,omitempty"`
+	AvailableQuantity int               `json:"availableQuantity,omitempty"`
+	CreatedAt         Time              `json:"createdAt,omitempty"`
+	UpdatedAt         Time              `json:"updatedAt,omitempty"`
+}
+
+// PriceRecurring represents the billing interval of a recurring price
+type PriceRecurring struct {
+	Interval      string `json:"interval"` // "day", "week", "month" or "year"
+	IntervalCount int    `json:"intervalCount"`
+}
+
+// MembershipOffer links a price to a membership (course) offer that is granted on purchase
+type MembershipOffer struct {
+	ID    string `json:"_id"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// PriceRequest represents a request to create or update a price
+type PriceRequest struct {
+	LocationID        string            `json:"locationId"`
+	Name              string            `json:"name"`
+	Type              string            `json:"type"` // "one_time" or "recurring"
+	Currency          string            `json:"currency"`
+	Amount            float64           `json:"amount"`
+	Description       string            `json:"description,omitempty"`
+	Recurring         *PriceRecurring   `json:"recurring,omitempty"`
+	TrialPeriod       int               `json:"trialPeriod,omitempty"`
+	TotalCycles       int               `json:"totalCycles,omitempty"`
+	SetupFee          float64           `json:"setupFee,omitempty"`
+	CompareAtPrice    float64           `json:"compareAtPrice,omitempty"`
+	VariantOptionIDs  []string          `json:"variantOptionIds,omitempty"`
+	MembershipOffers  []MembershipOffer `json:"membershipOffers,omitempty"`
+	SKU               string            `json:"sku,omitempty"`
+	TrackInventory    bool              `json:"trackInventory,omitempty"`
+	AvailableQuantity int               `json:"availableQuantity,omitempty"`
+}
+
+// PricesResponse represents a list of prices API response
+type PricesResponse struct {
+	Prices []Price `json:"prices,omitempty"`
+	Total  int     `json:"total,omitempty"`
+}
+
+// ListPrices retrieves the prices of a product
+// Required scope: products/prices.readonly
+func (s *ProductsService) ListPrices(locationID, productID string) (*PricesResponse, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+
+	var result PricesResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/products/%s/price?%s", productID, locationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetPrice retrieves a price of a product by ID
+// Required scope: products/prices.readonly
+func (s *ProductsService) GetPrice(locationID, productID, priceID string) (*Price, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+	if priceID == "" {
+		return nil, fmt.Errorf("priceId is required")
+	}
+
+	var result Price
+	err := s.client.doRequest("GET", fmt.Sprintf("/products/%s/price/%s?%s", productID, priceID, locationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreatePrice creates a new price for a product
+// Required scope: products/prices.write
+func (s *ProductsService) CreatePrice(productID string, req *PriceRequest) (*Price, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+	req, err := validatePriceRequest(s.client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Price
+	err = s.client.doRequest("POST", fmt.Sprintf("/products/%s/price", productID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdatePrice updates an existing price of a product
+// Required scope: products/prices.write
+func (s *ProductsService) UpdatePrice(productID, priceID string, req *PriceRequest) (*Price, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+	if priceID == "" {
+		return nil, fmt.Errorf("priceId is required")
+	}
+	req, err := validatePriceRequest(s.client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Price
+	err = s.client.doRequest("PUT", fmt.Sprintf("/products/%s/price/%s", productID, priceID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeletePrice deletes a price of a product
+// Required scope: products/prices.write
+func (s *ProductsService) DeletePrice(locationID, productID, priceID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return fmt.Errorf("productId is required")
+	}
+	if priceID == "" {
+		return fmt.Errorf("priceId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/products/%s/price/%s?%s", productID, priceID, locationQuery(locationID).Encode()), nil, nil)
+}
+
+// validatePriceRequest checks the fields required to create or update a price and returns a copy
+// of req with the default location filled in
+func validatePriceRequest(c *Client, req *PriceRequest) (*PriceRequest, error) {
+	req = shallowCopy(req)
+	req.LocationID = c.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.Currency == "" {
+		return nil, fmt.Errorf("currency is required")
+	}
+	switch req.Type {
+	case PriceTypeOneTime:
+	case PriceTypeRecurring:
+		if req.Recurring == nil || req.Recurring.Interval == "" {
+			return nil, fmt.Errorf("recurring interval is required for recurring prices")
+		}
+	default:
+		return nil, fmt.Errorf("type must be one_time or recurring")
+	}
+	return req, nil
+}
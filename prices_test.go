@@ -0,0 +1,123 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestProductsService_Prices(t *testing.T) {
+	var calls []string
+	var created, updated PriceRequest
+	record := func(r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}
+	checkQuery := func(r *http.Request) {
+		if r.URL.Query().Get("locationId") != "loc-1" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /products/prod-1/price": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			checkQuery(r)
+			_, _ = w.Write([]byte(`{"prices":[{"_id":"price-1","amount":10},{"_id":"price-2","amount":20}],"total":2}`))
+		},
+		"GET /products/prod-1/price/price-1": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			checkQuery(r)
+			_, _ = w.Write([]byte(`{"_id":"price-1","name":"Monthly","type":"recurring","recurring":{"interval":"month","intervalCount":1}}`))
+		},
+		"POST /products/prod-1/price": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"_id":"price-3","name":"Once"}`))
+		},
+		"PUT /products/prod-1/price/price-1": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"_id":"price-1","amount":15}`))
+		},
+		"DELETE /products/prod-1/price/price-1": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			checkQuery(r)
+			_, _ = w.Write([]byte(`{"status":true}`))
+		},
+	})
+
+	prices, err := client.Products.ListPrices("loc-1", "prod-1")
+	if err != nil {
+		t.Fatalf("ListPrices failed: %v", err)
+	}
+	if prices.Total != 2 || len(prices.Prices) != 2 || prices.Prices[1].ID != "price-2" {
+		t.Errorf("Unexpected prices: %+v", prices)
+	}
+
+	price, err := client.Products.GetPrice("loc-1", "prod-1", "price-1")
+	if err != nil {
+		t.Fatalf("GetPrice failed: %v", err)
+	}
+	if price.Recurring == nil || price.Recurring.Interval != "month" {
+		t.Errorf("Unexpected price: %+v", price)
+	}
+
+	price, err = client.Products.CreatePrice("prod-1", &PriceRequest{LocationID: "loc-1", Name: "Once", Type: "one_time", Currency: "USD", Amount: 10})
+	if err != nil {
+		t.Fatalf("CreatePrice failed: %v", err)
+	}
+	if price.ID != "price-3" || created.LocationID != "loc-1" || created.Amount != 10 {
+		t.Errorf("Unexpected create: %+v, %+v", price, created)
+	}
+
+	monthly := &PriceRequest{LocationID: "loc-1", Name: "Monthly", Type: "recurring", Currency: "USD", Amount: 15, Recurring: &PriceRecurring{Interval: "month", IntervalCount: 1}}
+	if _, err := client.Products.UpdatePrice("prod-1", "price-1", monthly); err != nil {
+		t.Fatalf("UpdatePrice failed: %v", err)
+	}
+	if updated.LocationID != "loc-1" || updated.Recurring == nil || updated.Recurring.Interval != "month" {
+		t.Errorf("Unexpected update request: %+v", updated)
+	}
+
+	if err := client.Products.DeletePrice("loc-1", "prod-1", "price-1"); err != nil {
+		t.Errorf("DeletePrice failed: %v", err)
+	}
+	if len(calls) != 5 {
+		t.Errorf("Unexpected calls: %v", calls)
+	}
+}
+
+func TestProductsService_PricesValidation(t *testing.T) {
+	client := newTestClient(t, Config{}, nil)
+
+	invalid := map[string]*PriceRequest{
+		"name":     {LocationID: "loc-1", Type: "one_time", Currency: "USD"},
+		"currency": {LocationID: "loc-1", Name: "Once", Type: "one_time"},
+		"type":     {LocationID: "loc-1", Name: "Once", Type: "weekly", Currency: "USD"},
+		"interval": {LocationID: "loc-1", Name: "Monthly", Type: "recurring", Currency: "USD"},
+	}
+	for field, req := range invalid {
+		if _, err := client.Products.CreatePrice("prod-1", req); err == nil {
+			t.Errorf("Expected error for invalid %s", field)
+		}
+	}
+
+	valid := &PriceRequest{LocationID: "loc-1", Name: "Once", Type: "one_time", Currency: "USD"}
+	if _, err := client.Products.CreatePrice("", valid); err == nil {
+		t.Error("Expected error for missing productId")
+	}
+	if _, err := client.Products.UpdatePrice("prod-1", "", valid); err == nil {
+		t.Error("Expected error for missing priceId")
+	}
+	if _, err := client.Products.ListPrices("loc-1", ""); err == nil {
+		t.Error("Expected error for missing productId")
+	}
+	if _, err := client.Products.GetPrice("loc-1", "prod-1", ""); err == nil {
+		t.Error("Expected error for missing priceId")
+	}
+	if err := client.Products.DeletePrice("loc-1", "prod-1", ""); err == nil {
+		t.Error("Expected error for missing priceId")
+	}
+
+	if _, err := client.Products.CreatePrice("prod-1", &PriceRequest{Name: "Once", Type: "one_time", Currency: "USD"}); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
@@ -0,0 +1,270 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProductCollection represents a curated collection of products in the store
+type ProductCollection struct {
+	ID        string      `json:"_id,omitempty"`
+	AltID     string      `json:"altId,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	Slug      string      `json:"slug,omitempty"`
+	Image     string      `json:"image,omitempty"`
+	SEO       *ProductSEO `json:"seo,omitempty"`
+	CreatedAt Time        `json:"createdAt,omitempty"`
+}
+
+// ProductCollectionRequest represents a request to create or update a product collection
+type ProductCollectionRequest struct {
+	LocationID string      `json:"altId"`
+	AltType    string      `json:"altType"` // Defaults to "location"
+	Name       string      `json:"name"`
+	Slug       string      `json:"slug"`
+	Image      string      `json:"image,omitempty"`
+	SEO        *ProductSEO `json:"seo,omitempty"`
+}
+
+// ListProductCollectionsOptions represents query options for listing product collections
+type ListProductCollectionsOptions struct {
+	LocationID    string
+	Name          string
+	CollectionIDs []string
+	Limit         int
+	Offset        int
+}
+
+// ProductCollectionResponse represents a single product collection API response
+type ProductCollectionResponse struct {
+	Data *ProductCollection `json:"data,omitempty"`
+}
+
+// ProductCollectionsResponse represents a list of product collections API response
+type ProductCollectionsResponse struct {
+	Data  []ProductCollection `json:"data,omitempty"`
+	Total int                 `json:"total,omitempty"`
+}
+
+// storeStatusRequest represents a request to include or exclude products from a store
+type storeStatusRequest struct {
+	AltID      string   `json:"altId"`
+	AltType    string   `json:"altType"`
+	Action     string   `json:"action"`
+	ProductIDs []string `json:"productIds"`
+}
+
+// ListCollections retrieves the product collections of a location
+// Required scope: products/collection.readonly
+func (s *ProductsService) ListCollections(opts *ListProductCollectionsOptions) (*ProductCollectionsResponse, error) {
+	if opts == nil {
+		opts = &ListProductCollectionsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := altLocationQuery(opts.LocationID)
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if len(opts.CollectionIDs) > 0 {
+		query.Set("collectionIds", strings.Join(opts.CollectionIDs, ","))
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+
+	var result ProductCollectionsResponse
+	err := s.client.doRequest("GET", "/products/collections?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCollection retrieves a product collection by ID
+// Required scope: products/collection.readonly
+func (s *ProductsService) GetCollection(collectionID string) (*ProductCollection, error) {
+	if collectionID == "" {
+		return nil, fmt.Errorf("collectionId is required")
+	}
+
+	var result ProductCollectionResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/products/collections/%s", collectionID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// CreateCollection creates a new product collection
+// Required scope: products/collection.write
+func (s *ProductsService) CreateCollection(req *ProductCollectionRequest) (*ProductCollection, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.Slug == "" {
+		return nil, fmt.Errorf("slug is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+
+	var result ProductCollectionResponse
+	err := s.client.doRequest("POST", "/products/collections", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// UpdateCollection updates an existing product collection
+// Required scope: products/collection.write
+func (s *ProductsService) UpdateCollection(collectionID string, req *ProductCollectionRequest) error {
+	if collectionID == "" {
+		return fmt.Errorf("collectionId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if req.AltType == "" {
+		req.AltType = "location"
+	}
+
+	return s.client.doRequest("PUT", fmt.Sprintf("/products/collections/%s", collectionID), req, nil)
+}
+
+// DeleteCollection deletes a product collection
+// Required scope: products/collection.write
+func (s *ProductsService) DeleteCollection(locationID, collectionID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if collectionID == "" {
+		return fmt.Errorf("collectionId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/products/collections/%s?%s", collectionID, altLocationQuery(locationID).Encode()), nil, nil)
+}
+
+// AddToCollection attaches products to a collection.
+// Collections are stored on the products, so each product is fetched and updated in turn.
+// Required scopes: products.readonly, products.write
+func (s *ProductsService) AddToCollection(locationID, collectionID string, productIDs []string) error {
+	return s.updateProductCollections(locationID, collectionID, productIDs, true)
+}
+
+// RemoveFromCollection detaches products from a collection
+// Required scopes: products.readonly, products.write
+func (s *ProductsService) RemoveFromCollection(locationID, collectionID string, productIDs []string) error {
+	return s.updateProductCollections(locationID, collectionID, productIDs, false)
+}
+
+// IncludeInStore makes products available in a store
+// Required scope: products.write
+func (s *ProductsService) IncludeInStore(locationID, storeID string, productIDs []string) error {
+	return s.setStoreStatus(locationID, storeID, "include", productIDs)
+}
+
+// ExcludeFromStore removes products from a store
+// Required scope: products.write
+func (s *ProductsService) ExcludeFromStore(locationID, storeID string, productIDs []string) error {
+	return s.setStoreStatus(locationID, storeID, "exclude", productIDs)
+}
+
+// setStoreStatus includes or excludes products from a store
+func (s *ProductsService) setStoreStatus(locationID, storeID, action string, productIDs []string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if storeID == "" {
+		return fmt.Errorf("storeId is required")
+	}
+	if len(productIDs) == 0 {
+		return fmt.Errorf("at least one product is required")
+	}
+
+	req := &storeStatusRequest{AltID: locationID, AltType: "location", Action: action, ProductIDs: productIDs}
+	return s.client.doRequest("POST", fmt.Sprintf("/products/store/%s", storeID), req, nil)
+}
+
+// updateProductCollections adds or removes a collection from each product's collection list
+func (s *ProductsService) updateProductCollections(locationID, collectionID string, productIDs []string, add bool) error {
+	if collectionID == "" {
+		return fmt.Errorf("collectionId is required")
+	}
+	if len(productIDs) == 0 {
+		return fmt.Errorf("at least one product is required")
+	}
+
+	for _, productID := range productIDs {
+		product, err := s.Get(locationID, productID)
+		if err != nil {
+			return fmt.Errorf("failed to get product %s: %w", productID, err)
+		}
+
+		collections := make([]string, 0, len(product.CollectionIDs)+1)
+		present := false
+		for _, id := range product.CollectionIDs {
+			if id == collectionID {
+				present = true
+				if !add {
+					continue
+				}
+			}
+			collections = append(collections, id)
+		}
+		if present == add {
+			continue
+		}
+		if add {
+			collections = append(collections, collectionID)
+		}
+
+		req := productRequestFrom(locationID, product)
+		req.CollectionIDs = collections
+		if _, err := s.Update(productID, req); err != nil {
+			return fmt.Errorf("failed to update product %s: %w", productID, err)
+		}
+	}
+
+	return nil
+}
+
+// productRequestFrom builds an update request that preserves the current state of a product
+func productRequestFrom(locationID string, p *Product) *ProductRequest {
+	return &ProductRequest{
+		LocationID:          locationID,
+		Name:                p.Name,
+		Description:         p.Description,
+		ProductType:         p.ProductType,
+		Image:               p.Image,
+		StatementDescriptor: p.StatementDescriptor,
+		AvailableInStore:    p.AvailableInStore,
+		Medias:              p.Medias,
+		Variants:            p.Variants,
+		CollectionIDs:       p.CollectionIDs,
+		IsTaxesEnabled:      p.IsTaxesEnabled,
+		Taxes:               p.Taxes,
+		Slug:                p.Slug,
+		SEO:                 p.SEO,
+	}
+}
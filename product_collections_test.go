@@ -0,0 +1,190 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestProductsService_Collections(t *testing.T) {
+	var created, updated ProductCollectionRequest
+	var deleted bool
+	checkQuery := func(r *http.Request) {
+		if q := r.URL.Query(); q.Get("altId") != "loc-1" || q.Get("altType") != "location" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /products/collections": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r)
+			q := r.URL.Query()
+			if q.Get("name") != "Summer" || q.Get("collectionIds") != "col-1,col-2" || q.Get("limit") != "10" || q.Get("offset") != "20" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"data":[{"_id":"col-1","name":"Summer"}],"total":1}`))
+		},
+		"GET /products/collections/col-1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"_id":"col-1","name":"Summer","slug":"summer"}}`))
+		},
+		"POST /products/collections": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"data":{"_id":"col-2","name":"Winter","slug":"winter"}}`))
+		},
+		"PUT /products/collections/col-1": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{}`))
+		},
+		"DELETE /products/collections/col-1": func(w http.ResponseWriter, r *http.Request) {
+			checkQuery(r)
+			deleted = true
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	collections, err := client.Products.ListCollections(&ListProductCollectionsOptions{
+		LocationID:    "loc-1",
+		Name:          "Summer",
+		CollectionIDs: []string{"col-1", "col-2"},
+		Limit:         10,
+		Offset:        20,
+	})
+	if err != nil {
+		t.Fatalf("ListCollections failed: %v", err)
+	}
+	if collections.Total != 1 || len(collections.Data) != 1 || collections.Data[0].ID != "col-1" {
+		t.Errorf("Unexpected collections: %+v", collections)
+	}
+
+	collection, err := client.Products.GetCollection("col-1")
+	if err != nil || collection.Slug != "summer" {
+		t.Errorf("GetCollection = %+v, %v", collection, err)
+	}
+
+	collection, err = client.Products.CreateCollection(&ProductCollectionRequest{LocationID: "loc-1", Name: "Winter", Slug: "winter"})
+	if err != nil {
+		t.Fatalf("CreateCollection failed: %v", err)
+	}
+	if collection.ID != "col-2" || created.LocationID != "loc-1" || created.AltType != "location" {
+		t.Errorf("Unexpected create: %+v, %+v", collection, created)
+	}
+
+	if err := client.Products.UpdateCollection("col-1", &ProductCollectionRequest{LocationID: "loc-1", Name: "Summer Sale", Slug: "summer"}); err != nil {
+		t.Fatalf("UpdateCollection failed: %v", err)
+	}
+	if updated.LocationID != "loc-1" || updated.AltType != "location" || updated.Name != "Summer Sale" {
+		t.Errorf("Unexpected update request: %+v", updated)
+	}
+
+	if err := client.Products.DeleteCollection("loc-1", "col-1"); err != nil || !deleted {
+		t.Errorf("DeleteCollection failed: %v", err)
+	}
+
+	if _, err := client.Products.CreateCollection(&ProductCollectionRequest{LocationID: "loc-1", Slug: "winter"}); err == nil {
+		t.Error("Expected error for missing name")
+	}
+	if _, err := client.Products.CreateCollection(&ProductCollectionRequest{LocationID: "loc-1", Name: "Winter"}); err == nil {
+		t.Error("Expected error for missing slug")
+	}
+	if _, err := client.Products.GetCollection(""); err == nil {
+		t.Error("Expected error for missing collectionId")
+	}
+	if err := client.Products.UpdateCollection("loc-1", &ProductCollectionRequest{}); err == nil {
+		t.Error("Expected error for missing collectionId")
+	}
+	if err := client.Products.DeleteCollection("loc-1", ""); err == nil {
+		t.Error("Expected error for missing collectionId")
+	}
+}
+
+func TestProductsService_CollectionMembership(t *testing.T) {
+	var mu sync.Mutex
+	products := map[string][]string{
+		"prod-1": {"col-a"},
+		"prod-2": {"col-1", "col-b"},
+	}
+	var updates []string
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /products/{productId}": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("locationId") != "loc-1" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			id := r.PathValue("productId")
+			writeJSON(w, Product{ID: id, Name: "Product " + id, CollectionIDs: products[id]})
+		},
+		"PUT /products/{productId}": func(w http.ResponseWriter, r *http.Request) {
+			var req ProductRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			mu.Lock()
+			defer mu.Unlock()
+			id := r.PathValue("productId")
+			if req.Name != "Product "+id {
+				t.Errorf("Update did not preserve the product: %+v", req)
+			}
+			products[id] = req.CollectionIDs
+			updates = append(updates, id)
+			writeJSON(w, Product{ID: id})
+		},
+	})
+
+	if err := client.Products.AddToCollection("loc-1", "col-1", []string{"prod-1", "prod-2"}); err != nil {
+		t.Fatalf("AddToCollection failed: %v", err)
+	}
+	if len(updates) != 1 || updates[0] != "prod-1" {
+		t.Errorf("Expected only prod-1 to be updated, got %v", updates)
+	}
+	if got := products["prod-1"]; len(got) != 2 || got[0] != "col-a" || got[1] != "col-1" {
+		t.Errorf("Unexpected prod-1 collections: %v", got)
+	}
+
+	if err := client.Products.RemoveFromCollection("loc-1", "col-1", []string{"prod-2"}); err != nil {
+		t.Fatalf("RemoveFromCollection failed: %v", err)
+	}
+	if got := products["prod-2"]; len(got) != 1 || got[0] != "col-b" {
+		t.Errorf("Unexpected prod-2 collections: %v", got)
+	}
+
+	if err := client.Products.AddToCollection("loc-1", "", []string{"prod-1"}); err == nil {
+		t.Error("Expected error for missing collectionId")
+	}
+	if err := client.Products.RemoveFromCollection("loc-1", "col-1", nil); err == nil {
+		t.Error("Expected error for missing products")
+	}
+}
+
+func TestProductsService_StoreStatus(t *testing.T) {
+	var requests []storeStatusRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /products/store/store-1": func(w http.ResponseWriter, r *http.Request) {
+			var req storeStatusRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			requests = append(requests, req)
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	if err := client.Products.IncludeInStore("loc-1", "store-1", []string{"prod-1", "prod-2"}); err != nil {
+		t.Fatalf("IncludeInStore failed: %v", err)
+	}
+	if err := client.Products.ExcludeFromStore("loc-1", "store-1", []string{"prod-3"}); err != nil {
+		t.Fatalf("ExcludeFromStore failed: %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	if r := requests[0]; r.AltID != "loc-1" || r.AltType != "location" || r.Action != "include" || len(r.ProductIDs) != 2 {
+		t.Errorf("Unexpected include request: %+v", r)
+	}
+	if r := requests[1]; r.Action != "exclude" || len(r.ProductIDs) != 1 || r.ProductIDs[0] != "prod-3" {
+		t.Errorf("Unexpected exclude request: %+v", r)
+	}
+
+	if err := client.Products.IncludeInStore("loc-1", "", []string{"prod-1"}); err == nil {
+		t.Error("Expected error for missing storeId")
+	}
+	if err := client.Products.ExcludeFromStore("loc-1", "store-1", nil); err == nil {
+		t.Error("Expected error for missing products")
+	}
+}
@@ -0,0 +1,84 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// ProductImageRequest represents an image to upload and attach to a product
+type ProductImageRequest struct {
+	File     io.Reader
+	FileName string
+	Title    string   // Title of the image on the product (default: FileName)
+	Featured bool     // Make this the product's featured image; the first image is always featured
+	PriceIDs []string // Prices (variants) the image is shown for, all when empty
+	ParentID string   // Media library folder to upload into (default: the root folder)
+
+	OnProgress ProgressFunc
+}
+
+// UploadImage uploads an image to the media library and attaches it to a product, so product
+// sync jobs need a single call. The product is fetched first and updated with its other fields
+// unchanged. If the update fails, the image stays in the media library and the error names it.
+// Required scopes: medias.write, products.readonly, products.write
+func (s *ProductsService) UploadImage(ctx context.Context, locationID, productID string, req *ProductImageRequest) (*Product, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+
+	product, err := s.Get(locationID, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %s: %w", productID, err)
+	}
+
+	media, err := s.client.Media.Upload(ctx, &UploadMediaRequest{
+		File:       req.File,
+		FileName:   req.FileName,
+		ParentID:   req.ParentID,
+		OnProgress: req.OnProgress,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload image: %w", err)
+	}
+	if media.URL == "" {
+		return nil, fmt.Errorf("media library returned no URL for uploaded image %s", media.FileID)
+	}
+
+	title := req.Title
+	if title == "" {
+		title = req.FileName
+	}
+	featured := req.Featured || product.Image == ""
+
+	update := productRequestFrom(locationID, product)
+	update.Medias = make([]ProductMedia, 0, len(product.Medias)+1)
+	for _, m := range product.Medias {
+		if featured {
+			m.IsFeatured = false
+		}
+		update.Medias = append(update.Medias, m)
+	}
+	update.Medias = append(update.Medias, ProductMedia{
+		ID:         media.FileID,
+		Title:      title,
+		URL:        media.URL,
+		Type:       "image",
+		IsFeatured: featured,
+		PriceIDs:   req.PriceIDs,
+	})
+	if featured {
+		update.Image = media.URL
+	}
+
+	updated, err := s.Update(productID, update)
+	if err != nil {
+		return nil, fmt.Errorf("image uploaded as %s but failed to attach it to product %s: %w", media.FileID, productID, err)
+	}
+
+	return updated, nil
+}
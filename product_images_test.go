@@ -0,0 +1,52 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProductsService_UploadImage(t *testing.T) {
+	var updated ProductRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/products/prod-1":
+			_, _ = w.Write([]byte(`{"_id":"prod-1","name":"Mug","productType":"PHYSICAL","image":"https://cdn.example.com/old.png",
+				"medias":[{"id":"m-old","url":"https://cdn.example.com/old.png","type":"image","isFeatured":true}]}`))
+		case r.Method == "POST" && r.URL.Path == "/medias/upload-file":
+			if _, header, err := r.FormFile("file"); err != nil || header.Filename != "mug.png" {
+				t.Errorf("upload = %v, %v", header, err)
+			}
+			_, _ = w.Write([]byte(`{"fileId":"m-new","url":"https://cdn.example.com/mug.png"}`))
+		case r.Method == "PUT" && r.URL.Path == "/products/prod-1":
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{"_id":"prod-1","name":"Mug","image":"https://cdn.example.com/mug.png"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	product, err := client.Products.UploadImage(context.Background(), "loc-1", "prod-1", &ProductImageRequest{
+		File:     strings.NewReader("png data"),
+		FileName: "mug.png",
+		Featured: true,
+	})
+	if err != nil {
+		t.Fatalf("UploadImage failed: %v", err)
+	}
+	if product.Image != "https://cdn.example.com/mug.png" {
+		t.Errorf("product = %+v", product)
+	}
+
+	if updated.Name != "Mug" || updated.Image != "https://cdn.example.com/mug.png" || len(updated.Medias) != 2 {
+		t.Fatalf("update request = %+v", updated)
+	}
+	if updated.Medias[0].IsFeatured || !updated.Medias[1].IsFeatured || updated.Medias[1].ID != "m-new" || updated.Medias[1].Title != "mug.png" {
+		t.Errorf("medias = %+v", updated.Medias)
+	}
+}
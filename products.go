@@ -0,0 +1,223 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ProductsService handles operations related to products
+type ProductsService struct {
+	client *Client
+}
+
+// Product represents a GoHighLevel product
+type Product struct {
+	ID                  string           `json:"_id,omitempty"`
+	LocationID          string           `json:"locationId,omitempty"`
+	Name                string           `json:"name,omitempty"`
+	Description         string           `json:"description,omitempty"`
+	ProductType         string           `json:"productType,omitempty"`
+	Image               string           `json:"image,omitempty"`
+	StatementDescriptor string           `json:"statementDescriptor,omitempty"`
+	AvailableInStore    bool             `json:"availableInStore,omitempty"`
+	Medias              []ProductMedia   `json:"medias,omitempty"`
+	Variants            []ProductVariant `json:"variants,omitempty"`
+	CollectionIDs       []string         `json:"collectionIds,omitempty"`
+	IsTaxesEnabled      bool             `json:"isTaxesEnabled,omitempty"`
+	Taxes               []string         `json:"taxes,omitempty"`
+	Slug                string           `json:"slug,omitempty"`
+	SEO                 *ProductSEO      `json:"seo,omitempty"`
+	CreatedAt           Time             `json:"createdAt,omitempty"`
+	UpdatedAt           Time             `json:"updatedAt,omitempty"`
+	// Extra holds fields returned by the API that this struct does not declare yet,
+	// so they survive a decode/encode round-trip
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ProductMedia represents an image or video attached to a product
+type ProductMedia struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title,omitempty"`
+	URL        string   `json:"url"`
+	Type       string   `json:"type"` // "image" or "video"
+	IsFeatured bool     `json:"isFeatured,omitempty"`
+	PriceIDs   []string `json:"priceIds,omitempty"`
+}
+
+// ProductVariant represents a variant dimension of a product (e.g. size or color)
+type ProductVariant struct {
+	ID      string                 `json:"id"`
+	Name    string                 `json:"name"`
+	Options []ProductVariantOption `json:"options"`
+}
+
+// ProductVariantOption represents one option of a product variant (e.g. "Large")
+type ProductVariantOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ProductSEO represents the SEO metadata of a product
+type ProductSEO struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ProductRequest represents a request to create or update a product
+type ProductRequest struct {
+	LocationID          string           `json:"locationId"`
+	Name                string           `json:"name"`
+	Description         string           `json:"description,omitempty"`
+	ProductType         string           `json:"productType"` // "DIGITAL", "PHYSICAL", "SERVICE" or "PHYSICAL/DIGITAL"
+	Image               string           `json:"image,omitempty"`
+	StatementDescriptor string           `json:"statementDescriptor,omitempty"`
+	AvailableInStore    bool             `json:"availableInStore,omitempty"`
+	Medias              []ProductMedia   `json:"medias,omitempty"`
+	Variants            []ProductVariant `json:"variants,omitempty"`
+	CollectionIDs       []string         `json:"collectionIds,omitempty"`
+	IsTaxesEnabled      bool             `json:"isTaxesEnabled,omitempty"`
+	Taxes               []string         `json:"taxes,omitempty"`
+	Slug                string           `json:"slug,omitempty"`
+	SEO                 *ProductSEO      `json:"seo,omitempty"`
+}
+
+// ListProductsOptions represents query options for listing products
+type ListProductsOptions struct {
+	LocationID       string
+	Search           string
+	CollectionIDs    []string
+	ProductIDs       []string
+	AvailableInStore bool
+	Limit            int
+	Offset           int
+}
+
+// ProductsResponse represents a list of products API response
+type ProductsResponse struct {
+	Products []Product `json:"products,omitempty"`
+	Total    []struct {
+		Total int `json:"total,omitempty"`
+	} `json:"total,omitempty"`
+}
+
+// List retrieves products for a location with optional filters
+// Required scope: products.readonly
+func (s *ProductsService) List(opts *ListProductsOptions) (*ProductsResponse, error) {
+	if opts == nil {
+		opts = &ListProductsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := url.Values{}
+	query.Set("locationId", opts.LocationID)
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	for _, id := range opts.CollectionIDs {
+		query.Add("collectionIds", id)
+	}
+	for _, id := range opts.ProductIDs {
+		query.Add("productIds", id)
+	}
+	if opts.AvailableInStore {
+		query.Set("availableInStore", "true")
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+
+	var result ProductsResponse
+	err := s.client.doRequest("GET", "/products/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves a product by ID
+// Required scope: products.readonly
+func (s *ProductsService) Get(locationID, productID string) (*Product, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+
+	var result Product
+	err := s.client.doRequest("GET", fmt.Sprintf("/products/%s?%s", productID, locationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates a new product
+// Required scope: products.write
+func (s *ProductsService) Create(req *ProductRequest) (*Product, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.ProductType == "" {
+		return nil, fmt.Errorf("productType is required")
+	}
+
+	var result Product
+	err := s.client.doRequest("POST", "/products/", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update updates an existing product
+// Required scope: products.write
+func (s *ProductsService) Update(productID string, req *ProductRequest) (*Product, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("productId is required")
+	}
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result Product
+	err := s.client.doRequest("PUT", fmt.Sprintf("/products/%s", productID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a product
+// Required scope: products.write
+func (s *ProductsService) Delete(locationID, productID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if productID == "" {
+		return fmt.Errorf("productId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/products/%s?%s", productID, locationQuery(locationID).Encode()), nil, nil)
+}
@@ -0,0 +1,83 @@
+package gohighlevel
+
+import (
+	"testing"
+	"time"
+)
+
+// Integration tests for Products API
+// These tests use the same environment variables as the Contacts tests.
+
+func TestProductsIntegration_FullWorkflow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	timestamp := time.Now().Format("20060102150405")
+
+	// 1. Create a product
+	t.Log("Step 1: Creating product")
+	product, err := client.Products.Create(&ProductRequest{
+		LocationID:  locationID,
+		Name:        "Test Product " + timestamp,
+		Description: "Created by integration tests",
+		ProductType: "DIGITAL",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if product.ID == "" {
+		t.Fatal("Created product has no ID")
+	}
+	t.Logf("Created product: %s", product.ID)
+
+	defer func() {
+		_ = client.Products.Delete(locationID, product.ID)
+	}()
+
+	// 2. Get the product
+	t.Log("Step 2: Retrieving product")
+	retrieved, err := client.Products.Get(locationID, product.ID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if retrieved.Name != product.Name {
+		t.Errorf("Expected name %s, got %s", product.Name, retrieved.Name)
+	}
+
+	// 3. Update the product
+	t.Log("Step 3: Updating product")
+	updated, err := client.Products.Update(product.ID, &ProductRequest{
+		LocationID:  locationID,
+		Name:        "Updated Product " + timestamp,
+		ProductType: "DIGITAL",
+	})
+	if err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+	if updated.Name != "Updated Product "+timestamp {
+		t.Errorf("Product name not updated, got %s", updated.Name)
+	}
+
+	// 4. List products
+	t.Log("Step 4: Listing products")
+	products, err := client.Products.List(&ListProductsOptions{
+		LocationID: locationID,
+		ProductIDs: []string{product.ID},
+	})
+	if err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+	if len(products.Products) != 1 {
+		t.Errorf("Expected 1 product, got %d", len(products.Products))
+	}
+
+	// 5. Delete the product
+	t.Log("Step 5: Deleting product")
+	if err := client.Products.Delete(locationID, product.ID); err != nil {
+		t.Fatalf("Failed to delete product: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package gohighlevel
+
+import (
+	"context"
+	"io"
+)
+
+// ProgressFunc is called as an upload is sent, with the bytes sent so far and the total size
+// (-1 if unknown). It is called from the goroutine sending the request.
+type ProgressFunc func(sent, total int64)
+
+// ProgressReader wraps a reader, reporting how much has been read and failing with the context's
+// error once the context is done, so an upload can show progress and be aborted
+type ProgressReader struct {
+	ctx        context.Context
+	r          io.Reader
+	total      int64
+	sent       int64
+	onProgress ProgressFunc
+}
+
+// NewProgressReader returns a reader of r that calls onProgress after every read. total is the
+// size reported to onProgress, -1 if unknown.
+func NewProgressReader(ctx context.Context, r io.Reader, total int64, onProgress ProgressFunc) *ProgressReader {
+	return &ProgressReader{ctx: ctx, r: r, total: total, onProgress: onProgress}
+}
+
+func (p *ProgressReader) Read(b []byte) (int, error) {
+	if err := p.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		if p.onProgress != nil {
+			p.onProgress(p.sent, p.total)
+		}
+	}
+	return n, err
+}
+
+// Sent returns the number of bytes read so far
+func (p *ProgressReader) Sent() int64 {
+	return p.sent
+}
@@ -0,0 +1,87 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limit headers returned by the API
+const (
+	headerRateLimitMax            = "X-RateLimit-Max"
+	headerRateLimitRemaining      = "X-RateLimit-Remaining"
+	headerRateLimitInterval       = "X-RateLimit-Interval-Milliseconds"
+	headerRateLimitDaily          = "X-RateLimit-Limit-Daily"
+	headerRateLimitDailyRemaining = "X-RateLimit-Daily-Remaining"
+)
+
+// RateLimitStatus is the rate limit of a location as last reported by the API
+type RateLimitStatus struct {
+	LocationID     string
+	Limit          int           // Requests allowed per burst interval
+	Remaining      int           // Requests remaining in the current burst interval
+	Interval       time.Duration // Length of the burst interval
+	DailyLimit     int           // Requests allowed per day
+	DailyRemaining int           // Requests remaining today
+	ResetAt        time.Time     // When the burst interval ends, at the latest
+	ObservedAt     time.Time     // When the response reporting this status was received
+}
+
+// parseRateLimitStatus reads the rate limit headers of a response. It returns false when the
+// response carries no rate limit information.
+func parseRateLimitStatus(header http.Header, now time.Time) (RateLimitStatus, bool) {
+	if header == nil {
+		return RateLimitStatus{}, false
+	}
+	remaining, err := strconv.Atoi(header.Get(headerRateLimitRemaining))
+	if err != nil {
+		return RateLimitStatus{}, false
+	}
+
+	status := RateLimitStatus{Remaining: remaining, ObservedAt: now}
+	status.Limit, _ = strconv.Atoi(header.Get(headerRateLimitMax))
+	status.DailyLimit, _ = strconv.Atoi(header.Get(headerRateLimitDaily))
+	status.DailyRemaining, _ = strconv.Atoi(header.Get(headerRateLimitDailyRemaining))
+	if ms, err := strconv.Atoi(header.Get(headerRateLimitInterval)); err == nil {
+		status.Interval = time.Duration(ms) * time.Millisecond
+		status.ResetAt = now.Add(status.Interval)
+	}
+	return status, true
+}
+
+// rateLimitState holds the last rate limit status seen per location, shared with clients
+// derived from the same client
+type rateLimitState struct {
+	mu         sync.RWMutex
+	byLocation map[string]RateLimitStatus
+}
+
+func (s *rateLimitState) set(status RateLimitStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byLocation == nil {
+		s.byLocation = make(map[string]RateLimitStatus)
+	}
+	s.byLocation[status.LocationID] = status
+}
+
+func (s *rateLimitState) get(locationID string) (RateLimitStatus, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	status, ok := s.byLocation[locationID]
+	return status, ok
+}
+
+// RateLimitStatus returns the rate limit of the client's default location as last reported by
+// the API, so schedulers can plan work instead of discovering limits through 429 responses.
+// It returns false until a response with rate limit headers has been received.
+func (c *Client) RateLimitStatus() (RateLimitStatus, bool) {
+	return c.RateLimitStatusFor(c.locationID)
+}
+
+// RateLimitStatusFor returns the last reported rate limit of a location. Statuses are recorded
+// under the default location ID of the client (or derived client) that sent the request.
+func (c *Client) RateLimitStatusFor(locationID string) (RateLimitStatus, bool) {
+	return c.rateLimits.get(locationID)
+}
@@ -0,0 +1,75 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimitStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	header := http.Header{}
+	header.Set("X-RateLimit-Max", "100")
+	header.Set("X-RateLimit-Remaining", "42")
+	header.Set("X-RateLimit-Interval-Milliseconds", "10000")
+	header.Set("X-RateLimit-Limit-Daily", "200000")
+	header.Set("X-RateLimit-Daily-Remaining", "199000")
+
+	status, ok := parseRateLimitStatus(header, now)
+	if !ok {
+		t.Fatal("expected rate limit status")
+	}
+	want := RateLimitStatus{
+		Limit:          100,
+		Remaining:      42,
+		Interval:       10 * time.Second,
+		DailyLimit:     200000,
+		DailyRemaining: 199000,
+		ResetAt:        now.Add(10 * time.Second),
+		ObservedAt:     now,
+	}
+	if status != want {
+		t.Errorf("status = %+v, want %+v", status, want)
+	}
+
+	if _, ok := parseRateLimitStatus(http.Header{}, now); ok {
+		t.Error("expected no status without headers")
+	}
+}
+
+func TestClient_RateLimitStatus(t *testing.T) {
+	remaining := "99"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", remaining)
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc1"})
+	if _, ok := client.RateLimitStatus(); ok {
+		t.Error("expected no status before any request")
+	}
+
+	if _, err := client.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	status, ok := client.RateLimitStatus()
+	if !ok || status.Remaining != 99 || status.LocationID != "loc1" {
+		t.Errorf("status = %+v, %v", status, ok)
+	}
+
+	// A derived client for another location records its own status in the shared state
+	other := client.WithMetadata(nil)
+	other.SetLocationID("loc2")
+	remaining = "7"
+	if _, err := other.Contacts.Get("c1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if status, _ := client.RateLimitStatusFor("loc2"); status.Remaining != 7 {
+		t.Errorf("loc2 remaining = %d, want 7", status.Remaining)
+	}
+	if status, _ := client.RateLimitStatus(); status.Remaining != 99 {
+		t.Errorf("loc1 remaining = %d, want 99", status.Remaining)
+	}
+}
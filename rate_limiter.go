@@ -0,0 +1,105 @@
+package gohighlevel
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter paces outgoing API requests. Wait blocks until a request may be sent.
+// *rate.Limiter from golang.org/x/time/rate satisfies this interface.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucketLimiter is a simple token bucket RateLimiter
+type TokenBucketLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	rate     float64 // tokens per second
+	last     time.Time
+}
+
+// NewRateLimiter returns a limiter allowing bursts of up to requests calls, refilled evenly
+// over per. GoHighLevel allows 100 requests per 10 seconds per location, so
+// NewRateLimiter(100, 10*time.Second) matches the API's burst limit.
+func NewRateLimiter(requests int, per time.Duration) *TokenBucketLimiter {
+	if requests <= 0 {
+		requests = 1
+	}
+	if per <= 0 {
+		per = time.Second
+	}
+	return &TokenBucketLimiter{
+		capacity: float64(requests),
+		tokens:   float64(requests),
+		rate:     float64(requests) / per.Seconds(),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available and returns 0, or returns how long to wait
+func (l *TokenBucketLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// RateLimiterSet hands out one rate limiter per location. GoHighLevel limits requests per
+// location, so clients serving many locations share a set and every client of the same
+// location is paced by the same limiter.
+type RateLimiterSet struct {
+	mu         sync.Mutex
+	newLimiter func() RateLimiter
+	limiters   map[string]RateLimiter
+}
+
+// NewRateLimiterSet returns a set that creates limiters with newLimiter, e.g.
+//
+//	ghl.NewRateLimiterSet(func() ghl.RateLimiter { return ghl.NewRateLimiter(100, 10*time.Second) })
+func NewRateLimiterSet(newLimiter func() RateLimiter) *RateLimiterSet {
+	return &RateLimiterSet{newLimiter: newLimiter, limiters: make(map[string]RateLimiter)}
+}
+
+// For returns the limiter of a location, creating it on first use
+func (s *RateLimiterSet) For(locationID string) RateLimiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	limiter, ok := s.limiters[locationID]
+	if !ok {
+		limiter = s.newLimiter()
+		s.limiters[locationID] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,70 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// BulkCreateRecords creates many records of a custom object concurrently and reports the outcome
+// of each one, like Contacts.BulkUpsert. Set opts.RateLimiter to leave headroom for other
+// traffic during large migrations. The created records are returned in input order; entries
+// for failed items are nil.
+// Required scope: objects/record.write
+func (s *CustomObjectsService) BulkCreateRecords(ctx context.Context, objectKey string, reqs []*ObjectRecordRequest, opts *BulkOptions) ([]*ObjectRecord, BulkResults) {
+	records := make([]*ObjectRecord, len(reqs))
+	results := RunBulk(ctx, len(reqs), opts, func(ctx context.Context, i int) error {
+		if reqs[i] == nil {
+			return fmt.Errorf("record request is nil")
+		}
+		record, err := s.CreateRecord(objectKey, reqs[i])
+		if err != nil {
+			return err
+		}
+		records[i] = record
+		return nil
+	})
+	return records, results
+}
+
+// ReadRecordsCSV reads record requests for BulkCreateRecords from CSV. The header row names the
+// property key of each column, without the "custom_objects.<object>." prefix; empty cells are
+// left out. Values are strings, so convert numeric or multi-select properties before creating
+// the records.
+func ReadRecordsCSV(r io.Reader, locationID string) ([]*ObjectRecordRequest, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range header {
+		header[i] = strings.TrimSpace(key)
+		if header[i] == "" {
+			return nil, fmt.Errorf("column %d has no property key", i+1)
+		}
+	}
+
+	var reqs []*ObjectRecordRequest
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return reqs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		props := make(map[string]interface{}, len(row))
+		for i, value := range row {
+			if value != "" {
+				props[header[i]] = value
+			}
+		}
+		reqs = append(reqs, &ObjectRecordRequest{LocationID: locationID, Properties: props})
+	}
+}
@@ -0,0 +1,67 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadRecordsCSV(t *testing.T) {
+	reqs, err := ReadRecordsCSV(strings.NewReader("name, breed\nRex,Beagle\nMax,\n"), "loc-1")
+	if err != nil {
+		t.Fatalf("ReadRecordsCSV failed: %v", err)
+	}
+	if len(reqs) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(reqs))
+	}
+	if reqs[0].LocationID != "loc-1" || reqs[0].Properties["breed"] != "Beagle" {
+		t.Errorf("Unexpected first record: %+v", reqs[0])
+	}
+	if _, ok := reqs[1].Properties["breed"]; ok {
+		t.Errorf("Expected empty cell to be left out, got %+v", reqs[1].Properties)
+	}
+
+	if _, err := ReadRecordsCSV(strings.NewReader("name,\nRex,x\n"), "loc-1"); err == nil {
+		t.Error("Expected error for a column without a property key")
+	}
+}
+
+func TestBulkCreateRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/objects/custom_objects.pets/records" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req ObjectRecordRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		name, _ := req.Properties["name"].(string)
+		if name == "bad" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message":"invalid"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(ObjectRecordResponse{Record: &ObjectRecord{ID: "rec-" + name}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	reqs := []*ObjectRecordRequest{
+		{LocationID: "loc-1", Properties: map[string]interface{}{"name": "rex"}},
+		{LocationID: "loc-1", Properties: map[string]interface{}{"name": "bad"}},
+		{LocationID: "loc-1", Properties: map[string]interface{}{"name": "max"}},
+	}
+
+	records, results := client.CustomObjects.BulkCreateRecords(context.Background(), "custom_objects.pets", reqs, &BulkOptions{Concurrency: 2})
+	if results.Succeeded() != 2 {
+		t.Fatalf("Expected 2 successes, got %d", results.Succeeded())
+	}
+	failed := results.Failed()
+	if len(failed) != 1 || failed[0].Index != 1 {
+		t.Errorf("Expected item 1 to fail, got %+v", failed)
+	}
+	if records[0] == nil || records[0].ID != "rec-rex" || records[1] != nil || records[2].ID != "rec-max" {
+		t.Errorf("Unexpected records: %+v", records)
+	}
+}
@@ -0,0 +1,124 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RecordFilter is a condition of a custom object record search, built like a ContactFilter with
+// the Filter* operators. Set Field, Operator and Value for a single condition, or Group ("AND" or
+// "OR") and Filters to combine conditions. Object properties are addressed as
+// "properties.<key>"; PropertyFilter adds the prefix.
+type RecordFilter struct {
+	Field    string         `json:"field,omitempty"`
+	Operator string         `json:"operator,omitempty"`
+	Value    interface{}    `json:"value,omitempty"`
+	Group    string         `json:"group,omitempty"`
+	Filters  []RecordFilter `json:"filters,omitempty"`
+}
+
+// PropertyFilter returns a filter on an object property, given by its key without the
+// "custom_objects.<object>." prefix
+func PropertyFilter(key, operator string, value interface{}) RecordFilter {
+	return RecordFilter{Field: recordPropertyField(key), Operator: operator, Value: value}
+}
+
+// PropertyRangeFilter returns a filter matching records whose numeric property is at least min
+// and below max. A nil bound leaves that end open.
+func PropertyRangeFilter(key string, min, max *float64) RecordFilter {
+	value := map[string]float64{}
+	if min != nil {
+		value["gte"] = *min
+	}
+	if max != nil {
+		value["lt"] = *max
+	}
+	return RecordFilter{Field: recordPropertyField(key), Operator: FilterRange, Value: value}
+}
+
+// RecordDateRangeFilter returns a filter matching records whose date field, e.g. "dateAdded",
+// "dateUpdated" or a date property, is at or after from and before to, like DateRangeFilter.
+func RecordDateRangeFilter(field string, from, to time.Time) RecordFilter {
+	f := DateRangeFilter(field, from, to)
+	return RecordFilter{Field: f.Field, Operator: f.Operator, Value: f.Value}
+}
+
+// AllOf returns a filter matching records that match every one of filters
+func AllOf(filters ...RecordFilter) RecordFilter {
+	return RecordFilter{Group: "AND", Filters: filters}
+}
+
+// AnyOf returns a filter matching records that match at least one of filters
+func AnyOf(filters ...RecordFilter) RecordFilter {
+	return RecordFilter{Group: "OR", Filters: filters}
+}
+
+// recordPropertyField returns the search field of a property key
+func recordPropertyField(key string) string {
+	if strings.HasPrefix(key, "properties.") {
+		return key
+	}
+	return "properties." + key
+}
+
+// RecordSort orders record search results by "dateAdded", "dateUpdated" or a property
+// ("properties.<key>")
+type RecordSort struct {
+	Field     string        `json:"field"`
+	Direction SortDirection `json:"direction"` // Default SortAsc
+}
+
+// validate checks the sort direction and fills in the default
+func (s *RecordSort) validate() error {
+	if s.Field == "" {
+		return fmt.Errorf("sort field is required")
+	}
+	switch s.Direction {
+	case "":
+		s.Direction = SortAsc
+	case SortAsc, SortDesc:
+	default:
+		return fmt.Errorf("sort direction must be asc or desc")
+	}
+	return nil
+}
+
+// SearchRecordsPage retrieves a page of record search results like SearchRecords, with HasMore
+// and NextPage for walking every match
+// Required scope: objects/record.readonly
+func (s *CustomObjectsService) SearchRecordsPage(ctx context.Context, objectKey string, req *SearchObjectRecordsRequest) (*Page[ObjectRecord], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &SearchObjectRecordsRequest{}
+	}
+	current := *req
+	if err := current.applyPageDefaults(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.SearchRecords(objectKey, &current)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[ObjectRecord]{Items: result.Records, Total: result.Total}
+	pageNumber := current.Page
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	next := pageNumber * current.PageLimit
+	page.HasMore = len(result.Records) == current.PageLimit && next < result.Total
+	if page.HasMore {
+		page.NextPageToken = offsetPageToken(next)
+		nextReq := current
+		nextReq.PageToken = page.NextPageToken
+		page.next = func(ctx context.Context) (*Page[ObjectRecord], error) {
+			return s.SearchRecordsPage(ctx, objectKey, &nextReq)
+		}
+	}
+	return page, nil
+}
@@ -0,0 +1,145 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// RelationsService handles operations related to associations between contacts, opportunities
+// and custom object records
+type RelationsService struct {
+	client *Client
+}
+
+// Relation links two records through an association
+type Relation struct {
+	ID              string `json:"id,omitempty"`
+	AssociationID   string `json:"associationId,omitempty"`
+	FirstRecordID   string `json:"firstRecordId,omitempty"`
+	FirstObjectKey  string `json:"firstObjectKey,omitempty"`
+	SecondRecordID  string `json:"secondRecordId,omitempty"`
+	SecondObjectKey string `json:"secondObjectKey,omitempty"`
+	LocationID      string `json:"locationId,omitempty"`
+	CreatedAt       Time   `json:"createdAt,omitempty"`
+}
+
+// Other returns the ID and object key of the record linked to recordID by the relation
+func (r *Relation) Other(recordID string) (string, string) {
+	if r.FirstRecordID == recordID {
+		return r.SecondRecordID, r.SecondObjectKey
+	}
+	return r.FirstRecordID, r.FirstObjectKey
+}
+
+// ListRelationsOptions represents query options for listing the relations of a record
+type ListRelationsOptions struct {
+	LocationID     string
+	AssociationIDs []string // Only these associations when set
+	Skip           int
+	Limit          int // Default 20
+	// PageToken resumes listing from Page.NextPageToken, overriding Skip
+	PageToken string
+}
+
+// RelationsResponse represents a list of relations API response
+type RelationsResponse struct {
+	Relations []Relation `json:"relations,omitempty"`
+	Total     int        `json:"total,omitempty"`
+}
+
+// applyPageDefaults sets the default page size and the offset encoded in o.PageToken
+func (o *ListRelationsOptions) applyPageDefaults() error {
+	if o.Limit <= 0 {
+		o.Limit = 20
+	}
+	if o.PageToken != "" {
+		offset, err := parseOffsetPageToken(o.PageToken)
+		if err != nil {
+			return err
+		}
+		o.Skip, o.PageToken = offset, ""
+	}
+	return nil
+}
+
+// ListByRecord retrieves a page of the relations of a contact, opportunity or custom object
+// record. When objectKey is set, e.g. "custom_objects.pets" or "contact", only relations whose
+// other record is of that object are returned; Total still counts every relation.
+// Required scope: associations/relation.readonly
+func (s *RelationsService) ListByRecord(recordID, objectKey string, opts *ListRelationsOptions) (*RelationsResponse, error) {
+	if recordID == "" {
+		return nil, fmt.Errorf("recordId is required")
+	}
+	if opts == nil {
+		opts = &ListRelationsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if err := opts.applyPageDefaults(); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("locationId", opts.LocationID)
+	params.Set("skip", strconv.Itoa(opts.Skip))
+	params.Set("limit", strconv.Itoa(opts.Limit))
+	for _, id := range opts.AssociationIDs {
+		params.Add("associationIds", id)
+	}
+
+	var result RelationsResponse
+	path := fmt.Sprintf("/associations/relations/%s?%s", url.PathEscape(recordID), params.Encode())
+	if err := s.client.doRequest("GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	if objectKey != "" {
+		matched := result.Relations[:0]
+		for _, relation := range result.Relations {
+			if _, key := relation.Other(recordID); key == objectKey {
+				matched = append(matched, relation)
+			}
+		}
+		result.Relations = matched
+	}
+	return &result, nil
+}
+
+// ListByRecordPage retrieves a page of the relations of a record like ListByRecord, with
+// HasMore and NextPage for walking every relation
+// Required scope: associations/relation.readonly
+func (s *RelationsService) ListByRecordPage(ctx context.Context, recordID, objectKey string, opts *ListRelationsOptions) (*Page[Relation], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &ListRelationsOptions{}
+	}
+	current := *opts
+	if err := current.applyPageDefaults(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.ListByRecord(recordID, objectKey, &current)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[Relation]{Items: result.Relations, Total: result.Total}
+	next := current.Skip + current.Limit
+	page.HasMore = next < result.Total
+	if page.HasMore {
+		page.NextPageToken = offsetPageToken(next)
+		nextOpts := current
+		nextOpts.PageToken = page.NextPageToken
+		page.next = func(ctx context.Context) (*Page[Relation], error) {
+			return s.ListByRecordPage(ctx, recordID, objectKey, &nextOpts)
+		}
+	}
+	return page, nil
+}
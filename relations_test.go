@@ -0,0 +1,62 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRelations_ListByRecordPage(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/associations/relations/contact-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("skip") == "0" {
+			w.Write([]byte(`{"total":3,"relations":[
+				{"id":"r1","firstRecordId":"contact-1","firstObjectKey":"contact","secondRecordId":"pet-1","secondObjectKey":"custom_objects.pets"},
+				{"id":"r2","firstRecordId":"opp-1","firstObjectKey":"opportunity","secondRecordId":"contact-1","secondObjectKey":"contact"}]}`))
+			return
+		}
+		w.Write([]byte(`{"total":3,"relations":[
+			{"id":"r3","firstRecordId":"pet-2","firstObjectKey":"custom_objects.pets","secondRecordId":"contact-1","secondObjectKey":"contact"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	page, err := client.Relations.ListByRecordPage(context.Background(), "contact-1", "custom_objects.pets", &ListRelationsOptions{
+		LocationID:     "loc-1",
+		AssociationIDs: []string{"assoc-1"},
+		Limit:          2,
+	})
+	if err != nil {
+		t.Fatalf("ListByRecordPage failed: %v", err)
+	}
+
+	var pets []string
+	for relation, err := range page.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("Iteration failed: %v", err)
+		}
+		id, _ := relation.Other("contact-1")
+		pets = append(pets, id)
+	}
+	if len(pets) != 2 || pets[0] != "pet-1" || pets[1] != "pet-2" {
+		t.Errorf("Unexpected linked records: %v", pets)
+	}
+	if len(queries) != 2 || queries[0] != "associationIds=assoc-1&limit=2&locationId=loc-1&skip=0" {
+		t.Errorf("Unexpected queries: %v", queries)
+	}
+}
+
+func TestRelations_ListByRecord_Validation(t *testing.T) {
+	client, _ := NewClient(Config{AccessToken: "token"})
+	if _, err := client.Relations.ListByRecord("", "", &ListRelationsOptions{LocationID: "loc-1"}); err == nil {
+		t.Error("Expected error for missing record ID")
+	}
+	if _, err := client.Relations.ListByRecord("rec-1", "", nil); err == nil {
+		t.Error("Expected error for missing location ID")
+	}
+}
@@ -0,0 +1,179 @@
+package gohighlevel
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of failed API requests.
+//
+// Requests rejected with 429 Too Many Requests are always safe to retry. Network errors and
+// 5xx responses are only retried for idempotent methods (GET, PUT, DELETE, HEAD), so a POST
+// that may have been processed is never sent twice.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt (default 3). Set it to
+	// NoRetries to keep the policy's other settings without retrying.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry (default 500ms); it doubles on each retry
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries (default 30s)
+	MaxBackoff time.Duration
+}
+
+// NoRetries is the RetryPolicy.MaxRetries value that disables retries, as a zero MaxRetries
+// selects the default
+const NoRetries = -1
+
+// DefaultRetryPolicy returns a retry policy with the default settings
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxRetries: 3, MinBackoff: 500 * time.Millisecond, MaxBackoff: 30 * time.Second}
+}
+
+// withDefaults fills in unset fields of the policy
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	defaults := DefaultRetryPolicy()
+	switch {
+	case p.MaxRetries == 0:
+		p.MaxRetries = defaults.MaxRetries
+	case p.MaxRetries < 0:
+		p.MaxRetries = 0
+	}
+	if p.MinBackoff <= 0 {
+		p.MinBackoff = defaults.MinBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaults.MaxBackoff
+	}
+	return p
+}
+
+// shouldRetry reports whether a request with the given outcome may be retried
+func (p RetryPolicy) shouldRetry(method string, statusCode int, err error) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(method) {
+		return false
+	}
+	if statusCode == 0 {
+		// No response at all: a connection or timeout error
+		return err != nil
+	}
+	return statusCode >= 500
+}
+
+// backoff returns the delay before the given retry (0-based), honoring a Retry-After header
+func (p RetryPolicy) backoff(retry int, header http.Header) time.Duration {
+	if header != nil {
+		if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay := time.Duration(seconds) * time.Second
+			if delay > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return delay
+		}
+	}
+
+	delay := p.MinBackoff << uint(retry)
+	if delay <= 0 || delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	// Full jitter keeps many clients from retrying in lockstep
+	return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
+
+// isIdempotent reports whether repeating a request with this method has no additional effect
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// sendRequest executes a request, waiting for the rate limiter and concurrency limiter before
+// each attempt and retrying according to the retry policy and retry budget. Cancelling ctx
+// aborts the request in flight and any wait before the next attempt.
+func (c *Client) sendRequest(ctx context.Context, method, path string, body, result interface{}) (int, []byte, error) {
+	var policy RetryPolicy
+	if c.retryPolicy != nil {
+		policy = c.retryPolicy.withDefaults()
+	}
+
+	for retry := 0; ; retry++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return 0, nil, err
+			}
+		}
+
+		if c.concurrency != nil {
+			if err := c.concurrency.acquire(ctx); err != nil {
+				return 0, nil, err
+			}
+		}
+
+		start := time.Now()
+		var (
+			statusCode int
+			header     http.Header
+			respBody   []byte
+			err        error
+		)
+		if _, stream := result.(*streamResult); c.hedging != nil && method == http.MethodGet && !stream {
+			statusCode, header, respBody, err = c.executeHedged(c.requestContext(ctx), method, path, result)
+		} else {
+			statusCode, header, respBody, err = c.executeRequest(c.requestContext(ctx), method, path, body, result)
+		}
+
+		overloaded := isOverloaded(statusCode, err)
+		if c.concurrency != nil {
+			c.concurrency.release(overloaded)
+		}
+		if c.retryBudget != nil {
+			c.retryBudget.record(overloaded)
+		}
+		info := RequestInfo{
+			Method:     method,
+			Path:       path,
+			StatusCode: statusCode,
+			Attempt:    retry + 1,
+			Duration:   time.Since(start),
+			Err:        err,
+			Metadata:   c.Metadata(),
+		}
+		if c.onRequest != nil {
+			c.onRequest(info)
+		}
+		if c.metrics != nil {
+			c.metrics.ObserveRequest(info)
+		}
+		if status, ok := parseRateLimitStatus(header, time.Now()); ok {
+			status.LocationID = c.locationID
+			c.rateLimits.set(status)
+			if c.metrics != nil {
+				c.metrics.ObserveRateLimit(status.LocationID, status.Remaining, status.DailyRemaining)
+			}
+		}
+		if c.retryPolicy == nil || retry >= policy.MaxRetries || !policy.shouldRetry(method, statusCode, err) {
+			return statusCode, respBody, err
+		}
+		if c.retryBudget != nil && !c.retryBudget.allowRetry() {
+			return statusCode, respBody, err
+		}
+		if c.metrics != nil {
+			c.metrics.ObserveRetry(info)
+		}
+
+		timer := time.NewTimer(policy.backoff(retry, header))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return statusCode, respBody, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ReviewsService handles operations related to reviews and review requests (Reputation Management)
+type ReviewsService struct {
+	client *Client
+}
+
+// Review represents a review left for a location on a connected review platform
+type Review struct {
+	ID           string       `json:"id,omitempty"`
+	LocationID   string       `json:"locationId,omitempty"`
+	Source       string       `json:"source,omitempty"` // e.g. "google", "facebook"
+	Rating       float64      `json:"rating,omitempty"`
+	Comment      string       `json:"comment,omitempty"`
+	ReviewerName string       `json:"reviewerName,omitempty"`
+	ReviewerURL  string       `json:"reviewerUrl,omitempty"`
+	ContactID    string       `json:"contactId,omitempty"`
+	Reply        *ReviewReply `json:"reply,omitempty"`
+	ReviewedAt   Time         `json:"reviewedAt,omitempty"`
+	DateAdded    Time         `json:"dateAdded,omitempty"`
+	DateUpdated  Time         `json:"dateUpdated,omitempty"`
+}
+
+// ReviewReply represents the location's public reply to a review
+type ReviewReply struct {
+	Comment   string `json:"comment,omitempty"`
+	RepliedAt Time   `json:"repliedAt,omitempty"`
+}
+
+// ReviewRequest represents a review request sent to a contact
+type ReviewRequest struct {
+	ID         string `json:"id,omitempty"`
+	LocationID string `json:"locationId,omitempty"`
+	ContactID  string `json:"contactId,omitempty"`
+	Channel    string `json:"channel,omitempty"` // "sms" or "email"
+	Status     string `json:"status,omitempty"`
+	DateAdded  Time   `json:"dateAdded,omitempty"`
+}
+
+// ListReviewsOptions represents query options for listing reviews
+type ListReviewsOptions struct {
+	LocationID string
+	Source     string // Restrict to a review platform, e.g. "google"
+	MinRating  int
+	MaxRating  int
+	StartDate  string // YYYY-MM-DD
+	EndDate    string // YYYY-MM-DD
+	Limit      int
+	Skip       int
+}
+
+// SendReviewRequestRequest represents a request to ask a contact for a review
+type SendReviewRequestRequest struct {
+	LocationID string `json:"locationId"`
+	ContactID  string `json:"contactId"`
+	Channel    string `json:"channel"` // "sms" or "email"
+}
+
+// ReviewsResponse represents a list of reviews API response
+type ReviewsResponse struct {
+	Reviews []Review `json:"reviews,omitempty"`
+	Total   int      `json:"total,omitempty"`
+}
+
+// reviewRequestResponse represents the response to sending a review request
+type reviewRequestResponse struct {
+	ReviewRequest *ReviewRequest `json:"reviewRequest,omitempty"`
+}
+
+// List retrieves the reviews of a location
+// Required scope: reputation/review.readonly
+func (s *ReviewsService) List(opts *ListReviewsOptions) (*ReviewsResponse, error) {
+	if opts == nil {
+		opts = &ListReviewsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	query := url.Values{}
+	query.Set("locationId", opts.LocationID)
+	query.Set("limit", fmt.Sprintf("%d", limit))
+	query.Set("skip", fmt.Sprintf("%d", opts.Skip))
+	if opts.Source != "" {
+		query.Set("source", opts.Source)
+	}
+	if opts.MinRating > 0 {
+		query.Set("minRating", fmt.Sprintf("%d", opts.MinRating))
+	}
+	if opts.MaxRating > 0 {
+		query.Set("maxRating", fmt.Sprintf("%d", opts.MaxRating))
+	}
+	if opts.StartDate != "" {
+		query.Set("startDate", opts.StartDate)
+	}
+	if opts.EndDate != "" {
+		query.Set("endDate", opts.EndDate)
+	}
+
+	var result ReviewsResponse
+	err := s.client.doRequest("GET", "/reputation/reviews?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SendReviewRequest sends a review request to a contact using the location's review request settings
+// Required scope: reputation/review-request.write
+func (s *ReviewsService) SendReviewRequest(req *SendReviewRequestRequest) (*ReviewRequest, error) {
+	req = shallowCopy(req)
+	req.LocationID = s.client.resolveLocationID(req.LocationID)
+	if req.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.ContactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+	if req.Channel != "sms" && req.Channel != "email" {
+		return nil, fmt.Errorf("channel must be sms or email")
+	}
+
+	var result reviewRequestResponse
+	err := s.client.doRequest("POST", "/reputation/review-requests", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.ReviewRequest, nil
+}
@@ -0,0 +1,70 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestReviewsService_List(t *testing.T) {
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /reputation/reviews": func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("locationId") != "loc-1" || q.Get("limit") != "20" || q.Get("skip") != "40" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			if q.Get("source") != "google" || q.Get("minRating") != "4" || q.Get("maxRating") != "" ||
+				q.Get("startDate") != "2025-01-01" || q.Get("endDate") != "2025-01-31" {
+				t.Errorf("unexpected filters %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"reviews":[{"id":"rev-1","rating":5,"reply":{"comment":"Thanks!"}}],"total":41}`))
+		},
+	})
+
+	reviews, err := client.Reviews.List(&ListReviewsOptions{
+		LocationID: "loc-1",
+		Source:     "google",
+		MinRating:  4,
+		StartDate:  "2025-01-01",
+		EndDate:    "2025-01-31",
+		Skip:       40,
+	})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if reviews.Total != 41 || len(reviews.Reviews) != 1 || reviews.Reviews[0].Reply == nil {
+		t.Errorf("Unexpected reviews: %+v", reviews)
+	}
+
+	if _, err := client.Reviews.List(nil); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
+
+func TestReviewsService_SendReviewRequest(t *testing.T) {
+	var sent SendReviewRequestRequest
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /reputation/review-requests": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"reviewRequest":{"id":"req-1","contactId":"contact-1","channel":"sms","status":"sent"}}`))
+		},
+	})
+
+	request, err := client.Reviews.SendReviewRequest(&SendReviewRequestRequest{LocationID: "loc-1", ContactID: "contact-1", Channel: "sms"})
+	if err != nil {
+		t.Fatalf("SendReviewRequest failed: %v", err)
+	}
+	if request.ID != "req-1" || request.Status != "sent" {
+		t.Errorf("Unexpected review request: %+v", request)
+	}
+	if sent.LocationID != "loc-1" || sent.ContactID != "contact-1" || sent.Channel != "sms" {
+		t.Errorf("Unexpected request body: %+v", sent)
+	}
+
+	if _, err := client.Reviews.SendReviewRequest(&SendReviewRequestRequest{LocationID: "loc-1", Channel: "email"}); err == nil {
+		t.Error("Expected error for missing contactId")
+	}
+	if _, err := client.Reviews.SendReviewRequest(&SendReviewRequestRequest{LocationID: "loc-1", ContactID: "contact-1", Channel: "whatsapp"}); err == nil {
+		t.Error("Expected error for an unsupported channel")
+	}
+}
@@ -0,0 +1,51 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SaaSService handles operations related to SaaS mode sub-accounts.
+// These endpoints require an agency access token.
+type SaaSService struct {
+	client *Client
+}
+
+// SaaSSubscription represents the SaaS plan a location is subscribed to
+type SaaSSubscription struct {
+	LocationID     string `json:"locationId,omitempty"`
+	CompanyID      string `json:"companyId,omitempty"`
+	SaaSMode       string `json:"saasMode,omitempty"` // e.g. "activated", "setup_pending", "not_activated"
+	PlanID         string `json:"saasPlanId,omitempty"`
+	PriceID        string `json:"priceId,omitempty"`
+	CustomerID     string `json:"customerId,omitempty"`     // Stripe customer ID
+	SubscriptionID string `json:"subscriptionId,omitempty"` // Stripe subscription ID
+	Status         string `json:"subscriptionStatus,omitempty"`
+	IsSaaSV2       bool   `json:"isSaaSV2,omitempty"`
+	CreatedAt      Time   `json:"createdAt,omitempty"`
+	UpdatedAt      Time   `json:"updatedAt,omitempty"`
+}
+
+// GetSubscription retrieves the SaaS plan and subscription of a location, to reconcile
+// agency billing against the locations' plans
+// Required scope: saas/location.read
+func (s *SaaSService) GetSubscription(companyID, locationID string) (*SaaSSubscription, error) {
+	if companyID == "" {
+		return nil, fmt.Errorf("companyId is required")
+	}
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := url.Values{}
+	query.Set("companyId", companyID)
+
+	var result SaaSSubscription
+	err := s.client.doRequest("GET", fmt.Sprintf("/saas/get-saas-subscription/%s?%s", locationID, query.Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
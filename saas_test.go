@@ -0,0 +1,30 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSaaSService_GetSubscription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/saas/get-saas-subscription/loc-1" || r.URL.Query().Get("companyId") != "comp-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"locationId":"loc-1","companyId":"comp-1","saasMode":"activated","saasPlanId":"plan-1","subscriptionId":"sub_123","subscriptionStatus":"active"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	sub, err := client.SaaS.GetSubscription("comp-1", "loc-1")
+	if err != nil {
+		t.Fatalf("GetSubscription failed: %v", err)
+	}
+	if sub.PlanID != "plan-1" || sub.Status != "active" || sub.SaaSMode != "activated" {
+		t.Errorf("subscription = %+v", sub)
+	}
+
+	if _, err := client.SaaS.GetSubscription("", "loc-1"); err == nil {
+		t.Error("expected error for missing companyId")
+	}
+}
@@ -0,0 +1,70 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Connection health of a social account, as reported by SocialAccount.Health
+const (
+	SocialAccountHealthy  = "healthy"
+	SocialAccountExpiring = "expiring"
+	SocialAccountExpired  = "expired"
+)
+
+// ExpiresAt returns when the account's platform token expires. ok is false when the platform
+// did not report an expiry.
+func (a *SocialAccount) ExpiresAt() (expires time.Time, ok bool) {
+	if a.Expire == "" {
+		return time.Time{}, false
+	}
+	expires, err := parseTimestamp([]byte(strconv.Quote(a.Expire)))
+	if err != nil || expires.IsZero() {
+		return time.Time{}, false
+	}
+	return expires, true
+}
+
+// Health reports whether the account can still post: SocialAccountExpired once its token has
+// expired and it needs reconnecting, SocialAccountExpiring when the token expires within the
+// given window, and SocialAccountHealthy otherwise
+func (a *SocialAccount) Health(within time.Duration) string {
+	if a.IsExpired {
+		return SocialAccountExpired
+	}
+	expires, ok := a.ExpiresAt()
+	if !ok {
+		return SocialAccountHealthy
+	}
+	now := time.Now()
+	switch {
+	case !expires.After(now):
+		return SocialAccountExpired
+	case expires.Before(now.Add(within)):
+		return SocialAccountExpiring
+	}
+	return SocialAccountHealthy
+}
+
+// NeedingReconnection returns the accounts that have expired or expire within the given window,
+// so schedulers can alert before posts to them start failing
+func (r *SocialAccountsResult) NeedingReconnection(within time.Duration) []SocialAccount {
+	var accounts []SocialAccount
+	for i := range r.Accounts {
+		if r.Accounts[i].Health(within) != SocialAccountHealthy {
+			accounts = append(accounts, r.Accounts[i])
+		}
+	}
+	return accounts
+}
+
+// ReconnectURL returns the URL that re-authorizes an expired or expiring account, like
+// OAuthStartURL with reconnect set for the account's platform
+// Required scope: socialplanner/oauth.readonly
+func (s *SocialPlannerService) ReconnectURL(locationID, userID string, account *SocialAccount) (string, error) {
+	if account == nil || account.Platform == "" {
+		return "", fmt.Errorf("account platform is required")
+	}
+	return s.OAuthStartURL(account.Platform, locationID, userID, true)
+}
@@ -0,0 +1,59 @@
+package gohighlevel
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSocialAccount_Health(t *testing.T) {
+	now := time.Now().UTC()
+	result := &SocialAccountsResult{Accounts: []SocialAccount{
+		{ID: "flagged", IsExpired: true},
+		{ID: "past", Expire: now.Add(-time.Hour).Format(time.RFC3339)},
+		{ID: "soon", Expire: now.Add(48 * time.Hour).Format(time.RFC3339)},
+		{ID: "later", Expire: now.Add(30 * 24 * time.Hour).Format(time.RFC3339)},
+		{ID: "epoch", Expire: strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)},
+		{ID: "unknown"},
+	}}
+
+	want := map[string]string{
+		"flagged": SocialAccountExpired,
+		"past":    SocialAccountExpired,
+		"soon":    SocialAccountExpiring,
+		"later":   SocialAccountHealthy,
+		"epoch":   SocialAccountExpiring,
+		"unknown": SocialAccountHealthy,
+	}
+	for _, account := range result.Accounts {
+		if got := account.Health(7 * 24 * time.Hour); got != want[account.ID] {
+			t.Errorf("%s: expected %s, got %s", account.ID, want[account.ID], got)
+		}
+	}
+
+	needing := result.NeedingReconnection(7 * 24 * time.Hour)
+	if len(needing) != 4 {
+		t.Errorf("Expected 4 accounts needing reconnection, got %d", len(needing))
+	}
+}
+
+func TestSocialPlanner_ReconnectURL(t *testing.T) {
+	client, _ := NewClient(Config{AccessToken: "token"})
+
+	reconnectURL, err := client.SocialPlanner.ReconnectURL("loc-1", "user-1", &SocialAccount{Platform: SocialPlatformLinkedIn})
+	if err != nil {
+		t.Fatalf("ReconnectURL failed: %v", err)
+	}
+	parsed, err := url.Parse(reconnectURL)
+	if err != nil {
+		t.Fatalf("Invalid URL %q: %v", reconnectURL, err)
+	}
+	if parsed.Path != "/social-media-posting/oauth/linkedin/start" || parsed.Query().Get("reconnect") != "true" {
+		t.Errorf("Unexpected reconnect URL %s", reconnectURL)
+	}
+
+	if _, err := client.SocialPlanner.ReconnectURL("loc-1", "user-1", &SocialAccount{}); err == nil {
+		t.Error("Expected error for an account without a platform")
+	}
+}
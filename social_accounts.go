@@ -0,0 +1,119 @@
+package gohighlevel
+
+import "fmt"
+
+// Social platforms that can be connected to the Social Planner
+const (
+	SocialPlatformFacebook       = "facebook"
+	SocialPlatformInstagram      = "instagram"
+	SocialPlatformGoogle         = "google"
+	SocialPlatformLinkedIn       = "linkedin"
+	SocialPlatformTwitter        = "twitter"
+	SocialPlatformTikTok         = "tiktok"
+	SocialPlatformTikTokBusiness = "tiktok-business"
+)
+
+// SocialAccount represents a social media account (page, profile or business listing)
+// connected to the Social Planner
+type SocialAccount struct {
+	ID        string                 `json:"id,omitempty"`
+	OAuthID   string                 `json:"oauthId,omitempty"`
+	ProfileID string                 `json:"profileId,omitempty"`
+	Name      string                 `json:"name,omitempty"`
+	Platform  string                 `json:"platform,omitempty"`
+	Type      string                 `json:"type,omitempty"` // e.g. "page", "profile", "location", "business"
+	Avatar    string                 `json:"avatar,omitempty"`
+	Expire    string                 `json:"expire,omitempty"`
+	IsExpired bool                   `json:"isExpired,omitempty"`
+	Meta      map[string]interface{} `json:"meta,omitempty"`
+	DeletedAt string                 `json:"deleted,omitempty"`
+	CreatedAt Time                   `json:"createdAt,omitempty"`
+	UpdatedAt Time                   `json:"updatedAt,omitempty"`
+}
+
+// SocialAccountGroup represents a named group of connected accounts that can be posted to together
+type SocialAccountGroup struct {
+	ID         string   `json:"id,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	LocationID string   `json:"locationId,omitempty"`
+	AccountIDs []string `json:"accountIds,omitempty"`
+	CreatedAt  Time     `json:"createdAt,omitempty"`
+	UpdatedAt  Time     `json:"updatedAt,omitempty"`
+}
+
+// SocialAccountsResult represents the connected accounts and groups of a location
+type SocialAccountsResult struct {
+	Accounts []SocialAccount      `json:"accounts,omitempty"`
+	Groups   []SocialAccountGroup `json:"groups,omitempty"`
+}
+
+// socialAccountsResponse represents a list of social accounts API response
+type socialAccountsResponse struct {
+	Results SocialAccountsResult `json:"results"`
+}
+
+// ListAccounts retrieves the social accounts and account groups connected to a location
+// Required scope: socialplanner/account.readonly
+func (s *SocialPlannerService) ListAccounts(locationID string) (*SocialAccountsResult, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result socialAccountsResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/social-media-posting/%s/accounts", locationID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Results, nil
+}
+
+// DeleteAccount disconnects a social account from a location.
+// Scheduled posts of the account are removed as well.
+// Required scope: socialplanner/account.write
+func (s *SocialPlannerService) DeleteAccount(locationID, accountID, companyID, userID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if accountID == "" {
+		return fmt.Errorf("accountId is required")
+	}
+
+	query := locationQuery(locationID)
+	if companyID != "" {
+		query.Set("companyId", companyID)
+	}
+	if userID != "" {
+		query.Set("userId", userID)
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/social-media-posting/%s/accounts/%s?%s", locationID, accountID, query.Encode()), nil, nil)
+}
+
+// OAuthStartURL returns the URL that starts the OAuth flow connecting a social platform
+// (one of the SocialPlatform constants) to a location. Open it in a browser popup; once the
+// user has authorized, GoHighLevel lists the pages/profiles that can be attached to the location.
+// Set reconnect to re-authorize an account whose token has expired.
+// Required scope: socialplanner/oauth.readonly
+func (s *SocialPlannerService) OAuthStartURL(platform, locationID, userID string, reconnect bool) (string, error) {
+	if platform == "" {
+		return "", fmt.Errorf("platform is required")
+	}
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return "", fmt.Errorf("locationId is required")
+	}
+	if userID == "" {
+		return "", fmt.Errorf("userId is required")
+	}
+
+	query := locationQuery(locationID)
+	query.Set("userId", userID)
+	if reconnect {
+		query.Set("reconnect", "true")
+	}
+
+	return fmt.Sprintf("%s/social-media-posting/oauth/%s/start?%s", s.client.BaseURL, platform, query.Encode()), nil
+}
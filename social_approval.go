@@ -0,0 +1,107 @@
+package gohighlevel
+
+import "fmt"
+
+// SocialPostApproval holds the review state of a social post in an approval flow
+type SocialPostApproval struct {
+	Approver       string `json:"approver,omitempty"` // User ID of the reviewer
+	RequesterNote  string `json:"requesterNote,omitempty"`
+	ApproverNote   string `json:"approverNote,omitempty"`
+	ApprovalStatus string `json:"approvalStatus,omitempty"` // See SocialApproval* constants
+}
+
+// SubmitPostForApproval moves a social post into review by approverID, with an optional note
+// for the reviewer. userID is the user making the change.
+// Required scopes: socialplanner/post.readonly, socialplanner/post.write
+func (s *SocialPlannerService) SubmitPostForApproval(locationID, postID, userID, approverID, note string) (*SocialPost, error) {
+	if approverID == "" {
+		return nil, fmt.Errorf("approverId is required")
+	}
+	return s.reviewPost(locationID, postID, userID, false, func(post *SocialPost, req *SocialPostRequest) {
+		req.Status = SocialPostStatusInReview
+		req.ApprovalDetails = &SocialPostApproval{
+			Approver:       approverID,
+			RequesterNote:  note,
+			ApprovalStatus: SocialApprovalPending,
+		}
+	})
+}
+
+// ApprovePost approves a social post that is in review. The post is scheduled for its schedule
+// date, or published right away when it has none.
+// Required scopes: socialplanner/post.readonly, socialplanner/post.write
+func (s *SocialPlannerService) ApprovePost(locationID, postID, userID, note string) (*SocialPost, error) {
+	return s.reviewPost(locationID, postID, userID, true, func(post *SocialPost, req *SocialPostRequest) {
+		req.Status = SocialPostStatusScheduled
+		if post.ScheduleDate.IsZero() {
+			req.Status = SocialPostStatusPublished
+		}
+		req.ApprovalDetails.ApprovalStatus = SocialApprovalApproved
+		req.ApprovalDetails.ApproverNote = note
+	})
+}
+
+// RejectPost rejects a social post that is in review and returns it to draft, with a note
+// explaining what needs to change
+// Required scopes: socialplanner/post.readonly, socialplanner/post.write
+func (s *SocialPlannerService) RejectPost(locationID, postID, userID, note string) (*SocialPost, error) {
+	if note == "" {
+		return nil, fmt.Errorf("note is required")
+	}
+	return s.reviewPost(locationID, postID, userID, true, func(post *SocialPost, req *SocialPostRequest) {
+		req.Status = SocialPostStatusDraft
+		req.ApprovalDetails.ApprovalStatus = SocialApprovalRejected
+		req.ApprovalDetails.ApproverNote = note
+	})
+}
+
+// reviewPost loads a social post, lets update change the edit request built from it and saves
+// the result. When inReview is set the post must be awaiting approval.
+func (s *SocialPlannerService) reviewPost(locationID, postID, userID string, inReview bool, update func(post *SocialPost, req *SocialPostRequest)) (*SocialPost, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userId is required")
+	}
+	post, err := s.GetPost(locationID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, fmt.Errorf("social post %s not found", postID)
+	}
+	if inReview && post.Status != SocialPostStatusInReview {
+		return nil, fmt.Errorf("social post %s is not in review (status %q)", postID, post.Status)
+	}
+
+	req := socialPostRequestFrom(post, userID)
+	if req.ApprovalDetails == nil {
+		req.ApprovalDetails = &SocialPostApproval{}
+	}
+	update(post, req)
+	return s.EditPost(locationID, postID, req)
+}
+
+// socialPostRequestFrom returns an edit request that keeps a social post's current values
+func socialPostRequestFrom(post *SocialPost, userID string) *SocialPostRequest {
+	req := &SocialPostRequest{
+		AccountIDs:      post.AccountIDs,
+		Summary:         post.Summary,
+		Media:           post.Media,
+		Status:          post.Status,
+		Type:            post.Type,
+		ScheduleDate:    post.ScheduleDate.iso(),
+		UserID:          userID,
+		FollowUpComment: post.FollowUpComment,
+		Tags:            post.Tags,
+		CategoryID:      post.CategoryID,
+		OGTagsDetails:   post.OGTagsDetails,
+		GMBPostDetails:  post.GMBPostDetails,
+		Instagram:       post.Instagram,
+		TikTok:          post.TikTok,
+		YouTube:         post.YouTube,
+	}
+	if post.ApprovalDetails != nil {
+		approval := *post.ApprovalDetails
+		req.ApprovalDetails = &approval
+	}
+	return req
+}
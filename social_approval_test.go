@@ -0,0 +1,91 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newSocialApprovalClient serves post-1 of loc-1 and records its edit
+func newSocialApprovalClient(t *testing.T, post SocialPost, edited *SocialPostRequest) *Client {
+	return newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"GET /social-media-posting/loc-1/posts/post-1": func(w http.ResponseWriter, r *http.Request) {
+			var resp socialPostResponse
+			resp.Results.Post = &post
+			writeJSON(w, resp)
+		},
+		"PUT /social-media-posting/loc-1/posts/post-1": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(edited)
+			var resp socialPostResponse
+			resp.Results.Post = &SocialPost{ID: "post-1", Status: edited.Status, ApprovalDetails: edited.ApprovalDetails}
+			writeJSON(w, resp)
+		},
+	})
+}
+
+func TestSocialPlanner_SubmitPostForApproval(t *testing.T) {
+	var edited SocialPostRequest
+	client := newSocialApprovalClient(t, SocialPost{
+		ID:           "post-1",
+		AccountIDs:   []string{"acc-1"},
+		Summary:      "Launch day",
+		Status:       SocialPostStatusDraft,
+		Type:         "post",
+		ScheduleDate: Time{Time: time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)},
+	}, &edited)
+	post, err := client.SocialPlanner.SubmitPostForApproval("loc-1", "post-1", "user-1", "client-1", "Please check the copy")
+	if err != nil {
+		t.Fatalf("SubmitPostForApproval failed: %v", err)
+	}
+	if post.Status != SocialPostStatusInReview {
+		t.Errorf("Expected in_review post, got %s", post.Status)
+	}
+	if edited.UserID != "user-1" || edited.Summary != "Launch day" || len(edited.AccountIDs) != 1 {
+		t.Errorf("Expected existing fields to be kept, got %+v", edited)
+	}
+	approval := edited.ApprovalDetails
+	if approval == nil || approval.Approver != "client-1" || approval.ApprovalStatus != SocialApprovalPending || approval.RequesterNote != "Please check the copy" {
+		t.Errorf("Unexpected approval details: %+v", approval)
+	}
+
+	if _, err := client.SocialPlanner.ApprovePost("loc-1", "post-1", "client-1", ""); err == nil {
+		t.Error("Expected error approving a post that is not in review")
+	}
+}
+
+func TestSocialPlanner_ApproveAndRejectPost(t *testing.T) {
+	inReview := SocialPost{
+		ID:              "post-1",
+		AccountIDs:      []string{"acc-1"},
+		Status:          SocialPostStatusInReview,
+		Type:            "post",
+		ScheduleDate:    Time{Time: time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)},
+		ApprovalDetails: &SocialPostApproval{Approver: "client-1", ApprovalStatus: SocialApprovalPending},
+	}
+
+	var edited SocialPostRequest
+	client := newSocialApprovalClient(t, inReview, &edited)
+
+	post, err := client.SocialPlanner.ApprovePost("loc-1", "post-1", "client-1", "Looks good")
+	if err != nil {
+		t.Fatalf("ApprovePost failed: %v", err)
+	}
+	if post.Status != SocialPostStatusScheduled || edited.ApprovalDetails.ApprovalStatus != SocialApprovalApproved {
+		t.Errorf("Unexpected approved post: %+v %+v", post, edited.ApprovalDetails)
+	}
+	if edited.ApprovalDetails.Approver != "client-1" || edited.ApprovalDetails.ApproverNote != "Looks good" {
+		t.Errorf("Unexpected approval details: %+v", edited.ApprovalDetails)
+	}
+
+	if _, err := client.SocialPlanner.RejectPost("loc-1", "post-1", "client-1", ""); err == nil {
+		t.Error("Expected error rejecting without a note")
+	}
+	post, err = client.SocialPlanner.RejectPost("loc-1", "post-1", "client-1", "Wrong image")
+	if err != nil {
+		t.Fatalf("RejectPost failed: %v", err)
+	}
+	if post.Status != SocialPostStatusDraft || edited.ApprovalDetails.ApprovalStatus != SocialApprovalRejected {
+		t.Errorf("Unexpected rejected post: %+v %+v", post, edited.ApprovalDetails)
+	}
+}
@@ -0,0 +1,221 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"io"
+)
+
+// SocialCSVUpload describes a CSV file uploaded for bulk post import, before accounts are assigned
+type SocialCSVUpload struct {
+	FilePath  string `json:"filePath,omitempty"`
+	RowsCount int    `json:"rowsCount,omitempty"`
+	FileName  string `json:"fileName,omitempty"`
+}
+
+// SocialCSVImport represents a bulk post import created from a CSV file
+type SocialCSVImport struct {
+	ID         string   `json:"id,omitempty"`
+	LocationID string   `json:"locationId,omitempty"`
+	FileName   string   `json:"fileName,omitempty"`
+	FilePath   string   `json:"filePath,omitempty"`
+	RowsCount  int      `json:"rowsCount,omitempty"`
+	AccountIDs []string `json:"accountIds,omitempty"`
+	Status     string   `json:"status,omitempty"` // e.g. "in_progress", "imported", "failed"
+	UserID     string   `json:"userId,omitempty"`
+	CreatedAt  Time     `json:"createdAt,omitempty"`
+	UpdatedAt  Time     `json:"updatedAt,omitempty"`
+}
+
+// SetCSVAccountsRequest assigns the accounts an uploaded CSV file will be posted to
+type SetCSVAccountsRequest struct {
+	AccountIDs []string `json:"accountIds"`
+	FilePath   string   `json:"filePath"`
+	RowsCount  int      `json:"rowsCount"`
+	FileName   string   `json:"fileName"`
+	Approver   string   `json:"approver,omitempty"` // User who must approve the imported posts
+	UserID     string   `json:"userId,omitempty"`
+}
+
+// SocialCSVImportsResult represents a page of CSV imports
+type SocialCSVImportsResult struct {
+	CSVs  []SocialCSVImport `json:"csvs,omitempty"`
+	Count int               `json:"count,omitempty"`
+}
+
+// SocialCSVImportResult represents a CSV import together with the posts read from it
+type SocialCSVImportResult struct {
+	CSV   *SocialCSVImport `json:"csv,omitempty"`
+	Posts []SocialPost     `json:"posts,omitempty"`
+	Count int              `json:"count,omitempty"`
+}
+
+// socialCSVUploadResponse represents the response to a CSV upload
+type socialCSVUploadResponse struct {
+	Results SocialCSVUpload `json:"results"`
+}
+
+// socialCSVSetAccountsResponse represents the response to assigning accounts to a CSV upload
+type socialCSVSetAccountsResponse struct {
+	Results *SocialCSVImport `json:"results"`
+}
+
+// socialCSVImportsResponse represents a list of CSV imports API response
+type socialCSVImportsResponse struct {
+	Results SocialCSVImportsResult `json:"results"`
+}
+
+// socialCSVImportResponse represents a single CSV import API response
+type socialCSVImportResponse struct {
+	Results SocialCSVImportResult `json:"results"`
+}
+
+// UploadCSV uploads a CSV file of posts for bulk import.
+// The file is only parsed at this point; call SetCSVAccounts to choose the target accounts,
+// review the posts with GetCSVImport and then FinalizeCSVImport to schedule them.
+// Required scope: socialplanner/csv.write
+func (s *SocialPlannerService) UploadCSV(locationID, fileName string, file io.Reader) (*SocialCSVUpload, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if fileName == "" {
+		return nil, fmt.Errorf("fileName is required")
+	}
+	if file == nil {
+		return nil, fmt.Errorf("file is required")
+	}
+
+	body, err := newMultipartBody("file", fileName, file, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result socialCSVUploadResponse
+	err = s.client.doRequest("POST", fmt.Sprintf("/social-media-posting/%s/csv", locationID), body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Results, nil
+}
+
+// SetCSVAccounts assigns the accounts an uploaded CSV file will be posted to,
+// creating the import in review
+// Required scope: socialplanner/csv.write
+func (s *SocialPlannerService) SetCSVAccounts(locationID string, req *SetCSVAccountsRequest) (*SocialCSVImport, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if len(req.AccountIDs) == 0 {
+		return nil, fmt.Errorf("at least one account is required")
+	}
+	if req.FilePath == "" {
+		return nil, fmt.Errorf("filePath is required")
+	}
+
+	var result socialCSVSetAccountsResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/social-media-posting/%s/set-accounts", locationID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Results, nil
+}
+
+// ListCSVImports retrieves the CSV imports of a location
+// Required scope: socialplanner/csv.readonly
+func (s *SocialPlannerService) ListCSVImports(locationID string, limit, skip int) (*SocialCSVImportsResult, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	var result socialCSVImportsResponse
+	path := fmt.Sprintf("/social-media-posting/%s/csv?%s", locationID, socialCSVPageQuery(limit, skip))
+	err := s.client.doRequest("GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Results, nil
+}
+
+// GetCSVImport retrieves a CSV import and a page of the posts read from it, for review
+// Required scope: socialplanner/csv.readonly
+func (s *SocialPlannerService) GetCSVImport(locationID, csvID string, limit, skip int) (*SocialCSVImportResult, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if csvID == "" {
+		return nil, fmt.Errorf("csvId is required")
+	}
+
+	var result socialCSVImportResponse
+	path := fmt.Sprintf("/social-media-posting/%s/csv/%s?%s", locationID, csvID, socialCSVPageQuery(limit, skip))
+	err := s.client.doRequest("GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Results, nil
+}
+
+// FinalizeCSVImport starts scheduling the posts of a reviewed CSV import
+// Required scope: socialplanner/csv.write
+func (s *SocialPlannerService) FinalizeCSVImport(locationID, csvID, userID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if csvID == "" {
+		return fmt.Errorf("csvId is required")
+	}
+
+	req := map[string]string{}
+	if userID != "" {
+		req["userId"] = userID
+	}
+
+	return s.client.doRequest("PATCH", fmt.Sprintf("/social-media-posting/%s/csv/%s", locationID, csvID), req, nil)
+}
+
+// DeleteCSVImport deletes a CSV import
+// Required scope: socialplanner/csv.write
+func (s *SocialPlannerService) DeleteCSVImport(locationID, csvID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if csvID == "" {
+		return fmt.Errorf("csvId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/social-media-posting/%s/csv/%s", locationID, csvID), nil, nil)
+}
+
+// DeleteCSVImportPost removes a single post from a CSV import under review
+// Required scope: socialplanner/csv.write
+func (s *SocialPlannerService) DeleteCSVImportPost(locationID, csvID, postID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if csvID == "" {
+		return fmt.Errorf("csvId is required")
+	}
+	if postID == "" {
+		return fmt.Errorf("postId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/social-media-posting/%s/csv/%s/post/%s", locationID, csvID, postID), nil, nil)
+}
+
+// socialCSVPageQuery builds the limit/skip query used by the CSV import listing endpoints
+func socialCSVPageQuery(limit, skip int) string {
+	if limit <= 0 {
+		limit = 10
+	}
+	return fmt.Sprintf("limit=%d&skip=%d", limit, skip)
+}
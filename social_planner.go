@@ -0,0 +1,286 @@
+package gohighlevel
+
+import "fmt"
+
+// SocialPlannerService handles operations related to the Social Planner
+type SocialPlannerService struct {
+	client *Client
+}
+
+// SocialPost represents a post in the Social Planner
+type SocialPost struct {
+	ID              string                `json:"_id,omitempty"`
+	LocationID      string                `json:"locationId,omitempty"`
+	AccountIDs      []string              `json:"accountIds,omitempty"`
+	Platform        string                `json:"platform,omitempty"`
+	Summary         string                `json:"summary,omitempty"`
+	Media           []SocialPostMedia     `json:"media,omitempty"`
+	Status          string                `json:"status,omitempty"`
+	Type            string                `json:"type,omitempty"`
+	ScheduleDate    Time                  `json:"scheduleDate,omitempty"`
+	PublishedAt     Time                  `json:"publishedAt,omitempty"`
+	CreatedBy       string                `json:"createdBy,omitempty"`
+	FollowUpComment string                `json:"followUpComment,omitempty"`
+	Tags            []string              `json:"tags,omitempty"`
+	CategoryID      string                `json:"categoryId,omitempty"`
+	OGTagsDetails   *SocialPostOGTags     `json:"ogTagsDetails,omitempty"`
+	GMBPostDetails  *GMBPostDetails       `json:"gmbPostDetails,omitempty"`
+	Instagram       *InstagramPostDetails `json:"instagramPostDetails,omitempty"`
+	TikTok          *TikTokPostDetails    `json:"tiktokPostDetails,omitempty"`
+	YouTube         *YouTubePostDetails   `json:"youtubePostDetails,omitempty"`
+	ApprovalDetails *SocialPostApproval   `json:"approvalDetails,omitempty"`
+	Error           string                `json:"error,omitempty"`
+	CreatedAt       Time                  `json:"createdAt,omitempty"`
+	UpdatedAt       Time                  `json:"updatedAt,omitempty"`
+}
+
+// SocialPostMedia represents an image or video attached to a social post
+type SocialPostMedia struct {
+	URL     string `json:"url"`
+	Caption string `json:"caption,omitempty"`
+	Type    string `json:"type,omitempty"` // MIME type, e.g. "image/png" or "video/mp4"
+}
+
+// SocialPostOGTags holds the link preview shown for a post that contains a URL
+type SocialPostOGTags struct {
+	MetaImage string `json:"metaImage,omitempty"`
+	MetaLink  string `json:"metaLink,omitempty"`
+}
+
+// GMBPostDetails holds Google Business Profile specific post options
+type GMBPostDetails struct {
+	GMBEvent   map[string]interface{} `json:"gmbEvent,omitempty"`
+	Offer      map[string]interface{} `json:"offer,omitempty"`
+	ActionType string                 `json:"actionType,omitempty"` // e.g. "book", "order", "shop", "learn_more", "sign_up", "call"
+	URL        string                 `json:"url,omitempty"`
+}
+
+// InstagramPostDetails holds Instagram specific post options
+type InstagramPostDetails struct {
+	Type          string   `json:"type,omitempty"` // "post", "story" or "reel"
+	Collaborators []string `json:"collaborators,omitempty"`
+	UserTags      []string `json:"userTags,omitempty"`
+}
+
+// TikTokPostDetails holds TikTok specific post options
+type TikTokPostDetails struct {
+	PrivacyLevel      string `json:"privacyLevel,omitempty"`
+	PromoteOtherBrand bool   `json:"promoteOtherBrand,omitempty"`
+	EnableComment     bool   `json:"enableComment,omitempty"`
+	EnableDuet        bool   `json:"enableDuet,omitempty"`
+	EnableStitch      bool   `json:"enableStitch,omitempty"`
+	VideoDisclosure   bool   `json:"videoDisclosure,omitempty"`
+	PromoteYourBrand  bool   `json:"promoteYourBrand,omitempty"`
+}
+
+// YouTubePostDetails holds YouTube specific post options
+type YouTubePostDetails struct {
+	Title         string `json:"title,omitempty"`
+	PrivacyStatus string `json:"privacyStatus,omitempty"` // "public", "private" or "unlisted"
+	Type          string `json:"type,omitempty"`          // "video" or "short"
+}
+
+// SocialPostRequest represents a request to create or edit a social post
+type SocialPostRequest struct {
+	AccountIDs      []string              `json:"accountIds"`
+	Summary         string                `json:"summary,omitempty"`
+	Media           []SocialPostMedia     `json:"media,omitempty"`
+	Status          string                `json:"status,omitempty"` // e.g. "draft", "scheduled" or "published"
+	Type            string                `json:"type"`             // "post", "story" or "reel"
+	ScheduleDate    string                `json:"scheduleDate,omitempty"`
+	UserID          string                `json:"userId"`
+	FollowUpComment string                `json:"followUpComment,omitempty"`
+	Tags            []string              `json:"tags,omitempty"`
+	CategoryID      string                `json:"categoryId,omitempty"`
+	OGTagsDetails   *SocialPostOGTags     `json:"ogTagsDetails,omitempty"`
+	GMBPostDetails  *GMBPostDetails       `json:"gmbPostDetails,omitempty"`
+	Instagram       *InstagramPostDetails `json:"instagramPostDetails,omitempty"`
+	TikTok          *TikTokPostDetails    `json:"tiktokPostDetails,omitempty"`
+	YouTube         *YouTubePostDetails   `json:"youtubePostDetails,omitempty"`
+	ApprovalDetails *SocialPostApproval   `json:"approvalDetails,omitempty"`
+}
+
+// ListSocialPostsRequest represents the filters for listing social posts
+type ListSocialPostsRequest struct {
+	Type         string `json:"type,omitempty"`     // e.g. "all", "scheduled", "draft", "failed", "in_review", "published"
+	Accounts     string `json:"accounts,omitempty"` // Comma-separated account IDs
+	Skip         string `json:"skip"`
+	Limit        string `json:"limit"`
+	FromDate     string `json:"fromDate"`
+	ToDate       string `json:"toDate"`
+	IncludeUsers string `json:"includeUsers"`
+	PostType     string `json:"postType,omitempty"`
+}
+
+// SocialPostsResult represents a page of social posts
+type SocialPostsResult struct {
+	Posts []SocialPost `json:"posts,omitempty"`
+	Count int          `json:"count,omitempty"`
+}
+
+// socialPostResponse represents a single social post API response
+type socialPostResponse struct {
+	Results struct {
+		Post *SocialPost `json:"post,omitempty"`
+	} `json:"results"`
+}
+
+// socialPostsResponse represents a list of social posts API response
+type socialPostsResponse struct {
+	Results SocialPostsResult `json:"results"`
+}
+
+// bulkDeleteSocialPostsResponse represents the response to a bulk delete
+type bulkDeleteSocialPostsResponse struct {
+	Results struct {
+		DeletedCount int `json:"deletedCount"`
+	} `json:"results"`
+}
+
+// CreatePost creates (and optionally schedules) a social post
+// Required scope: socialplanner/post.write
+func (s *SocialPlannerService) CreatePost(locationID string, req *SocialPostRequest) (*SocialPost, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	req, err := validateSocialPostRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result socialPostResponse
+	err = s.client.doRequest("POST", fmt.Sprintf("/social-media-posting/%s/posts", locationID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Results.Post, nil
+}
+
+// ListPosts retrieves social posts of a location.
+// FromDate and ToDate are required by the API.
+// Required scope: socialplanner/post.readonly
+func (s *SocialPlannerService) ListPosts(locationID string, req *ListSocialPostsRequest) (*SocialPostsResult, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if req.FromDate == "" || req.ToDate == "" {
+		return nil, fmt.Errorf("fromDate and toDate are required")
+	}
+	req = shallowCopy(req)
+	if req.Skip == "" {
+		req.Skip = "0"
+	}
+	if req.Limit == "" {
+		req.Limit = "10"
+	}
+	if req.IncludeUsers == "" {
+		req.IncludeUsers = "false"
+	}
+
+	var result socialPostsResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/social-media-posting/%s/posts/list", locationID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Results, nil
+}
+
+// GetPost retrieves a social post by ID
+// Required scope: socialplanner/post.readonly
+func (s *SocialPlannerService) GetPost(locationID, postID string) (*SocialPost, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if postID == "" {
+		return nil, fmt.Errorf("postId is required")
+	}
+
+	var result socialPostResponse
+	err := s.client.doRequest("GET", fmt.Sprintf("/social-media-posting/%s/posts/%s", locationID, postID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Results.Post, nil
+}
+
+// EditPost edits an existing social post
+// Required scope: socialplanner/post.write
+func (s *SocialPlannerService) EditPost(locationID, postID string, req *SocialPostRequest) (*SocialPost, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if postID == "" {
+		return nil, fmt.Errorf("postId is required")
+	}
+	req, err := validateSocialPostRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result socialPostResponse
+	err = s.client.doRequest("PUT", fmt.Sprintf("/social-media-posting/%s/posts/%s", locationID, postID), req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Results.Post, nil
+}
+
+// DeletePost deletes a social post
+// Required scope: socialplanner/post.write
+func (s *SocialPlannerService) DeletePost(locationID, postID string) error {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return fmt.Errorf("locationId is required")
+	}
+	if postID == "" {
+		return fmt.Errorf("postId is required")
+	}
+
+	return s.client.doRequest("DELETE", fmt.Sprintf("/social-media-posting/%s/posts/%s", locationID, postID), nil, nil)
+}
+
+// BulkDeletePosts deletes multiple social posts and returns the number deleted
+// Required scope: socialplanner/post.write
+func (s *SocialPlannerService) BulkDeletePosts(locationID string, postIDs []string) (int, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return 0, fmt.Errorf("locationId is required")
+	}
+	if len(postIDs) == 0 {
+		return 0, fmt.Errorf("at least one post is required")
+	}
+
+	req := map[string][]string{"postIds": postIDs}
+
+	var result bulkDeleteSocialPostsResponse
+	err := s.client.doRequest("POST", fmt.Sprintf("/social-media-posting/%s/posts/bulk-delete", locationID), req, &result)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Results.DeletedCount, nil
+}
+
+// validateSocialPostRequest checks the fields required to create or edit a social post and returns
+// a copy of req with the default type filled in
+func validateSocialPostRequest(req *SocialPostRequest) (*SocialPostRequest, error) {
+	if len(req.AccountIDs) == 0 {
+		return nil, fmt.Errorf("at least one account is required")
+	}
+	if req.UserID == "" {
+		return nil, fmt.Errorf("userId is required")
+	}
+	req = shallowCopy(req)
+	if req.Type == "" {
+		req.Type = "post"
+	}
+	return req, nil
+}
@@ -0,0 +1,328 @@
+package gohighlevel
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSocialPlanner_OAuthStartURL(t *testing.T) {
+	client, err := NewClient(Config{AccessToken: "token"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	startURL, err := client.SocialPlanner.OAuthStartURL(SocialPlatformFacebook, "loc-1", "user-1", true)
+	if err != nil {
+		t.Fatalf("OAuthStartURL failed: %v", err)
+	}
+
+	parsed, err := url.Parse(startURL)
+	if err != nil {
+		t.Fatalf("Invalid URL %q: %v", startURL, err)
+	}
+	if got := parsed.Scheme + "://" + parsed.Host; got != DefaultBaseURL {
+		t.Errorf("Expected base URL %s, got %s", DefaultBaseURL, got)
+	}
+	if parsed.Path != "/social-media-posting/oauth/facebook/start" {
+		t.Errorf("Unexpected path %s", parsed.Path)
+	}
+
+	query := parsed.Query()
+	if query.Get("locationId") != "loc-1" || query.Get("userId") != "user-1" || query.Get("reconnect") != "true" {
+		t.Errorf("Unexpected query %s", parsed.RawQuery)
+	}
+
+	if _, err := client.SocialPlanner.OAuthStartURL(SocialPlatformFacebook, "loc-1", "", false); err == nil {
+		t.Error("Expected error for missing userId")
+	}
+}
+
+func TestSocialPlannerIntegration_ListAccounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	result, err := client.SocialPlanner.ListAccounts(locationID)
+	if err != nil {
+		t.Fatalf("ListAccounts failed: %v", err)
+	}
+
+	t.Logf("Found %d social accounts and %d groups", len(result.Accounts), len(result.Groups))
+	for _, account := range result.Accounts {
+		t.Logf("  %s (%s, %s)", account.Name, account.Platform, account.Type)
+	}
+}
+
+func TestSocialPlanner_Posts(t *testing.T) {
+	var created, edited SocialPostRequest
+	var listed ListSocialPostsRequest
+	var bulk map[string][]string
+	var deleted bool
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /social-media-posting/loc-1/posts": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"results":{"post":{"_id":"post-1","summary":"Hello"}}}`))
+		},
+		"POST /social-media-posting/loc-1/posts/list": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&listed)
+			_, _ = w.Write([]byte(`{"results":{"posts":[{"_id":"post-1"},{"_id":"post-2"}],"count":2}}`))
+		},
+		"GET /social-media-posting/loc-1/posts/post-1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"results":{"post":{"_id":"post-1","summary":"Hello"}}}`))
+		},
+		"PUT /social-media-posting/loc-1/posts/post-1": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&edited)
+			_, _ = w.Write([]byte(`{"results":{"post":{"_id":"post-1","summary":"Hello again"}}}`))
+		},
+		"DELETE /social-media-posting/loc-1/posts/post-1": func(w http.ResponseWriter, r *http.Request) {
+			deleted = true
+			_, _ = w.Write([]byte(`{}`))
+		},
+		"POST /social-media-posting/loc-1/posts/bulk-delete": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&bulk)
+			_, _ = w.Write([]byte(`{"results":{"deletedCount":2}}`))
+		},
+	})
+
+	req := &SocialPostRequest{AccountIDs: []string{"acc-1"}, UserID: "user-1", Summary: "Hello"}
+	post, err := client.SocialPlanner.CreatePost("loc-1", req)
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+	if post.ID != "post-1" || created.Type != "post" || created.Summary != "Hello" {
+		t.Errorf("Unexpected create: %+v, %+v", post, created)
+	}
+	if req.Type != "" {
+		t.Errorf("CreatePost modified the caller's request: %+v", req)
+	}
+
+	list := &ListSocialPostsRequest{FromDate: "2025-01-01T00:00:00Z", ToDate: "2025-02-01T00:00:00Z"}
+	result, err := client.SocialPlanner.ListPosts("loc-1", list)
+	if err != nil {
+		t.Fatalf("ListPosts failed: %v", err)
+	}
+	if result.Count != 2 || len(result.Posts) != 2 {
+		t.Errorf("Unexpected posts: %+v", result)
+	}
+	if listed.Skip != "0" || listed.Limit != "10" || listed.IncludeUsers != "false" || listed.FromDate != list.FromDate {
+		t.Errorf("Unexpected list request: %+v", listed)
+	}
+	if list.Skip != "" || list.Limit != "" {
+		t.Errorf("ListPosts modified the caller's request: %+v", list)
+	}
+
+	post, err = client.SocialPlanner.GetPost("loc-1", "post-1")
+	if err != nil || post.Summary != "Hello" {
+		t.Errorf("GetPost = %+v, %v", post, err)
+	}
+
+	post, err = client.SocialPlanner.EditPost("loc-1", "post-1", &SocialPostRequest{AccountIDs: []string{"acc-1"}, UserID: "user-1", Summary: "Hello again", Type: "story"})
+	if err != nil {
+		t.Fatalf("EditPost failed: %v", err)
+	}
+	if post.Summary != "Hello again" || edited.Type != "story" {
+		t.Errorf("Unexpected edit: %+v, %+v", post, edited)
+	}
+
+	if err := client.SocialPlanner.DeletePost("loc-1", "post-1"); err != nil || !deleted {
+		t.Errorf("DeletePost failed: %v", err)
+	}
+
+	count, err := client.SocialPlanner.BulkDeletePosts("loc-1", []string{"post-1", "post-2"})
+	if err != nil || count != 2 || len(bulk["postIds"]) != 2 {
+		t.Errorf("BulkDeletePosts = %d, %v; request %v", count, err, bulk)
+	}
+}
+
+func TestSocialPlanner_PostsValidation(t *testing.T) {
+	client := newTestClient(t, Config{}, nil)
+
+	if _, err := client.SocialPlanner.CreatePost("loc-1", &SocialPostRequest{UserID: "user-1"}); err == nil {
+		t.Error("Expected error for missing accounts")
+	}
+	if _, err := client.SocialPlanner.CreatePost("loc-1", &SocialPostRequest{AccountIDs: []string{"acc-1"}}); err == nil {
+		t.Error("Expected error for missing userId")
+	}
+	if _, err := client.SocialPlanner.EditPost("loc-1", "", &SocialPostRequest{AccountIDs: []string{"acc-1"}, UserID: "user-1"}); err == nil {
+		t.Error("Expected error for missing postId")
+	}
+	if _, err := client.SocialPlanner.ListPosts("loc-1", &ListSocialPostsRequest{FromDate: "2025-01-01T00:00:00Z"}); err == nil {
+		t.Error("Expected error for missing toDate")
+	}
+	if _, err := client.SocialPlanner.GetPost("loc-1", ""); err == nil {
+		t.Error("Expected error for missing postId")
+	}
+	if err := client.SocialPlanner.DeletePost("loc-1", ""); err == nil {
+		t.Error("Expected error for missing postId")
+	}
+	if _, err := client.SocialPlanner.BulkDeletePosts("loc-1", nil); err == nil {
+		t.Error("Expected error for missing posts")
+	}
+
+	if _, err := client.SocialPlanner.GetPost("", "post-1"); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
+
+func TestNewMultipartBody(t *testing.T) {
+	body, err := newMultipartBody("file", "posts.csv", strings.NewReader("summary,date\nHello,2025-01-01\n"), map[string]string{"userId": "user-1"})
+	if err != nil {
+		t.Fatalf("newMultipartBody failed: %v", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(body.contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("Unexpected content type %q: %v", body.contentType, err)
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(body.data), params["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("Failed to parse multipart body: %v", err)
+	}
+	if got := form.Value["userId"]; len(got) != 1 || got[0] != "user-1" {
+		t.Errorf("Expected userId field, got %v", got)
+	}
+	files := form.File["file"]
+	if len(files) != 1 || files[0].Filename != "posts.csv" {
+		t.Fatalf("Expected posts.csv upload, got %v", files)
+	}
+}
+
+func TestSocialPlanner_CSVImport(t *testing.T) {
+	var accounts SetCSVAccountsRequest
+	var finalized map[string]string
+	var deleted []string
+	checkPage := func(r *http.Request, limit, skip string) {
+		if q := r.URL.Query(); q.Get("limit") != limit || q.Get("skip") != skip {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}
+	client := newTestClient(t, Config{}, map[string]http.HandlerFunc{
+		"POST /social-media-posting/loc-1/csv": func(w http.ResponseWriter, r *http.Request) {
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("Expected a multipart file upload: %v", err)
+				return
+			}
+			defer file.Close()
+			if header.Filename != "posts.csv" {
+				t.Errorf("Unexpected file name %s", header.Filename)
+			}
+			_, _ = w.Write([]byte(`{"results":{"filePath":"csv/posts.csv","rowsCount":2,"fileName":"posts.csv"}}`))
+		},
+		"POST /social-media-posting/loc-1/set-accounts": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&accounts)
+			_, _ = w.Write([]byte(`{"results":{"id":"csv-1","status":"in_progress","rowsCount":2}}`))
+		},
+		"GET /social-media-posting/loc-1/csv": func(w http.ResponseWriter, r *http.Request) {
+			checkPage(r, "10", "0")
+			_, _ = w.Write([]byte(`{"results":{"csvs":[{"id":"csv-1"}],"count":1}}`))
+		},
+		"GET /social-media-posting/loc-1/csv/csv-1": func(w http.ResponseWriter, r *http.Request) {
+			checkPage(r, "5", "10")
+			_, _ = w.Write([]byte(`{"results":{"csv":{"id":"csv-1"},"posts":[{"_id":"post-1"}],"count":2}}`))
+		},
+		"PATCH /social-media-posting/loc-1/csv/csv-1": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&finalized)
+			_, _ = w.Write([]byte(`{}`))
+		},
+		"DELETE /social-media-posting/loc-1/csv/csv-1": func(w http.ResponseWriter, r *http.Request) {
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		},
+		"DELETE /social-media-posting/loc-1/csv/csv-1/post/post-1": func(w http.ResponseWriter, r *http.Request) {
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		},
+	})
+
+	upload, err := client.SocialPlanner.UploadCSV("loc-1", "posts.csv", strings.NewReader("summary,date\nHello,2025-01-01\n"))
+	if err != nil {
+		t.Fatalf("UploadCSV failed: %v", err)
+	}
+	if upload.FilePath != "csv/posts.csv" || upload.RowsCount != 2 {
+		t.Errorf("Unexpected upload: %+v", upload)
+	}
+
+	imported, err := client.SocialPlanner.SetCSVAccounts("loc-1", &SetCSVAccountsRequest{
+		AccountIDs: []string{"acc-1"},
+		FilePath:   upload.FilePath,
+		RowsCount:  upload.RowsCount,
+		FileName:   upload.FileName,
+	})
+	if err != nil {
+		t.Fatalf("SetCSVAccounts failed: %v", err)
+	}
+	if imported.ID != "csv-1" || accounts.FilePath != "csv/posts.csv" || len(accounts.AccountIDs) != 1 {
+		t.Errorf("Unexpected set accounts: %+v, %+v", imported, accounts)
+	}
+
+	imports, err := client.SocialPlanner.ListCSVImports("loc-1", 0, 0)
+	if err != nil || imports.Count != 1 || len(imports.CSVs) != 1 {
+		t.Errorf("ListCSVImports = %+v, %v", imports, err)
+	}
+
+	review, err := client.SocialPlanner.GetCSVImport("loc-1", "csv-1", 5, 10)
+	if err != nil || review.CSV == nil || len(review.Posts) != 1 || review.Count != 2 {
+		t.Errorf("GetCSVImport = %+v, %v", review, err)
+	}
+
+	if err := client.SocialPlanner.FinalizeCSVImport("loc-1", "csv-1", "user-1"); err != nil {
+		t.Fatalf("FinalizeCSVImport failed: %v", err)
+	}
+	if finalized["userId"] != "user-1" {
+		t.Errorf("Unexpected finalize request: %v", finalized)
+	}
+
+	if err := client.SocialPlanner.DeleteCSVImportPost("loc-1", "csv-1", "post-1"); err != nil {
+		t.Errorf("DeleteCSVImportPost failed: %v", err)
+	}
+	if err := client.SocialPlanner.DeleteCSVImport("loc-1", "csv-1"); err != nil {
+		t.Errorf("DeleteCSVImport failed: %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("Unexpected deletes: %v", deleted)
+	}
+}
+
+func TestSocialPlanner_CSVImportValidation(t *testing.T) {
+	client := newTestClient(t, Config{}, nil)
+
+	if _, err := client.SocialPlanner.UploadCSV("loc-1", "", strings.NewReader("")); err == nil {
+		t.Error("Expected error for missing fileName")
+	}
+	if _, err := client.SocialPlanner.UploadCSV("loc-1", "posts.csv", nil); err == nil {
+		t.Error("Expected error for missing file")
+	}
+	if _, err := client.SocialPlanner.SetCSVAccounts("loc-1", &SetCSVAccountsRequest{FilePath: "csv/posts.csv"}); err == nil {
+		t.Error("Expected error for missing accounts")
+	}
+	if _, err := client.SocialPlanner.SetCSVAccounts("loc-1", &SetCSVAccountsRequest{AccountIDs: []string{"acc-1"}}); err == nil {
+		t.Error("Expected error for missing filePath")
+	}
+	if _, err := client.SocialPlanner.GetCSVImport("loc-1", "", 0, 0); err == nil {
+		t.Error("Expected error for missing csvId")
+	}
+	if err := client.SocialPlanner.FinalizeCSVImport("loc-1", "", ""); err == nil {
+		t.Error("Expected error for missing csvId")
+	}
+	if err := client.SocialPlanner.DeleteCSVImport("loc-1", ""); err == nil {
+		t.Error("Expected error for missing csvId")
+	}
+	if err := client.SocialPlanner.DeleteCSVImportPost("loc-1", "csv-1", ""); err == nil {
+		t.Error("Expected error for missing postId")
+	}
+
+	if _, err := client.SocialPlanner.ListCSVImports("", 0, 0); err == nil {
+		t.Error("Expected error for missing locationId")
+	}
+}
@@ -0,0 +1,186 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// SubscriptionsService handles operations related to payment subscriptions
+type SubscriptionsService struct {
+	client *Client
+}
+
+// Subscription represents a recurring payment subscription
+type Subscription struct {
+	ID                   string                 `json:"_id,omitempty"`
+	AltID                string                 `json:"altId,omitempty"`
+	AltType              string                 `json:"altType,omitempty"`
+	ContactID            string                 `json:"contactId,omitempty"`
+	ContactName          string                 `json:"contactName,omitempty"`
+	ContactEmail         string                 `json:"contactEmail,omitempty"`
+	ContactPhone         string                 `json:"contactPhone,omitempty"`
+	Currency             string                 `json:"currency,omitempty"`
+	Amount               float64                `json:"amount,omitempty"`
+	Status               string                 `json:"status,omitempty"`
+	LiveMode             bool                   `json:"liveMode,omitempty"`
+	EntityType           string                 `json:"entityType,omitempty"`
+	EntityID             string                 `json:"entityId,omitempty"`
+	EntitySourceType     string                 `json:"entitySourceType,omitempty"`
+	EntitySourceName     string                 `json:"entitySourceName,omitempty"`
+	EntitySourceID       string                 `json:"entitySourceId,omitempty"`
+	SubscriptionID       string                 `json:"subscriptionId,omitempty"` // ID of the subscription at the payment provider
+	PaymentProviderType  string                 `json:"paymentProviderType,omitempty"`
+	SubscriptionSnapshot map[string]interface{} `json:"subscriptionSnapshot,omitempty"`
+	Meta                 map[string]interface{} `json:"meta,omitempty"`
+	MarkAsTest           bool                   `json:"markAsTest,omitempty"`
+	CreatedAt            Time                   `json:"createdAt,omitempty"`
+	UpdatedAt            Time                   `json:"updatedAt,omitempty"`
+	// Extra holds fields returned by the API that this struct does not declare yet,
+	// so they survive a decode/encode round-trip
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ListSubscriptionsOptions represents query options for listing subscriptions
+type ListSubscriptionsOptions struct {
+	LocationID       string
+	ContactID        string
+	EntityID         string
+	EntitySourceType string
+	PaymentMode      string // "live" or "test"
+	Search           string
+	StartAt          string // YYYY-MM-DD
+	EndAt            string // YYYY-MM-DD
+	Limit            int
+	Offset           int
+
+	// Statuses restricts the returned subscriptions to the given statuses
+	// (e.g. "active", "trialing", "past_due", "canceled"). The API does not
+	// filter by status, so List keeps fetching pages and filtering them
+	// until Limit (default 100) subscriptions match or the list ends.
+	// TotalCount still reflects the unfiltered result; pass NextOffset as
+	// Offset to continue after the returned subscriptions.
+	Statuses []string
+}
+
+// SubscriptionsResponse represents a list of subscriptions API response
+type SubscriptionsResponse struct {
+	Data       []Subscription `json:"data,omitempty"`
+	TotalCount int            `json:"totalCount,omitempty"`
+
+	// NextOffset is the Offset that continues the list after Data. With Statuses, it counts
+	// the unfiltered subscriptions scanned, and is at least TotalCount once the list is done.
+	NextOffset int `json:"-"`
+}
+
+// statusFilterPageSize is the page size used when filtering subscriptions by status without a Limit
+const statusFilterPageSize = 100
+
+// List retrieves subscriptions for a location with optional filters
+// Required scope: payments/subscriptions.readonly
+func (s *SubscriptionsService) List(opts *ListSubscriptionsOptions) (*SubscriptionsResponse, error) {
+	if opts == nil {
+		opts = &ListSubscriptionsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if len(opts.Statuses) == 0 {
+		result, err := s.list(opts)
+		if err != nil {
+			return nil, err
+		}
+		result.NextOffset = opts.Offset + len(result.Data)
+		return result, nil
+	}
+
+	if opts.Limit <= 0 {
+		opts.Limit = statusFilterPageSize
+	}
+	matched := &SubscriptionsResponse{Data: []Subscription{}, NextOffset: opts.Offset}
+	for {
+		page, err := s.list(opts)
+		if err != nil {
+			return nil, err
+		}
+		matched.TotalCount = page.TotalCount
+
+		for _, sub := range page.Data {
+			matched.NextOffset++
+			if slices.Contains(opts.Statuses, sub.Status) {
+				matched.Data = append(matched.Data, sub)
+				if len(matched.Data) == opts.Limit {
+					return matched, nil
+				}
+			}
+		}
+		// The API may return fewer subscriptions than asked for before the end of the list, so
+		// only an empty page or the reported total ends it
+		if len(page.Data) == 0 || (page.TotalCount > 0 && matched.NextOffset >= page.TotalCount) {
+			return matched, nil
+		}
+		opts.Offset = matched.NextOffset
+	}
+}
+
+// list retrieves a single page of subscriptions, unfiltered by status
+func (s *SubscriptionsService) list(opts *ListSubscriptionsOptions) (*SubscriptionsResponse, error) {
+	query := altLocationQuery(opts.LocationID)
+	if opts.ContactID != "" {
+		query.Set("contactId", opts.ContactID)
+	}
+	if opts.EntityID != "" {
+		query.Set("entityId", opts.EntityID)
+	}
+	if opts.EntitySourceType != "" {
+		query.Set("entitySourceType", opts.EntitySourceType)
+	}
+	if opts.PaymentMode != "" {
+		query.Set("paymentMode", opts.PaymentMode)
+	}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if opts.StartAt != "" {
+		query.Set("startAt", opts.StartAt)
+	}
+	if opts.EndAt != "" {
+		query.Set("endAt", opts.EndAt)
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+
+	var result SubscriptionsResponse
+	err := s.client.doRequest("GET", "/payments/subscriptions?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves a subscription by ID
+// Required scope: payments/subscriptions.readonly
+func (s *SubscriptionsService) Get(locationID, subscriptionID string) (*Subscription, error) {
+	locationID = s.client.resolveLocationID(locationID)
+	if locationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("subscriptionId is required")
+	}
+
+	var result Subscription
+	err := s.client.doRequest("GET", fmt.Sprintf("/payments/subscriptions/%s?%s", subscriptionID, altLocationQuery(locationID).Encode()), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,115 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestSubscriptionsService_ListStatuses(t *testing.T) {
+	statuses := []string{"active", "canceled", "trialing", "canceled", "active", "past_due", "active"}
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("altId") != "loc-1" || q.Get("limit") != "2" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		offsets = append(offsets, q.Get("offset"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		result := SubscriptionsResponse{TotalCount: len(statuses)}
+		for i := offset; i < len(statuses) && i < offset+2; i++ {
+			result.Data = append(result.Data, Subscription{ID: strconv.Itoa(i), Status: statuses[i]})
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	opts := &ListSubscriptionsOptions{LocationID: "loc-1", Limit: 2, Statuses: []string{"active", "trialing"}}
+	result, err := client.Subscriptions.List(opts)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(result.Data) != 2 || result.Data[0].ID != "0" || result.Data[1].ID != "2" {
+		t.Errorf("Unexpected subscriptions: %+v", result.Data)
+	}
+	if result.NextOffset != 3 || result.TotalCount != 7 {
+		t.Errorf("Expected next offset 3 of 7, got %d of %d", result.NextOffset, result.TotalCount)
+	}
+
+	opts.Offset = result.NextOffset
+	result, err = client.Subscriptions.List(opts)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(result.Data) != 2 || result.Data[0].ID != "4" || result.Data[1].ID != "6" {
+		t.Errorf("Unexpected subscriptions: %+v", result.Data)
+	}
+	if result.NextOffset != 7 {
+		t.Errorf("Expected next offset 7, got %d", result.NextOffset)
+	}
+	if fmt.Sprint(offsets) != "[ 2 3 5]" {
+		t.Errorf("Unexpected offsets requested: %v", offsets)
+	}
+}
+
+func TestSubscriptionsService_ListStatuses_ShortPages(t *testing.T) {
+	const total, maxPage = 25, 10
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// The server caps pages below the requested limit
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		result := SubscriptionsResponse{TotalCount: total}
+		for i := offset; i < total && i < offset+maxPage; i++ {
+			status := "canceled"
+			if i == total-1 {
+				status = "active"
+			}
+			result.Data = append(result.Data, Subscription{ID: strconv.Itoa(i), Status: status})
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL})
+	result, err := client.Subscriptions.List(&ListSubscriptionsOptions{LocationID: "loc-1", Statuses: []string{"active"}})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].ID != "24" {
+		t.Errorf("Unexpected subscriptions: %+v", result.Data)
+	}
+	if result.NextOffset != total || requests != 3 {
+		t.Errorf("Expected next offset %d after 3 requests, got %d after %d", total, result.NextOffset, requests)
+	}
+}
+
+func TestSubscriptionsIntegration_List(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	client := setupTestClient(t)
+	locationID := getTestLocationID(t)
+
+	subscriptions, err := client.Subscriptions.List(&ListSubscriptionsOptions{
+		LocationID: locationID,
+		Limit:      10,
+		Statuses:   []string{"active"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to list subscriptions: %v", err)
+	}
+
+	for _, sub := range subscriptions.Data {
+		if sub.Status != "active" {
+			t.Errorf("Expected only active subscriptions, got %s", sub.Status)
+		}
+	}
+
+	t.Logf("Found %d active subscriptions (%d total)", len(subscriptions.Data), subscriptions.TotalCount)
+}
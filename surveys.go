@@ -0,0 +1,134 @@
+package gohighlevel
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+)
+
+// SurveysService handles operations related to surveys and their submissions
+type SurveysService struct {
+	client *Client
+}
+
+// SurveySubmission represents a submission of a survey
+type SurveySubmission struct {
+	ID        string `json:"id,omitempty"`
+	ContactID string `json:"contactId,omitempty"`
+	SurveyID  string `json:"surveyId,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	CreatedAt Time   `json:"createdAt,omitempty"`
+	// Others holds every answer keyed by field key or custom field ID, plus submission metadata
+	Others map[string]interface{} `json:"others,omitempty"`
+}
+
+// ListSurveySubmissionsOptions represents query options for listing survey submissions
+type ListSurveySubmissionsOptions struct {
+	LocationID string
+	SurveyID   string // All surveys when empty
+	Query      string // Matches contact name, email or phone
+	StartAt    time.Time
+	EndAt      time.Time
+	Page       int
+	Limit      int // At most 100 (default 20)
+	// PageToken resumes listing from Page.NextPageToken, overriding Page
+	PageToken string
+}
+
+// SurveySubmissionsResponse represents a list of survey submissions API response
+type SurveySubmissionsResponse struct {
+	Submissions []SurveySubmission `json:"submissions,omitempty"`
+	Meta        *SubmissionsMeta   `json:"meta,omitempty"`
+}
+
+// ListSubmissions retrieves a page of survey submissions
+// Required scope: surveys.readonly
+func (s *SurveysService) ListSubmissions(opts *ListSurveySubmissionsOptions) (*SurveySubmissionsResponse, error) {
+	if opts == nil {
+		opts = &ListSurveySubmissionsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+	if opts.PageToken != "" {
+		page, err := strconv.Atoi(opts.PageToken)
+		if err != nil || page < 1 {
+			return nil, fmt.Errorf("invalid page token %q", opts.PageToken)
+		}
+		opts.Page = page
+	}
+
+	query := submissionsQuery(opts.LocationID, opts.Query, opts.StartAt, opts.EndAt, opts.Page, opts.Limit)
+	if opts.SurveyID != "" {
+		query.Set("surveyId", opts.SurveyID)
+	}
+
+	var result SurveySubmissionsResponse
+	err := s.client.doRequest("GET", "/surveys/submissions?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SubmissionsPage retrieves a page of survey submissions like ListSubmissions, with HasMore and
+// NextPage for manual pagination
+// Required scope: surveys.readonly
+func (s *SurveysService) SubmissionsPage(ctx context.Context, opts *ListSurveySubmissionsOptions) (*Page[SurveySubmission], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &ListSurveySubmissionsOptions{}
+	}
+	current := *opts
+
+	result, err := s.ListSubmissions(&current)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[SurveySubmission]{Items: result.Submissions}
+	if meta := result.Meta; meta != nil {
+		page.Total = meta.Total
+		page.HasMore = len(result.Submissions) > 0 && meta.NextPage != nil
+		if page.HasMore {
+			page.NextPageToken = strconv.Itoa(*meta.NextPage)
+			nextOpts := current
+			nextOpts.PageToken = page.NextPageToken
+			page.next = func(ctx context.Context) (*Page[SurveySubmission], error) {
+				return s.SubmissionsPage(ctx, &nextOpts)
+			}
+		}
+	}
+	return page, nil
+}
+
+// Submissions iterates over every survey submission matching opts, e.g. over a date range set
+// with StartAt and EndAt, fetching pages as the loop reaches them. A failed fetch is yielded once
+// as the error, ending the iteration.
+// Required scope: surveys.readonly
+func (s *SurveysService) Submissions(ctx context.Context, opts *ListSurveySubmissionsOptions) iter.Seq2[SurveySubmission, error] {
+	return func(yield func(SurveySubmission, error) bool) {
+		pageOpts := ListSurveySubmissionsOptions{}
+		if opts != nil {
+			pageOpts = *opts
+		}
+		if pageOpts.Limit <= 0 {
+			pageOpts.Limit = submissionsPageSize
+		}
+
+		first, err := s.SubmissionsPage(ctx, &pageOpts)
+		if err != nil {
+			yield(SurveySubmission{}, err)
+			return
+		}
+		first.All(ctx)(yield)
+	}
+}
@@ -0,0 +1,70 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSurveysService_Submissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/surveys/submissions" || q.Get("surveyId") != "sv-1" || q.Get("startAt") != "2026-02-01" || q.Get("limit") != "100" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		switch q.Get("page") {
+		case "":
+			_, _ = w.Write([]byte(`{"submissions":[{"id":"s1"},{"id":"s2"}],"meta":{"total":3,"currentPage":1,"nextPage":2}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"submissions":[{"id":"s3"}],"meta":{"total":3,"currentPage":2,"nextPage":null}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc-1"})
+	opts := &ListSurveySubmissionsOptions{SurveyID: "sv-1", StartAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	var ids []string
+	for sub, err := range client.Surveys.Submissions(context.Background(), opts) {
+		if err != nil {
+			t.Fatalf("Submissions failed: %v", err)
+		}
+		ids = append(ids, sub.ID)
+	}
+	if len(ids) != 3 || ids[0] != "s1" || ids[2] != "s3" {
+		t.Errorf("ids = %v", ids)
+	}
+
+	// Stopping early fetches no further pages
+	for range client.Surveys.Submissions(context.Background(), opts) {
+		break
+	}
+}
+
+func TestSurveysService_SubmissionsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"submissions":[{"id":"s1"}],"meta":{"total":2,"nextPage":2}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{AccessToken: "token", BaseURL: server.URL, LocationID: "loc-1"})
+	var items, errs int
+	for _, err := range client.Surveys.Submissions(context.Background(), nil) {
+		if err != nil {
+			errs++
+			continue
+		}
+		items++
+	}
+	if items != 1 || errs != 1 {
+		t.Errorf("items = %d, errors = %d", items, errs)
+	}
+}
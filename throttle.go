@@ -0,0 +1,176 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// RetryBudget limits retries across all requests sent by a client, so a swarm of goroutines
+// sharing the client stops retrying when GoHighLevel is struggling instead of amplifying the
+// load. Every throttled or failed attempt spends a token and every successful one earns back
+// a fraction of a token; retries are only allowed while more than half the tokens remain.
+type RetryBudget struct {
+	mu         sync.Mutex
+	maxTokens  float64
+	tokenRatio float64
+	tokens     float64
+}
+
+// NewRetryBudget returns a retry budget holding maxTokens tokens (default 10) that earns
+// tokenRatio tokens per successful request (default 0.1)
+func NewRetryBudget(maxTokens, tokenRatio float64) *RetryBudget {
+	if maxTokens <= 0 {
+		maxTokens = 10
+	}
+	if tokenRatio <= 0 {
+		tokenRatio = 0.1
+	}
+	return &RetryBudget{maxTokens: maxTokens, tokenRatio: tokenRatio, tokens: maxTokens}
+}
+
+// record updates the budget with the outcome of an attempt
+func (b *RetryBudget) record(overloaded bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if overloaded {
+		b.tokens--
+		if b.tokens < 0 {
+			b.tokens = 0
+		}
+		return
+	}
+	b.tokens += b.tokenRatio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// allowRetry reports whether the budget permits another retry
+func (b *RetryBudget) allowRetry() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens > b.maxTokens/2
+}
+
+// Tokens returns the tokens currently in the budget
+func (b *RetryBudget) Tokens() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens
+}
+
+// AdaptiveConcurrency limits the number of requests a client has in flight and adapts the limit
+// to how GoHighLevel responds: the limit grows by one for every limit's worth of successful
+// requests, and is halved when a request is throttled (429) or fails with a server error.
+type AdaptiveConcurrency struct {
+	mu       sync.Mutex
+	minLimit float64
+	maxLimit float64
+	limit    float64
+	inFlight int
+	changed  chan struct{}
+}
+
+// NewAdaptiveConcurrency returns a concurrency limiter that starts at maxLimit and never goes
+// below minLimit (at least 1)
+func NewAdaptiveConcurrency(minLimit, maxLimit int) *AdaptiveConcurrency {
+	if minLimit < 1 {
+		minLimit = 1
+	}
+	if maxLimit < minLimit {
+		maxLimit = minLimit
+	}
+	return &AdaptiveConcurrency{
+		minLimit: float64(minLimit),
+		maxLimit: float64(maxLimit),
+		limit:    float64(maxLimit),
+		changed:  make(chan struct{}),
+	}
+}
+
+// acquire waits until a request may be sent
+func (a *AdaptiveConcurrency) acquire(ctx context.Context) error {
+	for {
+		a.mu.Lock()
+		if a.inFlight < int(a.limit) {
+			a.inFlight++
+			a.mu.Unlock()
+			return nil
+		}
+		changed := a.changed
+		a.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// tryAcquire starts a request if a slot is free, without waiting
+func (a *AdaptiveConcurrency) tryAcquire() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.inFlight >= int(a.limit) {
+		return false
+	}
+	a.inFlight++
+	return true
+}
+
+// release ends a request and adjusts the limit to its outcome
+func (a *AdaptiveConcurrency) release(overloaded bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inFlight--
+	if overloaded {
+		a.limit /= 2
+		if a.limit < a.minLimit {
+			a.limit = a.minLimit
+		}
+	} else {
+		a.limit += 1 / a.limit
+		if a.limit > a.maxLimit {
+			a.limit = a.maxLimit
+		}
+	}
+
+	close(a.changed)
+	a.changed = make(chan struct{})
+}
+
+// releaseCancelled ends a request that was cancelled before completing, leaving the limit as is
+func (a *AdaptiveConcurrency) releaseCancelled() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inFlight--
+	close(a.changed)
+	a.changed = make(chan struct{})
+}
+
+// Limit returns the current concurrency limit
+func (a *AdaptiveConcurrency) Limit() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return int(a.limit)
+}
+
+// InFlight returns the number of requests currently in flight
+func (a *AdaptiveConcurrency) InFlight() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.inFlight
+}
+
+// isOverloaded reports whether an attempt's outcome indicates GoHighLevel is overloaded
+func isOverloaded(statusCode int, err error) bool {
+	if statusCode == 0 {
+		return err != nil
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
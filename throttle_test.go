@@ -0,0 +1,87 @@
+package gohighlevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryBudget(t *testing.T) {
+	b := NewRetryBudget(4, 0.5)
+	if !b.allowRetry() {
+		t.Fatal("full budget should allow retries")
+	}
+
+	b.record(true)
+	b.record(true)
+	if b.allowRetry() {
+		t.Errorf("budget with %v of 4 tokens should not allow retries", b.Tokens())
+	}
+
+	b.record(false)
+	if !b.allowRetry() {
+		t.Errorf("budget with %v of 4 tokens should allow retries", b.Tokens())
+	}
+}
+
+func TestAdaptiveConcurrency(t *testing.T) {
+	a := NewAdaptiveConcurrency(1, 8)
+	ctx := context.Background()
+
+	for i := 0; i < 8; i++ {
+		if err := a.acquire(ctx); err != nil {
+			t.Fatalf("acquire %d failed: %v", i, err)
+		}
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := a.acquire(waitCtx); err == nil {
+		t.Fatal("acquire beyond the limit should block")
+	}
+
+	a.release(true)
+	if a.Limit() != 4 {
+		t.Errorf("Limit after overload = %d, want 4", a.Limit())
+	}
+	for i := 0; i < 7; i++ {
+		a.release(true)
+	}
+	if a.Limit() != 1 || a.InFlight() != 0 {
+		t.Errorf("Limit = %d, InFlight = %d, want 1 and 0", a.Limit(), a.InFlight())
+	}
+
+	for i := 0; i < 10; i++ {
+		_ = a.acquire(ctx)
+		a.release(false)
+	}
+	if a.Limit() <= 1 {
+		t.Errorf("Limit did not recover after successes: %d", a.Limit())
+	}
+}
+
+func TestClient_RetryBudgetStopsRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, _ := NewClientWithOptions(
+		WithAccessToken("token"),
+		WithBaseURL(server.URL),
+		WithRetry(&RetryPolicy{MaxRetries: 10, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+		WithRetryBudget(NewRetryBudget(4, 0.1)),
+	)
+
+	if _, err := client.Contacts.Get("c1"); err == nil {
+		t.Fatal("expected error")
+	}
+	// Each failure spends a token and retries stop once no more than 2 of 4 remain
+	if calls != 2 {
+		t.Errorf("server called %d times, want 2", calls)
+	}
+}
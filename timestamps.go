@@ -0,0 +1,132 @@
+package gohighlevel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timeLayouts are the string timestamp formats returned by the API, tried in order
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Time is a timestamp that unmarshals from any of the formats the API uses: RFC 3339 strings,
+// date-only strings, and second or millisecond Unix epochs (as numbers or strings).
+// null and "" unmarshal to the zero time, and the zero time marshals to null.
+type Time struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *Time) UnmarshalJSON(data []byte) error {
+	parsed, err := parseTimestamp(data)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
+
+// iso returns the time as an ISO 8601 string for request fields, or "" for the zero time
+func (t Time) iso() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339Nano)
+}
+
+// Date is a calendar date such as a date of birth. It unmarshals from the same formats as Time,
+// keeping only the date, and marshals as "YYYY-MM-DD".
+type Date struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Date) UnmarshalJSON(data []byte) error {
+	parsed, err := parseTimestamp(data)
+	if err != nil {
+		return err
+	}
+	if parsed.IsZero() {
+		d.Time = time.Time{}
+		return nil
+	}
+	d.Time = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// String returns the date as "YYYY-MM-DD", or "" for the zero date
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Time.Format("2006-01-02")
+}
+
+// parseTimestamp parses a JSON timestamp in any of the supported formats
+func parseTimestamp(data []byte) (time.Time, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return time.Time{}, nil
+	}
+
+	if data[0] != '"' {
+		return parseEpoch(string(data))
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %s: %w", data, err)
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	if parsed, err := parseEpoch(value); err == nil {
+		return parsed, nil
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported timestamp format %q", value)
+}
+
+// parseEpoch parses a Unix epoch in seconds or milliseconds
+func parseEpoch(value string) (time.Time, error) {
+	epoch, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unsupported timestamp format %q", value)
+	}
+
+	// Epochs beyond year 5138 in seconds are treated as milliseconds
+	if epoch > 1e11 || epoch < -1e11 {
+		return time.UnixMilli(int64(epoch)).UTC(), nil
+	}
+	return time.Unix(int64(epoch), 0).UTC(), nil
+}
@@ -0,0 +1,91 @@
+package gohighlevel
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	want := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"RFC3339", `"2024-03-15T10:30:00Z"`, want},
+		{"RFC3339 with millis", `"2024-03-15T10:30:00.000Z"`, want},
+		{"RFC3339 with offset", `"2024-03-15T12:30:00+02:00"`, want},
+		{"millisecond epoch", `1710498600000`, want},
+		{"second epoch", `1710498600`, want},
+		{"epoch string", `"1710498600000"`, want},
+		{"date only", `"2024-03-15"`, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"null", `null`, time.Time{}},
+		{"empty string", `""`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got.Time)
+			}
+		})
+	}
+
+	var invalid Time
+	if err := json.Unmarshal([]byte(`"next tuesday"`), &invalid); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestDate_RoundTrip(t *testing.T) {
+	var contact Contact
+	data := `{"dateOfBirth":"1990-07-04T00:00:00.000Z","dateAdded":1710498600000}`
+	if err := json.Unmarshal([]byte(data), &contact); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if got := contact.DateOfBirth.String(); got != "1990-07-04" {
+		t.Errorf("Expected 1990-07-04, got %s", got)
+	}
+	if contact.DateAdded.Year() != 2024 {
+		t.Errorf("Expected dateAdded in 2024, got %v", contact.DateAdded.Time)
+	}
+
+	out, err := json.Marshal(Contact{DateOfBirth: contact.DateOfBirth})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	want := `{"dateOfBirth":"1990-07-04","dateAdded":null,"dateUpdated":null}`
+	if string(out) != want {
+		t.Errorf("Expected %s, got %s", want, out)
+	}
+}
+
+func TestTime_Models(t *testing.T) {
+	var invoice Invoice
+	data := `{"issueDate":"2024-03-15","dueDate":"2024-04-14T00:00:00.000Z","createdAt":"2024-03-15T10:30:00.000Z","updatedAt":1710498600}`
+	if err := json.Unmarshal([]byte(data), &invoice); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if invoice.IssueDate.String() != "2024-03-15" || invoice.DueDate.String() != "2024-04-14" {
+		t.Errorf("Expected issue and due dates, got %s and %s", invoice.IssueDate, invoice.DueDate)
+	}
+	want := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	if !invoice.CreatedAt.Equal(want) || !invoice.UpdatedAt.Equal(want) {
+		t.Errorf("Expected %v, got %v and %v", want, invoice.CreatedAt.Time, invoice.UpdatedAt.Time)
+	}
+
+	var order Order
+	if err := json.Unmarshal([]byte(`{"createdAt":"1710498600000"}`), &order); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !order.CreatedAt.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, order.CreatedAt.Time)
+	}
+}
@@ -0,0 +1,95 @@
+package gohighlevel
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// RequestTiming breaks down where the time of one HTTP request went, so latency can be
+// attributed to the network or to GoHighLevel's processing. Phases that did not happen,
+// e.g. DNS and TLS on a reused connection, are zero.
+type RequestTiming struct {
+	Method       string
+	Path         string
+	DNS          time.Duration // DNS lookup
+	Connect      time.Duration // TCP connection setup
+	TLSHandshake time.Duration // TLS handshake
+	ConnReused   bool          // Whether a pooled connection was reused
+	ConnWasIdle  bool          // Whether the reused connection was idle in the pool
+	// TimeToFirstByte is the time from sending the request until the first response byte,
+	// which approximates GoHighLevel's processing time on a reused connection
+	TimeToFirstByte time.Duration
+	Total           time.Duration // Whole request, including reading the response body
+	Err             error
+	Metadata        Metadata
+}
+
+// TimingHook is called with the timing breakdown of every HTTP request
+type TimingHook func(timing RequestTiming)
+
+// requestTimer collects httptrace events for one request
+type requestTimer struct {
+	mu                                sync.Mutex
+	start, dnsStart, connectStart     time.Time
+	tlsStart, wroteRequest, firstByte time.Time
+	timing                            RequestTiming
+}
+
+// newRequestTimer starts timing a request and returns ctx with the trace attached
+func newRequestTimer(ctx context.Context, method, path string) (*requestTimer, context.Context) {
+	t := &requestTimer{start: time.Now(), timing: RequestTiming{Method: method, Path: path}}
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) { t.mark(&t.dnsStart) },
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.measure(t.dnsStart, &t.timing.DNS)
+		},
+		ConnectStart: func(string, string) { t.mark(&t.connectStart) },
+		ConnectDone: func(string, string, error) {
+			t.measure(t.connectStart, &t.timing.Connect)
+		},
+		TLSHandshakeStart: func() { t.mark(&t.tlsStart) },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.measure(t.tlsStart, &t.timing.TLSHandshake)
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.timing.ConnReused = info.Reused
+			t.timing.ConnWasIdle = info.WasIdle
+			t.mu.Unlock()
+		},
+		WroteRequest:         func(httptrace.WroteRequestInfo) { t.mark(&t.wroteRequest) },
+		GotFirstResponseByte: func() { t.mark(&t.firstByte) },
+	}
+	return t, httptrace.WithClientTrace(ctx, trace)
+}
+
+func (t *requestTimer) mark(at *time.Time) {
+	t.mu.Lock()
+	*at = time.Now()
+	t.mu.Unlock()
+}
+
+func (t *requestTimer) measure(since time.Time, d *time.Duration) {
+	t.mu.Lock()
+	if !since.IsZero() {
+		*d = time.Since(since)
+	}
+	t.mu.Unlock()
+}
+
+// finish completes the timing of the request
+func (t *requestTimer) finish(err error, md Metadata) RequestTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	timing := t.timing
+	if !t.firstByte.IsZero() && !t.wroteRequest.IsZero() {
+		timing.TimeToFirstByte = t.firstByte.Sub(t.wroteRequest)
+	}
+	timing.Total = time.Since(t.start)
+	timing.Err = err
+	timing.Metadata = md
+	return timing
+}
@@ -0,0 +1,46 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_TimingHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"contact":{"id":"c1"}}`))
+	}))
+	defer server.Close()
+
+	var timings []RequestTiming
+	client, _ := NewClientWithOptions(
+		WithAccessToken("token"),
+		WithBaseURL(server.URL),
+		WithTimingHook(func(timing RequestTiming) { timings = append(timings, timing) }),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Contacts.Get("c1"); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+	}
+
+	if len(timings) != 2 {
+		t.Fatalf("got %d timings, want 2", len(timings))
+	}
+	first, second := timings[0], timings[1]
+	if first.Method != "GET" || first.Path != "/contacts/c1" {
+		t.Errorf("timing request = %s %s", first.Method, first.Path)
+	}
+	if first.ConnReused || first.Connect <= 0 {
+		t.Errorf("first request should open a connection: %+v", first)
+	}
+	if !second.ConnReused || second.Connect != 0 {
+		t.Errorf("second request should reuse the connection: %+v", second)
+	}
+	if first.TimeToFirstByte < 5*time.Millisecond || first.Total < first.TimeToFirstByte {
+		t.Errorf("TimeToFirstByte = %v, Total = %v", first.TimeToFirstByte, first.Total)
+	}
+}
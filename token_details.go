@@ -0,0 +1,156 @@
+package gohighlevel
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// TokenDetails describes an access token
+type TokenDetails struct {
+	UserType   string // "Location" or "Company"
+	CompanyID  string
+	LocationID string
+	UserID     string
+	Scopes     []string
+	IssuedAt   time.Time // Zero if unknown
+	ExpiresAt  time.Time // Zero if unknown
+	// Active is true when the API accepted the token at inspection time. It is only set by
+	// Client.InspectToken.
+	Active bool
+}
+
+// Expired reports whether the token is past its expiry, if known
+func (d *TokenDetails) Expired() bool {
+	return !d.ExpiresAt.IsZero() && !time.Now().Before(d.ExpiresAt)
+}
+
+// HasScope reports whether the token was granted a scope
+func (d *TokenDetails) HasScope(scope string) bool {
+	for _, s := range d.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenClaims are the claims GoHighLevel puts in its JWT access tokens
+type tokenClaims struct {
+	AuthClass   string `json:"authClass"`
+	AuthClassID string `json:"authClassId"`
+	CompanyID   string `json:"companyId"`
+	OAuthMeta   struct {
+		Scopes []string `json:"scopes"`
+	} `json:"oauthMeta"`
+	IssuedAt  int64 `json:"iat"`
+	ExpiresAt int64 `json:"exp"`
+}
+
+// ParseAccessToken reads the claims of a GoHighLevel access token without contacting the API.
+// The signature is not verified, so use the result for display and scheduling only; use
+// Client.InspectToken to also check the token with the API.
+func ParseAccessToken(token string) (*TokenDetails, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("access token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode access token claims: %w", err)
+	}
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to parse access token claims: %w", err)
+	}
+
+	details := &TokenDetails{UserType: claims.AuthClass, CompanyID: claims.CompanyID, Scopes: claims.OAuthMeta.Scopes}
+	switch claims.AuthClass {
+	case "Location":
+		details.LocationID = claims.AuthClassID
+	case "Company":
+		details.CompanyID = claims.AuthClassID
+	}
+	if claims.IssuedAt > 0 {
+		details.IssuedAt = time.Unix(claims.IssuedAt, 0)
+	}
+	if claims.ExpiresAt > 0 {
+		details.ExpiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+	return details, nil
+}
+
+// InspectToken describes the client's current access token and checks it with the API. Details
+// come from the token's claims and from what the token endpoint reported when the client last
+// obtained a token, with the latter taking precedence; Active reports whether the API accepts
+// the token right now, regardless of the expiry tracked locally.
+// Required scope: locations.readonly for location tokens, companies.readonly for agency tokens
+func (c *Client) InspectToken() (*TokenDetails, error) {
+	c.tokens.mu.RLock()
+	token := c.tokens.accessToken
+	expiry := c.tokens.expiry
+	reported := c.tokens.reported
+	c.tokens.mu.RUnlock()
+
+	if token == "" {
+		return nil, fmt.Errorf("no access token available, please authorize first")
+	}
+
+	details, err := ParseAccessToken(token)
+	if err != nil {
+		details = &TokenDetails{}
+	}
+	if reported != nil {
+		details.mergeTokenResponse(reported)
+	}
+	if !expiry.IsZero() {
+		details.ExpiresAt = expiry
+	}
+
+	var path string
+	switch {
+	case details.LocationID != "" || c.locationID != "":
+		path = fmt.Sprintf("/locations/%s", c.resolveLocationID(details.LocationID))
+	case details.CompanyID != "":
+		path = fmt.Sprintf("/companies/%s", details.CompanyID)
+	default:
+		return details, fmt.Errorf("cannot check the token: it names no location or company")
+	}
+
+	statusCode, respBody, err := c.sendRequest(context.Background(), "GET", path, nil, nil)
+	if err != nil {
+		return details, err
+	}
+	switch {
+	case statusCode >= 200 && statusCode < 300, statusCode == http.StatusForbidden, statusCode == http.StatusNotFound:
+		// A token lacking the scope to read its own location is still accepted by the API
+		details.Active = true
+	case statusCode == http.StatusUnauthorized:
+	default:
+		return details, fmt.Errorf("API request failed with status %d: %s", statusCode, string(respBody))
+	}
+	return details, nil
+}
+
+// mergeTokenResponse overlays what the token endpoint reported about a token
+func (d *TokenDetails) mergeTokenResponse(resp *TokenResponse) {
+	if resp.UserType != "" {
+		d.UserType = resp.UserType
+	}
+	if resp.CompanyID != "" {
+		d.CompanyID = resp.CompanyID
+	}
+	if resp.LocationID != "" {
+		d.LocationID = resp.LocationID
+	}
+	if resp.UserID != "" {
+		d.UserID = resp.UserID
+	}
+	if resp.Scope != "" {
+		d.Scopes = strings.Fields(resp.Scope)
+	}
+}
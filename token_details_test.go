@@ -0,0 +1,85 @@
+package gohighlevel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseAccessToken(t *testing.T) {
+	details, err := ParseAccessToken(testJWT(`{"authClass":"Location","authClassId":"loc","oauthMeta":{"scopes":["contacts.readonly"]},"iat":1700000000,"exp":1700086400}`))
+	if err != nil {
+		t.Fatalf("ParseAccessToken failed: %v", err)
+	}
+	want := &TokenDetails{
+		UserType:   "Location",
+		LocationID: "loc",
+		Scopes:     []string{"contacts.readonly"},
+		IssuedAt:   time.Unix(1700000000, 0),
+		ExpiresAt:  time.Unix(1700086400, 0),
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("ParseAccessToken = %+v, want %+v", details, want)
+	}
+	if !details.Expired() {
+		t.Error("token from 2023 not reported expired")
+	}
+
+	company, _ := ParseAccessToken(testJWT(`{"authClass":"Company","authClassId":"co"}`))
+	if company.CompanyID != "co" || company.LocationID != "" {
+		t.Errorf("company token = %+v", company)
+	}
+
+	if _, err := ParseAccessToken("opaque-token"); err == nil {
+		t.Error("non-JWT token was parsed")
+	}
+}
+
+func TestClient_InspectToken(t *testing.T) {
+	token := testJWT(`{"authClass":"Location","authClassId":"loc","oauthMeta":{"scopes":["contacts.readonly"]}}`)
+	var checkedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_, _ = w.Write([]byte(`{"access_token":"` + token + `","refresh_token":"r","expires_in":3600,"userType":"Location","companyId":"co","locationId":"loc","userId":"u1","scope":"contacts.readonly contacts.write"}`))
+			return
+		}
+		checkedPath = r.URL.Path
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{ClientID: "id", ClientSecret: "secret", Environment: MockEnvironment(server.URL)})
+	if err := client.AuthorizeWithRefreshToken("r"); err != nil {
+		t.Fatalf("AuthorizeWithRefreshToken failed: %v", err)
+	}
+
+	details, err := client.InspectToken()
+	if err != nil {
+		t.Fatalf("InspectToken failed: %v", err)
+	}
+	if !details.Active || checkedPath != "/locations/loc" {
+		t.Errorf("Active = %v, checked %q", details.Active, checkedPath)
+	}
+	// The token response takes precedence over the claims
+	if details.CompanyID != "co" || details.UserID != "u1" || !details.HasScope("contacts.write") {
+		t.Errorf("details = %+v", details)
+	}
+	if until := time.Until(details.ExpiresAt); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("ExpiresAt in %v, want about an hour", until)
+	}
+
+	client.SetAccessToken(testJWT(`{"authClass":"Location","authClassId":"loc"}`))
+	details, err = client.InspectToken()
+	if err != nil {
+		t.Fatalf("InspectToken failed: %v", err)
+	}
+	if details.Active || details.HasScope("contacts.write") {
+		t.Errorf("rejected token = %+v", details)
+	}
+}
@@ -0,0 +1,201 @@
+package gohighlevel
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrTokensNotFound is returned by a TokenStore or BlobStore when nothing is stored under a key
+var ErrTokensNotFound = errors.New("tokens not found")
+
+// StoredTokens are the OAuth tokens of one installation as persisted by a TokenStore
+type StoredTokens struct {
+	AccessToken  string    `json:"accessToken"`
+	RefreshToken string    `json:"refreshToken"`
+	ExpiresAt    time.Time `json:"expiresAt,omitempty"`
+	UserType     string    `json:"userType,omitempty"`
+	CompanyID    string    `json:"companyId,omitempty"`
+	LocationID   string    `json:"locationId,omitempty"`
+}
+
+// StoredTokensFromResponse returns the tokens of a token response, e.g. in an OnTokenRefresh
+// callback
+func StoredTokensFromResponse(resp TokenResponse) *StoredTokens {
+	tokens := &StoredTokens{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		UserType:     resp.UserType,
+		CompanyID:    resp.CompanyID,
+		LocationID:   resp.LocationID,
+	}
+	if resp.ExpiresIn > 0 {
+		tokens.ExpiresAt = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+	return tokens
+}
+
+// TokenStore persists the tokens of installations, keyed e.g. by location ID
+type TokenStore interface {
+	// Load returns the tokens stored under key, or ErrTokensNotFound
+	Load(ctx context.Context, key string) (*StoredTokens, error)
+	Save(ctx context.Context, key string, tokens *StoredTokens) error
+	Delete(ctx context.Context, key string) error
+}
+
+// BlobStore stores opaque values by key, e.g. in a database table, Redis or files. It is the
+// storage behind EncryptedTokenStore.
+type BlobStore interface {
+	// Get returns the value stored under key, or ErrTokensNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	Put(ctx context.Context, key string, value []byte) error
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryBlobStore is a BlobStore in memory, e.g. for tests
+type MemoryBlobStore struct {
+	mu     sync.RWMutex
+	values map[string][]byte
+}
+
+// Get implements BlobStore
+func (m *MemoryBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, ok := m.values[key]
+	if !ok {
+		return nil, ErrTokensNotFound
+	}
+	return append([]byte(nil), value...), nil
+}
+
+// Put implements BlobStore
+func (m *MemoryBlobStore) Put(ctx context.Context, key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.values == nil {
+		m.values = make(map[string][]byte)
+	}
+	m.values[key] = append([]byte(nil), value...)
+	return nil
+}
+
+// Delete implements BlobStore
+func (m *MemoryBlobStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+	return nil
+}
+
+// KeyFunc returns the 256-bit key used to encrypt tokens. It is called for every operation, so
+// it can fetch or unwrap the key from a KMS; cache the key in the function if that is slow.
+type KeyFunc func(ctx context.Context) ([]byte, error)
+
+// KeyFromEnv returns a KeyFunc reading a base64-encoded 32-byte key from an environment
+// variable, e.g. one generated with `openssl rand -base64 32`
+func KeyFromEnv(name string) KeyFunc {
+	return func(ctx context.Context) ([]byte, error) {
+		encoded := os.Getenv(name)
+		if encoded == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", name)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s is not valid base64: %w", name, err)
+		}
+		return key, nil
+	}
+}
+
+// encryptedTokensVersion is the first byte of every encrypted value, identifying its format
+const encryptedTokensVersion = 1
+
+// EncryptedTokenStore is a TokenStore that encrypts tokens with AES-256-GCM before writing them
+// to a BlobStore, for teams that must keep refresh tokens encrypted at rest. Each value is
+// bound to its key, so an encrypted value copied to another key fails to decrypt.
+type EncryptedTokenStore struct {
+	blobs BlobStore
+	key   KeyFunc
+}
+
+// NewEncryptedTokenStore returns a store encrypting tokens with the key returned by key
+func NewEncryptedTokenStore(blobs BlobStore, key KeyFunc) *EncryptedTokenStore {
+	return &EncryptedTokenStore{blobs: blobs, key: key}
+}
+
+// Load implements TokenStore
+func (s *EncryptedTokenStore) Load(ctx context.Context, key string) (*StoredTokens, error) {
+	sealed, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := s.aead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := aead.NonceSize()
+	if len(sealed) < 1+nonceSize || sealed[0] != encryptedTokensVersion {
+		return nil, fmt.Errorf("stored tokens for %q are not in a known format", key)
+	}
+	plaintext, err := aead.Open(nil, sealed[1:1+nonceSize], sealed[1+nonceSize:], []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt stored tokens for %q: %w", key, err)
+	}
+
+	var tokens StoredTokens
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to parse stored tokens for %q: %w", key, err)
+	}
+	return &tokens, nil
+}
+
+// Save implements TokenStore
+func (s *EncryptedTokenStore) Save(ctx context.Context, key string, tokens *StoredTokens) error {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("failed to encode tokens: %w", err)
+	}
+	aead, err := s.aead(ctx)
+	if err != nil {
+		return err
+	}
+
+	sealed := make([]byte, 1+aead.NonceSize(), 1+aead.NonceSize()+len(plaintext)+aead.Overhead())
+	sealed[0] = encryptedTokensVersion
+	if _, err := rand.Read(sealed[1:]); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed = aead.Seal(sealed, sealed[1:], plaintext, []byte(key))
+	return s.blobs.Put(ctx, key, sealed)
+}
+
+// Delete implements TokenStore
+func (s *EncryptedTokenStore) Delete(ctx context.Context, key string) error {
+	return s.blobs.Delete(ctx, key)
+}
+
+// aead returns the cipher for the current key
+func (s *EncryptedTokenStore) aead(ctx context.Context) (cipher.AEAD, error) {
+	key, err := s.key(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token encryption key: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("token encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
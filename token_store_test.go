@@ -0,0 +1,69 @@
+package gohighlevel
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEncryptedTokenStore(t *testing.T) {
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{7}, 32)
+	t.Setenv("TEST_GHL_TOKEN_KEY", base64.StdEncoding.EncodeToString(key))
+
+	blobs := &MemoryBlobStore{}
+	store := NewEncryptedTokenStore(blobs, KeyFromEnv("TEST_GHL_TOKEN_KEY"))
+
+	tokens := StoredTokensFromResponse(TokenResponse{AccessToken: "access", RefreshToken: "secret-refresh", ExpiresIn: 3600, LocationID: "loc"})
+	if err := store.Save(ctx, "loc", tokens); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	raw, _ := blobs.Get(ctx, "loc")
+	if bytes.Contains(raw, []byte("secret-refresh")) {
+		t.Error("refresh token stored in plain text")
+	}
+
+	loaded, err := store.Load(ctx, "loc")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.RefreshToken != "secret-refresh" || loaded.LocationID != "loc" || loaded.ExpiresAt.Sub(tokens.ExpiresAt).Abs() > time.Millisecond {
+		t.Errorf("Load = %+v, want %+v", loaded, tokens)
+	}
+
+	// A value moved to another key does not decrypt
+	_ = blobs.Put(ctx, "other", raw)
+	if _, err := store.Load(ctx, "other"); err == nil {
+		t.Error("value copied to another key was decrypted")
+	}
+
+	// A different key does not decrypt
+	wrongKey := NewEncryptedTokenStore(blobs, func(context.Context) ([]byte, error) { return bytes.Repeat([]byte{8}, 32), nil })
+	if _, err := wrongKey.Load(ctx, "loc"); err == nil {
+		t.Error("value decrypted with the wrong key")
+	}
+
+	if err := store.Delete(ctx, "loc"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := store.Load(ctx, "loc"); !errors.Is(err, ErrTokensNotFound) {
+		t.Errorf("Load after Delete = %v, want ErrTokensNotFound", err)
+	}
+}
+
+func TestEncryptedTokenStore_BadKey(t *testing.T) {
+	store := NewEncryptedTokenStore(&MemoryBlobStore{}, func(context.Context) ([]byte, error) { return []byte("short"), nil })
+	if err := store.Save(context.Background(), "loc", &StoredTokens{}); err == nil {
+		t.Error("short key was accepted")
+	}
+
+	t.Setenv("TEST_GHL_MISSING_KEY", "")
+	store = NewEncryptedTokenStore(&MemoryBlobStore{}, KeyFromEnv("TEST_GHL_MISSING_KEY"))
+	if err := store.Save(context.Background(), "loc", &StoredTokens{}); err == nil {
+		t.Error("missing key was accepted")
+	}
+}
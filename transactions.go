@@ -0,0 +1,143 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// TransactionsService handles operations related to payment transactions
+type TransactionsService struct {
+	client *Client
+}
+
+// Transaction represents a payment transaction
+type Transaction struct {
+	ID                  string                 `json:"_id,omitempty"`
+	AltID               string                 `json:"altId,omitempty"`
+	AltType             string                 `json:"altType,omitempty"`
+	ContactID           string                 `json:"contactId,omitempty"`
+	ContactName         string                 `json:"contactName,omitempty"`
+	ContactEmail        string                 `json:"contactEmail,omitempty"`
+	Currency            string                 `json:"currency,omitempty"`
+	Amount              float64                `json:"amount,omitempty"`
+	AmountRefunded      float64                `json:"amountRefunded,omitempty"`
+	Status              string                 `json:"status,omitempty"` // e.g. "succeeded", "failed", "refunded"
+	LiveMode            bool                   `json:"liveMode,omitempty"`
+	EntityType          string                 `json:"entityType,omitempty"` // e.g. "order", "invoice"
+	EntityID            string                 `json:"entityId,omitempty"`
+	EntitySourceType    string                 `json:"entitySourceType,omitempty"`
+	EntitySourceName    string                 `json:"entitySourceName,omitempty"`
+	EntitySourceID      string                 `json:"entitySourceId,omitempty"`
+	SubscriptionID      string                 `json:"subscriptionId,omitempty"`
+	ChargeID            string                 `json:"chargeId,omitempty"` // ID of the charge at the payment provider
+	PaymentProviderType string                 `json:"paymentProviderType,omitempty"`
+	ChargeSnapshot      map[string]interface{} `json:"chargeSnapshot,omitempty"`
+	Meta                map[string]interface{} `json:"meta,omitempty"`
+	MarkAsTest          bool                   `json:"markAsTest,omitempty"`
+	IsParent            bool                   `json:"isParent,omitempty"`
+	CreatedAt           Time                   `json:"createdAt,omitempty"`
+	UpdatedAt           Time                   `json:"updatedAt,omitempty"`
+}
+
+// ListTransactionsOptions represents query options for listing transactions
+type ListTransactionsOptions struct {
+	LocationID       string
+	ContactID        string
+	EntityID         string
+	EntitySourceType string
+	SubscriptionID   string
+	PaymentMode      string // "live" or "test"
+	Search           string
+	StartAt          string // YYYY-MM-DD
+	EndAt            string // YYYY-MM-DD
+	Limit            int
+	Offset           int
+}
+
+// TransactionsResponse represents a list of transactions API response
+type TransactionsResponse struct {
+	Data       []Transaction `json:"data,omitempty"`
+	TotalCount int           `json:"totalCount,omitempty"`
+}
+
+// List retrieves transactions for a location with optional filters
+// Required scope: payments/transactions.readonly
+func (s *TransactionsService) List(opts *ListTransactionsOptions) (*TransactionsResponse, error) {
+	if opts == nil {
+		opts = &ListTransactionsOptions{}
+	}
+	opts = shallowCopy(opts)
+	opts.LocationID = s.client.resolveLocationID(opts.LocationID)
+	if opts.LocationID == "" {
+		return nil, fmt.Errorf("locationId is required")
+	}
+
+	query := paymentsListQuery(opts.LocationID, opts.ContactID, opts.PaymentMode, opts.Search, opts.StartAt, opts.EndAt, opts.Limit, opts.Offset)
+	if opts.EntityID != "" {
+		query.Set("entityId", opts.EntityID)
+	}
+	if opts.EntitySourceType != "" {
+		query.Set("entitySourceType", opts.EntitySourceType)
+	}
+	if opts.SubscriptionID != "" {
+		query.Set("subscriptionId", opts.SubscriptionID)
+	}
+
+	var result TransactionsResponse
+	err := s.client.doRequest("GET", "/payments/transactions?"+query.Encode(), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListByContact retrieves every transaction of a contact, across all pages
+// Required scope: payments/transactions.readonly
+func (s *TransactionsService) ListByContact(locationID, contactID string) ([]Transaction, error) {
+	if contactID == "" {
+		return nil, fmt.Errorf("contactId is required")
+	}
+
+	var transactions []Transaction
+	for offset := 0; ; offset += paymentsPageSize {
+		result, err := s.List(&ListTransactionsOptions{LocationID: locationID, ContactID: contactID, Limit: paymentsPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, result.Data...)
+		if len(result.Data) < paymentsPageSize || len(transactions) >= result.TotalCount {
+			return transactions, nil
+		}
+	}
+}
+
+// paymentsPageSize is the page size used when collecting every order or transaction of a contact
+const paymentsPageSize = 100
+
+// paymentsListQuery builds the filters shared by the order and transaction listings
+func paymentsListQuery(locationID, contactID, paymentMode, search, startAt, endAt string, limit, offset int) url.Values {
+	query := altLocationQuery(locationID)
+	if contactID != "" {
+		query.Set("contactId", contactID)
+	}
+	if paymentMode != "" {
+		query.Set("paymentMode", paymentMode)
+	}
+	if search != "" {
+		query.Set("search", search)
+	}
+	if startAt != "" {
+		query.Set("startAt", startAt)
+	}
+	if endAt != "" {
+		query.Set("endAt", endAt)
+	}
+	if limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", limit))
+	}
+	if offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", offset))
+	}
+	return query
+}
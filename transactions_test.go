@@ -0,0 +1,56 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// newPaymentsListClient serves total items of a payments listing, checking the contact filter
+func newPaymentsListClient(t *testing.T, config Config, path string, total int) *Client {
+	return newTestClient(t, config, map[string]http.HandlerFunc{
+		"GET " + path: func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("contactId") != "c1" || q.Get("altId") != "loc-1" || q.Get("altType") != "location" {
+				t.Errorf("unexpected request %s", r.URL)
+			}
+			offset, _ := strconv.Atoi(q.Get("offset"))
+			limit, _ := strconv.Atoi(q.Get("limit"))
+			items := ""
+			for i := offset; i < total && i < offset+limit; i++ {
+				if items != "" {
+					items += ","
+				}
+				items += fmt.Sprintf(`{"_id":"id-%d","contactId":"c1"}`, i)
+			}
+			_, _ = fmt.Fprintf(w, `{"data":[%s],"totalCount":%d}`, items, total)
+		},
+	})
+}
+
+func TestTransactionsService_ListByContact(t *testing.T) {
+	client := newPaymentsListClient(t, Config{}, "/payments/transactions", 150)
+	transactions, err := client.Transactions.ListByContact("loc-1", "c1")
+	if err != nil {
+		t.Fatalf("ListByContact failed: %v", err)
+	}
+	if len(transactions) != 150 || transactions[149].ID != "id-149" {
+		t.Errorf("got %d transactions", len(transactions))
+	}
+
+	if _, err := client.Transactions.ListByContact("loc-1", ""); err == nil {
+		t.Error("expected error for missing contactId")
+	}
+}
+
+func TestOrdersService_ListByContact(t *testing.T) {
+	client := newPaymentsListClient(t, Config{LocationID: "loc-1"}, "/payments/orders", 3)
+	orders, err := client.Orders.ListByContact("", "c1")
+	if err != nil {
+		t.Fatalf("ListByContact failed: %v", err)
+	}
+	if len(orders) != 3 {
+		t.Errorf("got %d orders", len(orders))
+	}
+}
@@ -0,0 +1,136 @@
+package gohighlevel
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// MaxTagLength is the longest tag accepted by client-side validation
+const MaxTagLength = 100
+
+// FieldError describes a single invalid field of a request
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError is returned when a request fails client-side validation.
+// It lists every problem found rather than just the first.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		problems[i] = fe.Field + ": " + fe.Message
+	}
+	return "validation failed: " + strings.Join(problems, "; ")
+}
+
+// add records a problem with a field
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// errOrNil returns e if any problems were recorded, nil otherwise
+func (e *ValidationError) errOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// phoneFormatting matches the separators people commonly type in phone numbers
+var phoneFormatting = regexp.MustCompile(`[\s().\-/]`)
+
+// e164Pattern matches a phone number in E.164 format
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// NormalizePhoneE164 strips formatting from a phone number and checks that the result is in
+// E.164 format (e.g. "+1 (555) 010-9999" becomes "+15550109999"). A leading international
+// "00" prefix is converted to "+". Numbers without a country code are rejected.
+func NormalizePhoneE164(phone string) (string, error) {
+	normalized := phoneFormatting.ReplaceAllString(strings.TrimSpace(phone), "")
+	if strings.HasPrefix(normalized, "00") {
+		normalized = "+" + normalized[2:]
+	}
+	if !e164Pattern.MatchString(normalized) {
+		return "", fmt.Errorf("%q is not a valid E.164 phone number (expected + followed by country code and number)", phone)
+	}
+	return normalized, nil
+}
+
+// Validate checks a create contact request client-side and normalizes its phone number to E.164.
+// It is run automatically before sending when Config.ValidateRequests is set.
+func (r *CreateContactRequest) Validate() error {
+	v := &ValidationError{}
+	if r.LocationID == "" {
+		v.add("locationId", "is required")
+	}
+	validateContactFields(v, &r.Email, &r.Phone, r.Tags, r.CustomFields)
+	return v.errOrNil()
+}
+
+// Validate checks an upsert contact request client-side and normalizes its phone number to E.164.
+// It is run automatically before sending when Config.ValidateRequests is set.
+func (r *UpsertContactRequest) Validate() error {
+	v := &ValidationError{}
+	if r.LocationID == "" {
+		v.add("locationId", "is required")
+	}
+	if r.Email == "" && r.Phone == "" {
+		v.add("email", "email or phone is required to match an existing contact")
+	}
+	validateContactFields(v, &r.Email, &r.Phone, r.Tags, r.CustomFields)
+	return v.errOrNil()
+}
+
+// validateContactFields validates the fields shared by the contact create and upsert requests
+func validateContactFields(v *ValidationError, email, phone *string, tags []string, customFields []CustomField) {
+	if *email != "" {
+		if addr, err := mail.ParseAddress(*email); err != nil || addr.Address != *email {
+			v.add("email", "%q is not a valid email address", *email)
+		}
+	}
+
+	if *phone != "" {
+		if normalized, err := NormalizePhoneE164(*phone); err != nil {
+			v.add("phone", "%s", err)
+		} else {
+			*phone = normalized
+		}
+	}
+
+	for i, tag := range tags {
+		switch {
+		case strings.TrimSpace(tag) == "":
+			v.add(fmt.Sprintf("tags[%d]", i), "must not be empty")
+		case len(tag) > MaxTagLength:
+			v.add(fmt.Sprintf("tags[%d]", i), "must be at most %d characters", MaxTagLength)
+		}
+	}
+
+	for i, field := range customFields {
+		name := fmt.Sprintf("customField[%d]", i)
+		if field.ID == "" && field.Key == "" {
+			v.add(name, "id or key is required")
+		}
+		if !isValidCustomFieldValue(field.Value) {
+			v.add(name, "unsupported value type %T", field.Value)
+		}
+	}
+}
+
+// isValidCustomFieldValue reports whether a custom field value has a type the API accepts:
+// text, number, boolean, or a list of options
+func isValidCustomFieldValue(value interface{}) bool {
+	switch value.(type) {
+	case nil, string, bool, int, int32, int64, float32, float64, []string, []interface{}:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package gohighlevel
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalizePhoneE164(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"+1 (555) 010-9999", "+15550109999", false},
+		{"0044 20 7946 0958", "+442079460958", false},
+		{"+27.82.555.1234", "+27825551234", false},
+		{"555-010-9999", "", true},
+		{"+0123456789", "", true},
+		{"+1234", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizePhoneE164(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error=%v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCreateContactRequest_Validate(t *testing.T) {
+	valid := &CreateContactRequest{
+		LocationID:   "loc-1",
+		Email:        "ada@example.com",
+		Phone:        "+1 555 010 9999",
+		Tags:         []string{"vip"},
+		CustomFields: []CustomField{{ID: "field-1", Value: []string{"a", "b"}}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Expected valid request, got %v", err)
+	}
+	if valid.Phone != "+15550109999" {
+		t.Errorf("Expected phone to be normalized, got %s", valid.Phone)
+	}
+
+	invalid := &CreateContactRequest{
+		Email:        "not-an-email",
+		Phone:        "12345",
+		Tags:         []string{"", strings.Repeat("x", MaxTagLength+1)},
+		CustomFields: []CustomField{{Value: map[string]string{"a": "b"}}},
+	}
+	err := invalid.Validate()
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+
+	fields := make(map[string]bool)
+	for _, fe := range validationErr.Errors {
+		fields[fe.Field] = true
+	}
+	for _, want := range []string{"locationId", "email", "phone", "tags[0]", "tags[1]", "customField[0]"} {
+		if !fields[want] {
+			t.Errorf("Expected a problem for %s, got %v", want, validationErr)
+		}
+	}
+}
+
+func TestUpsertContactRequest_Validate(t *testing.T) {
+	err := (&UpsertContactRequest{LocationID: "loc-1"}).Validate()
+	if err == nil {
+		t.Error("Expected error when neither email nor phone is set")
+	}
+}
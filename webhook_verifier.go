@@ -0,0 +1,128 @@
+package gohighlevel
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WebhookSignatureHeader is the header carrying the signature of a webhook delivery
+const WebhookSignatureHeader = "X-Wh-Signature"
+
+// ErrInvalidWebhookSignature is returned when a webhook delivery is not signed by any trusted key
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// WebhookVerifier checks that webhook deliveries were signed by GoHighLevel. Deliveries are
+// signed with RSA-SHA256 over the raw request body.
+//
+// The verifier trusts several public keys at once so the signing key can be rotated without
+// rejecting deliveries: add the new key as soon as it is published, and remove the old one once
+// deliveries signed with it have stopped. The newest key is tried first.
+type WebhookVerifier struct {
+	mu   sync.RWMutex
+	keys []*rsa.PublicKey // Newest first
+}
+
+// NewWebhookVerifier returns a verifier trusting the given PEM-encoded public keys, oldest first
+func NewWebhookVerifier(pemKeys ...string) (*WebhookVerifier, error) {
+	v := &WebhookVerifier{}
+	for _, pemKey := range pemKeys {
+		if err := v.AddKey(pemKey); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// AddKey trusts a PEM-encoded public key and prefers it over the keys added before
+func (v *WebhookVerifier) AddKey(pemKey string) error {
+	key, err := parseRSAPublicKey(pemKey)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = append([]*rsa.PublicKey{key}, v.keys...)
+	return nil
+}
+
+// RemoveKey stops trusting a PEM-encoded public key, e.g. once rotation away from it is complete
+func (v *WebhookVerifier) RemoveKey(pemKey string) error {
+	key, err := parseRSAPublicKey(pemKey)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	kept := v.keys[:0]
+	for _, k := range v.keys {
+		if !k.Equal(key) {
+			kept = append(kept, k)
+		}
+	}
+	v.keys = kept
+	return nil
+}
+
+// Verify checks a base64-encoded signature of a raw webhook body
+func (v *WebhookVerifier) Verify(body []byte, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(sig) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+	digest := sha256.Sum256(body)
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	for _, key := range v.keys {
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookSignature
+}
+
+// VerifyRequest checks the signature of a webhook request and returns its body. The request
+// body is replaced, so handlers can still read it afterwards.
+func (v *WebhookVerifier) VerifyRequest(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := v.Verify(body, r.Header.Get(WebhookSignatureHeader)); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// parseRSAPublicKey parses a PEM-encoded RSA public key in PKIX or PKCS#1 form
+func parseRSAPublicKey(pemKey string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, fmt.Errorf("webhook public key is not PEM-encoded")
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook public key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("webhook public key is not an RSA key")
+	}
+	return key, nil
+}
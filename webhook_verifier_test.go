@@ -0,0 +1,95 @@
+package gohighlevel
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newWebhookKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func signWebhook(t *testing.T, key *rsa.PrivateKey, body string) string {
+	t.Helper()
+	digest := sha256.Sum256([]byte(body))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("SignPKCS1v15 failed: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestWebhookVerifier_Rotation(t *testing.T) {
+	oldKey, oldPEM := newWebhookKey(t)
+	newKey, newPEM := newWebhookKey(t)
+	body := `{"type":"ContactCreate","id":"c1"}`
+
+	verifier, err := NewWebhookVerifier(oldPEM)
+	if err != nil {
+		t.Fatalf("NewWebhookVerifier failed: %v", err)
+	}
+	if err := verifier.Verify([]byte(body), signWebhook(t, newKey, body)); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Errorf("untrusted key accepted: %v", err)
+	}
+
+	// During rotation both keys are accepted
+	if err := verifier.AddKey(newPEM); err != nil {
+		t.Fatalf("AddKey failed: %v", err)
+	}
+	for name, key := range map[string]*rsa.PrivateKey{"old": oldKey, "new": newKey} {
+		if err := verifier.Verify([]byte(body), signWebhook(t, key, body)); err != nil {
+			t.Errorf("%s key rejected during rotation: %v", name, err)
+		}
+	}
+
+	if err := verifier.RemoveKey(oldPEM); err != nil {
+		t.Fatalf("RemoveKey failed: %v", err)
+	}
+	if err := verifier.Verify([]byte(body), signWebhook(t, oldKey, body)); err == nil {
+		t.Error("removed key still accepted")
+	}
+	if err := verifier.Verify([]byte(body+" "), signWebhook(t, newKey, body)); err == nil {
+		t.Error("tampered body accepted")
+	}
+}
+
+func TestWebhookVerifier_VerifyRequest(t *testing.T) {
+	key, pemKey := newWebhookKey(t)
+	verifier, _ := NewWebhookVerifier(pemKey)
+	body := `{"type":"ContactDelete","id":"c1"}`
+
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+	req.Header.Set(WebhookSignatureHeader, signWebhook(t, key, body))
+	got, err := verifier.VerifyRequest(req)
+	if err != nil {
+		t.Fatalf("VerifyRequest failed: %v", err)
+	}
+	rest, _ := io.ReadAll(req.Body)
+	if string(got) != body || string(rest) != body {
+		t.Errorf("body = %q, request body afterwards = %q", got, rest)
+	}
+
+	req = httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+	if _, err := verifier.VerifyRequest(req); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Errorf("unsigned request error = %v", err)
+	}
+	if _, err := NewWebhookVerifier("not a key"); err == nil {
+		t.Error("invalid key accepted")
+	}
+}